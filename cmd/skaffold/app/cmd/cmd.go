@@ -265,7 +265,7 @@ func setUpLogs(stdErr io.Writer, level string, timestamp bool) error {
 // alwaysSucceedWhenCancelled returns nil if the context was cancelled.
 // If the error is due to cancellation, return it as it gets swallowed
 // in skaffold main.
-// For all other errors, pass through known errors.
+// For all other errors, pass through known errors, counted in the error statistics if enabled.
 // TODO: Return nil if error is `context.Cancelled` and remove check in main.
 func alwaysSucceedWhenCancelled(ctx context.Context, runCtx *runcontext.RunContext, err error) error {
 	if err == nil {
@@ -277,6 +277,7 @@ func alwaysSucceedWhenCancelled(ctx context.Context, runCtx *runcontext.RunConte
 	} else if err == context.Canceled {
 		return err
 	}
+	sErrors.RecordError(runCtx, "", err)
 	return sErrors.ShowAIError(runCtx, err)
 }
 
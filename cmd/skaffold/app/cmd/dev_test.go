@@ -179,3 +179,35 @@ func TestNewCmdDev(t *testing.T) {
 		t.CheckDeepEqual(true, opts.EnableRPC)
 	})
 }
+
+func TestNewCmdDevInvalidPortForwardFlags(t *testing.T) {
+	tests := []struct {
+		description string
+		args        []string
+		expectedErr string
+	}{
+		{description: "in-cluster websockets", args: []string{"--port-forward-in-cluster", "--port-forward-websocket"}, expectedErr: "--port-forward-in-cluster can't be combined"},
+		{description: "in-cluster multiplexing", args: []string{"--port-forward-in-cluster", "--port-forward-multiplex"}, expectedErr: "--port-forward-in-cluster can't be combined"},
+		{description: "invalid field selector", args: []string{"--port-forward-field-selector", "spec.nodeName"}, expectedErr: "invalid --port-forward-field-selector"},
+		{description: "invalid label condition", args: []string{"--port-forward-label-condition", "track in (canary"}, expectedErr: "invalid --port-forward-label-condition"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.NewTempDir().Chdir()
+			t.Override(&opts, config.SkaffoldOptions{})
+			var ran bool
+			t.Override(&doDev, func(context.Context, io.Writer) error {
+				ran = true
+				return nil
+			})
+
+			cmd := NewCmdDev()
+			cmd.SilenceUsage = true
+			cmd.SetArgs(test.args)
+			err := cmd.Execute()
+
+			t.CheckErrorContains(test.expectedErr, err)
+			t.CheckFalse(ran)
+		})
+	}
+}
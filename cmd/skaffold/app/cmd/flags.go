@@ -283,6 +283,206 @@ var flagRegistry = []Flag{
 		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
 		IsEnum:        true,
 	},
+	{
+		Name:          "port-forward-drain-timeout",
+		Usage:         "When set, stopping port forwarding waits up to this duration for active connections to finish",
+		Value:         &opts.PortForward.DrainTimeout,
+		DefValue:      time.Duration(0),
+		FlagAddMethod: "DurationVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-delete-grace-period",
+		Usage:         "When set, the port forwards of a deleted pod are only stopped once this duration has passed without the pod reappearing, eg. '5s'",
+		Value:         &opts.PortForward.DeleteGracePeriod,
+		DefValue:      time.Duration(0),
+		FlagAddMethod: "DurationVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-dev-images-only",
+		Usage:         "When forwarding pods, only forward containers running images built by Skaffold",
+		Value:         &opts.PortForward.DevImagesOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-open-browser",
+		Usage:         "When set, open each port forward that looks like HTTP, by its port name ('http' or 'web') or number, in the default browser once it's ready, on its bind address, or on localhost for loopback and wildcard addresses. Skipped when no display is available",
+		Value:         &opts.PortForward.OpenBrowser,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-mdns",
+		Usage:         "When set, advertise each port forward that looks like HTTP as an _http._tcp service over mDNS (Bonjour) once it's ready, so that it shows up in local service discovery tools. Port forwards are bound to 127.0.0.1 by default, which other hosts can't reach, so only those bound to another address, eg. with 'address: 0.0.0.0' in the skaffold.yaml port forwards, are advertised. Skipped when mDNS isn't available",
+		Value:         &opts.PortForward.AdvertiseMDNS,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-kube-contexts",
+		Usage:         "When forwarding pods, also forward the pods of these other kube-contexts, for multi-cluster development. Their local ports are allocated along with the ones of the current kube-context's pods",
+		Value:         &opts.PortForward.KubeContexts,
+		DefValue:      []string{},
+		FlagAddMethod: "StringSliceVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-primary-container",
+		Usage:         "When forwarding pods, only forward the primary container of pods with sidecars: the only container running an image built by Skaffold, or the only container exposing ports besides known sidecars",
+		Value:         &opts.PortForward.PrimaryContainerOnly,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-one-pod-per-workload",
+		Usage:         "When forwarding pods, only forward one Ready pod of each workload instead of all its replicas, switching to another Ready pod when the forwarded one is deleted",
+		Value:         &opts.PortForward.OnePodPerWorkload,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-reservations-file",
+		Usage:         "File remembering the local port of each forwarded resource, eg. .skaffold/port-forwards.json, so that resources are forwarded to the same local ports across sessions when they are still free",
+		Value:         &opts.PortForward.ReservationsFile,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-health-endpoints",
+		Usage:         "When set, serve the state of each port forward (active, degraded or terminated) as JSON on a local port of its own, reported with the port forward, for watchdogs to poll",
+		Value:         &opts.PortForward.HealthEndpoints,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-seed",
+		Usage:         "Seed of the local ports picked when the ports of forwarded resources aren't available, so that runs with the same seed, eg. in CI, get the same local ports. 0 picks ports at random",
+		Value:         &opts.PortForward.PortSeed,
+		DefValue:      int64(0),
+		FlagAddMethod: "Int64Var",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-multiplex",
+		Usage:         "Forward all the ports of a pod over a single connection to the API server instead of one kubectl port-forward per port, to reduce the load on the API server for pods with many ports",
+		Value:         &opts.PortForward.Multiplex,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-output-format",
+		Usage:         "Format of the messages of port forwarding: 'text' for human-readable lines, or 'json' for one JSON object per line, eg. for structured logging",
+		Value:         &opts.PortForward.OutputFormat,
+		DefValue:      "text",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-on-demand",
+		Usage:         "When forwarding pods, track the pods without forwarding their ports until they are requested through the API, eg. with a POST on /v1/port_forwards/pods. Required to request the ports of a given pod or replica",
+		Value:         &opts.PortForward.OnDemand,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-pause-during-rebuilds",
+		Usage:         "When forwarding pods, don't forward the pods created or modified while a dev iteration rebuilds and redeploys, and forward the pods running once the iteration completes",
+		Value:         &opts.PortForward.PauseDuringRebuilds,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "debug"},
+	},
+	{
+		Name:          "port-forward-readiness-probe",
+		Usage:         "When set, forward the port targeted by the readinessProbe of each pod container, even if the container doesn't expose it",
+		Value:         &opts.PortForward.ReadinessProbe,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-in-cluster",
+		Usage:         "When set, forward ports through Services created in the cluster of each forwarded resource instead of local ports, for Skaffold running in a pod. Can't be combined with --port-forward-multiplex or --port-forward-websocket",
+		Value:         &opts.PortForward.InCluster,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-websocket",
+		Usage:         "When set, serve each port forward as a WebSocket endpoint (ws://localhost:port), for browser-based dev tools",
+		Value:         &opts.PortForward.WebSocket,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-proxy",
+		Usage:         "URL of the HTTP CONNECT proxy used to reach the cluster when port forwarding. Defaults to the HTTPS_PROXY environment variable",
+		Value:         &opts.PortForward.Proxy,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-field-selector",
+		Usage:         "Only forward the ports of pods matching this field selector, eg. 'status.phase=Running,spec.nodeName=node-1'",
+		Value:         &opts.PortForward.FieldSelector,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-label-condition",
+		Usage:         "Only forward the ports of pods while their labels match this condition, eg. 'track=canary'. Forwards are stopped when a pod's labels stop matching",
+		Value:         &opts.PortForward.LabelCondition,
+		DefValue:      "",
+		FlagAddMethod: "StringVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-allow-ports",
+		Usage:         "Only forward these container ports of pods, eg. '8080,9229'",
+		Value:         &opts.PortForward.AllowedPorts,
+		DefValue:      []int{},
+		FlagAddMethod: "IntSliceVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-deny-ports",
+		Usage:         "Never forward these container ports of pods, eg. '22,2375,10250'",
+		Value:         &opts.PortForward.DeniedPorts,
+		DefValue:      []int{},
+		FlagAddMethod: "IntSliceVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-hosts-aliases",
+		Usage:         "Register a '<port name>.<pod name>.local' alias of each forwarded named port of pods in the hosts file, and remove it once the port forward stops. The original hosts file is saved next to it with a '.skaffold.bak' suffix before it's edited, which usually requires running Skaffold as an administrator",
+		Value:         &opts.PortForward.HostsAliases,
+		DefValue:      false,
+		FlagAddMethod: "BoolVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
+	{
+		Name:          "port-forward-namespace-offsets",
+		Usage:         "Shift the local ports of port forwards by an offset per namespace, eg. 'dev=10000,staging=20000' forwards port 8080 to local port 18080 in the 'dev' namespace. Ports that are already taken are allocated as usual",
+		Value:         &opts.PortForward.NamespaceOffsets,
+		DefValue:      map[string]int{},
+		FlagAddMethod: "StringToIntVar",
+		DefinedOn:     []string{"dev", "run", "deploy", "debug"},
+	},
 	{
 		Name:          "status-check",
 		Usage:         "Wait for deployed resources to stabilize",
@@ -683,6 +883,9 @@ func AddFlags(cmd *cobra.Command) {
 	// Apply command-specific default values to flags.
 	cmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		ResetFlagDefaults(cmd, flagsForCommand)
+		if err := opts.PortForward.Validate(); err != nil {
+			return err
+		}
 		// Since PersistentPreRunE replaces the parent's PersistentPreRunE,
 		// make sure we call it, if it is set.
 		if parent := cmd.Parent(); parent != nil {
@@ -26,6 +26,7 @@ import (
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/cmd/skaffold/app"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/version"
@@ -51,6 +52,10 @@ func main() {
 			log.Fatalf("failed to start the profiler: %v", err)
 		}
 	}
+	_, errorStats := os.LookupEnv("SKAFFOLD_ERROR_STATS")
+	if errorStats {
+		sErrors.EnableStatistics()
+	}
 	var code int
 	if err := app.Run(os.Stdout, os.Stderr); err != nil {
 		if errors.Is(err, context.Canceled) {
@@ -64,10 +69,26 @@ func main() {
 			code = exitCode(err)
 		}
 	}
+	if errorStats {
+		printErrorStatistics()
+	}
 	instrumentation.ShutdownAndFlush(context.Background(), code)
 	os.Exit(code)
 }
 
+// printErrorStatistics summarizes the errors encountered during the run, by phase and status code.
+func printErrorStatistics() {
+	stats := sErrors.Statistics()
+	if len(stats) == 0 {
+		return
+	}
+	errOut := output.GetWriter(os.Stderr, output.DefaultColorCode, false, false)
+	output.Default.Fprintln(errOut, "Errors encountered:")
+	for _, stat := range stats {
+		output.Default.Fprintf(errOut, " - %s\n", stat)
+	}
+}
+
 func exitCode(err error) int {
 	var exitCoder ExitCoder
 	if errors.As(err, &exitCoder) {
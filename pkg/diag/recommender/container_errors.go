@@ -44,6 +44,36 @@ func (r ContainerError) Make(errCode proto.StatusCode) proto.Suggestion {
 			SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_IMAGE,
 			Action:         "Try checking container config `image`",
 		}
+	case proto.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_VERIFY_IMAGE_PUSHED,
+			Action:         "Check that pushing the image succeeded and that the deployed tag matches the pushed one, eg. with `docker manifest inspect`",
+		}
+	case proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_BUILD_MULTI_ARCH_IMAGE,
+			Action:         "Try building the image for the architecture of your cluster nodes, eg. a multi-arch image with `docker buildx build --platform linux/amd64,linux/arm64`, or schedule the pod on nodes matching the image architecture",
+		}
+	case proto.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_RUN_AS_NON_ROOT_USER,
+			Action:         "Try setting a numeric non-root user in the image, eg. with `USER 1000` in its Dockerfile, or set `runAsUser` to a non-root UID in the container's `securityContext`",
+		}
+	case proto.StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_FIX_NODE_SELECTOR,
+			Action:         "Try adjusting the pod's `nodeSelector` or node affinity to match the labels of your nodes, or add nodes with matching labels to the cluster",
+		}
+	case proto.StatusCode_STATUSCHECK_UNTOLERATED_TAINT:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_ADD_TOLERATIONS,
+			Action:         "Try adding `tolerations` for the node taints to the pod spec, or add untainted nodes to the cluster",
+		}
+	case proto.StatusCode_STATUSCHECK_POD_EVICTED:
+		return proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE,
+			Action:         "Check the memory and disk pressure of your nodes with `kubectl describe nodes`, and set resource requests matching the pod's actual usage",
+		}
 	}
 	return NilSuggestion
 }
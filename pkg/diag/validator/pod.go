@@ -40,23 +40,37 @@ const (
 	actionableMessage   = `could not determine pod status. Try kubectl describe -n %s po/%s`
 	errorPrefix         = `(?P<Prefix>)(?P<DaemonLog>Error response from daemon\:)(?P<Error>.*)`
 	taintsExp           = `\{(?P<taint>.*?):.*?}`
+	availableNodesExp   = `0/(?P<total>\d+) nodes are available`
+	nodeSelectorExp     = `(?P<count>\d+) node\(s\) didn't match (?:node selector|Pod's node affinity)`
+	untoleratedTaintExp = `(?P<count>\d+) node\(s\) had (?:untolerated )?taint \{(?P<key>.*?): ?(?P<value>.*?)\}`
 	crashLoopBackOff    = "CrashLoopBackOff"
 	runContainerError   = "RunContainerError"
+	createConfigError   = "CreateContainerConfigError"
 	imagePullErr        = "ErrImagePull"
 	imagePullBackOff    = "ImagePullBackOff"
 	errImagePullBackOff = "ErrImagePullBackOff"
 	containerCreating   = "ContainerCreating"
 	podInitializing     = "PodInitializing"
+	evicted             = "Evicted"
 	podKind             = "pod"
 
 	failedScheduling = "FailedScheduling"
 	unhealthy        = "Unhealthy"
 	execFmtError     = "exec format error"
+	// reported by registries when the pulled tag or digest doesn't exist
+	manifestUnknown = "manifest unknown"
+	// reported by the kubelet for containers of pods requiring `runAsNonRoot` whose image runs as root
+	runAsNonRoot = "container has runAsNonRoot and image will run as root"
+	// reported by the scheduler while the pod's claims wait for a volume
+	unboundPVC = "pod has unbound immediate PersistentVolumeClaims"
 )
 
 var (
-	runContainerRe = regexp.MustCompile(errorPrefix)
-	taintsRe       = regexp.MustCompile(taintsExp)
+	runContainerRe     = regexp.MustCompile(errorPrefix)
+	taintsRe           = regexp.MustCompile(taintsExp)
+	availableNodesRe   = regexp.MustCompile(availableNodesExp)
+	nodeSelectorRe     = regexp.MustCompile(nodeSelectorExp)
+	untoleratedTaintRe = regexp.MustCompile(untoleratedTaintExp)
 	// for testing
 	runCli = executeCLI
 
@@ -88,11 +102,18 @@ func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.List
 		return nil, err
 	}
 	eventsClient := p.k.CoreV1().Events(ns)
+	// the Ready conditions of the nodes of the pods, fetched once per validation
+	nodes := map[string]*v1.NodeCondition{}
 	var rs []Resource
 	for _, po := range pods.Items {
 		ps := p.getPodStatus(&po)
 		// Update Pod status from Pod events if required
 		processPodEvents(eventsClient, po, ps)
+		// A pod bound to a node that isn't ready can't start, whatever its own status says
+		if c, ok := p.nodeNotReady(ctx, &po, ps, nodes); ok {
+			ps.updateAE(proto.StatusCode_STATUSCHECK_NODE_NOT_READY, nodeNotReadyMessage(&po, c))
+			ps.ae.Suggestions = append(ps.ae.Suggestions, nodeNotReadySuggestion(po.Spec.NodeName))
+		}
 		// The GVK group is not populated for List Objects. Hence set `kind` to `pod`
 		// See https://github.com/kubernetes-sigs/controller-runtime/pull/389
 		if po.Kind == "" {
@@ -104,6 +125,9 @@ func (p *PodValidator) Validate(ctx context.Context, ns string, opts metav1.List
 				ps.ae.Suggestions = append(ps.ae.Suggestions, &s)
 			}
 		}
+		if ps.ae.ErrCode == proto.StatusCode_STATUSCHECK_UNBOUND_PVC {
+			ps.ae.Suggestions = append(ps.ae.Suggestions, p.unboundPVCSuggestion(ctx, &po))
+		}
 		rs = append(rs, NewResourceFromObject(&po, Status(ps.phase), ps.ae, ps.logs))
 	}
 	return rs, nil
@@ -122,14 +146,30 @@ func (p *PodValidator) getPodStatus(pod *v1.Pod) *podStatus {
 func getPodStatus(pod *v1.Pod) (proto.StatusCode, []string, error) {
 	// See https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle/#pod-conditions
 
+	// Evicted pods are failed for good: their containers won't be restarted
+	if pod.Status.Reason == evicted {
+		if pod.Status.Message != "" {
+			return proto.StatusCode_STATUSCHECK_POD_EVICTED, nil, fmt.Errorf("pod %s was evicted: %s", pod.Name, pod.Status.Message)
+		}
+		return proto.StatusCode_STATUSCHECK_POD_EVICTED, nil, fmt.Errorf("pod %s was evicted", pod.Name)
+	}
 	// If the event type PodReady with status True is found then we return success immediately
 	if isPodReady(pod) {
 		return proto.StatusCode_STATUSCHECK_SUCCESS, nil, nil
 	}
 	// If the event type PodScheduled with status False is found then we check if it is due to taints and tolerations.
 	if c, ok := isPodNotScheduled(pod); ok {
+		if strings.Contains(c.Message, unboundPVC) {
+			return proto.StatusCode_STATUSCHECK_UNBOUND_PVC, nil, fmt.Errorf("%s: %s", c.Reason, c.Message)
+		}
 		logrus.Debugf("Pod %q not scheduled: checking tolerations", pod.Name)
 		sc, err := getUntoleratedTaints(c.Reason, c.Message)
+		if sc == proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE {
+			// no node condition is to blame, check the pod's own scheduling constraints.
+			if csc, cerr := getSchedulingConstraintsErr(c.Reason, c.Message); csc != proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE {
+				return csc, nil, cerr
+			}
+		}
 		return sc, nil, err
 	}
 	// we can check the container status if the pod has been scheduled successfully. This can be determined by having the event
@@ -213,6 +253,9 @@ func getContainerStatus(po *v1.Pod, cs []v1.ContainerStatus) (proto.StatusCode,
 			return extractErrorMessageFromWaitingContainerStatus(po, c)
 		case c.State.Terminated != nil && c.State.Terminated.ExitCode != 0:
 			sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
+			if isExecFormatError(c.State.Terminated.Reason, c.State.Terminated.Message) {
+				sc = proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR
+			}
 			return sc, l, fmt.Errorf("container %s terminated with exit code %d", c.Name, c.State.Terminated.ExitCode)
 		}
 	}
@@ -268,6 +311,115 @@ func getUntoleratedTaints(reason string, message string) (proto.StatusCode, erro
 	return errCode, fmt.Errorf("%s: 0/%d nodes available: %s", reason, len(messages), strings.Join(messages, ", "))
 }
 
+// getSchedulingConstraintsErr explains scheduling failures caused by the pod's node selector,
+// node affinity or missing tolerations for taints set on the nodes by the cluster administrator.
+func getSchedulingConstraintsErr(reason string, message string) (proto.StatusCode, error) {
+	errCode := proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE
+	var messages []string
+	for _, m := range nodeSelectorRe.FindAllStringSubmatch(message, -1) {
+		messages = append(messages, fmt.Sprintf("%s node(s) didn't match the pod's node selector or affinity", m[1]))
+		errCode = proto.StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH
+	}
+	for _, m := range untoleratedTaintRe.FindAllStringSubmatch(message, -1) {
+		key, value := m[2], m[3]
+		if strings.HasPrefix(key, "node.kubernetes.io/") {
+			// taints reflecting node conditions are reported by getUntoleratedTaints
+			continue
+		}
+		taint := key
+		if value != "" {
+			taint = fmt.Sprintf("%s=%s", key, value)
+		}
+		messages = append(messages, fmt.Sprintf("%s node(s) had taint %q that the pod doesn't tolerate", m[1], taint))
+		if errCode == proto.StatusCode_STATUSCHECK_UNKNOWN_UNSCHEDULABLE {
+			errCode = proto.StatusCode_STATUSCHECK_UNTOLERATED_TAINT
+		}
+	}
+	if len(messages) == 0 {
+		return errCode, fmt.Errorf("%s: %s", reason, message)
+	}
+	if m := availableNodesRe.FindStringSubmatch(message); m != nil {
+		return errCode, fmt.Errorf("%s: 0/%s nodes available: %s", reason, m[1], strings.Join(messages, ", "))
+	}
+	return errCode, fmt.Errorf("%s: %s", reason, strings.Join(messages, ", "))
+}
+
+// unboundPVCSuggestion suggests checking the provisioning of the pod's claims that aren't bound yet.
+func (p *PodValidator) unboundPVCSuggestion(ctx context.Context, pod *v1.Pod) *proto.Suggestion {
+	var claims []string
+	for _, v := range pod.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		name := v.PersistentVolumeClaim.ClaimName
+		pvc, err := p.k.CoreV1().PersistentVolumeClaims(pod.Namespace).Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			logrus.Debugf("Could not fetch PersistentVolumeClaim %q: %v", name, err)
+			continue
+		}
+		if pvc.Status.Phase != v1.ClaimBound {
+			claims = append(claims, name)
+		}
+	}
+
+	action := "Check that a StorageClass can dynamically provision volumes for the pod's PersistentVolumeClaims, or that matching PersistentVolumes exist"
+	if len(claims) > 0 {
+		action = fmt.Sprintf("Check that a StorageClass can dynamically provision a volume for PersistentVolumeClaim %s, or that a matching PersistentVolume exists, eg. with `kubectl describe -n %s pvc %s`",
+			strings.Join(claims, ", "), pod.Namespace, strings.Join(claims, " "))
+	}
+	return &proto.Suggestion{
+		SuggestionCode: proto.SuggestionCode_CHECK_PVC_PROVISIONING,
+		Action:         action,
+	}
+}
+
+// nodeNotReady returns the Ready condition of the node of a failing pod, if the node isn't ready.
+// Nodes are looked up in the given cache first.
+func (p *PodValidator) nodeNotReady(ctx context.Context, pod *v1.Pod, ps *podStatus, nodes map[string]*v1.NodeCondition) (v1.NodeCondition, bool) {
+	nodeName := pod.Spec.NodeName
+	if nodeName == "" || pod.Status.Phase == v1.PodSucceeded {
+		return v1.NodeCondition{}, false
+	}
+	if ps.ae.ErrCode == proto.StatusCode_STATUSCHECK_SUCCESS || ps.ae.ErrCode == proto.StatusCode_STATUSCHECK_POD_EVICTED {
+		return v1.NodeCondition{}, false
+	}
+
+	c, found := nodes[nodeName]
+	if !found {
+		node, err := p.k.CoreV1().Nodes().Get(ctx, nodeName, metav1.GetOptions{})
+		if err != nil {
+			logrus.Debugf("Could not fetch node %q of pod %q: %v", nodeName, pod.Name, err)
+		} else {
+			for i := range node.Status.Conditions {
+				if node.Status.Conditions[i].Type == v1.NodeReady {
+					c = &node.Status.Conditions[i]
+				}
+			}
+		}
+		nodes[nodeName] = c
+	}
+	if c == nil || c.Status == v1.ConditionTrue {
+		return v1.NodeCondition{}, false
+	}
+	return *c, true
+}
+
+func nodeNotReadyMessage(pod *v1.Pod, c v1.NodeCondition) string {
+	if c.Message != "" {
+		return fmt.Sprintf("pod %s can't run: node %s is not ready: %s", pod.Name, pod.Spec.NodeName, c.Message)
+	}
+	return fmt.Sprintf("pod %s can't run: node %s is not ready", pod.Name, pod.Spec.NodeName)
+}
+
+// nodeNotReadySuggestion suggests checking the kubelet and container runtime of a node that isn't ready.
+func nodeNotReadySuggestion(nodeName string) *proto.Suggestion {
+	return &proto.Suggestion{
+		SuggestionCode: proto.SuggestionCode_ADDRESS_NODE_NOT_READY,
+		Action: fmt.Sprintf("Check the health of node %s, its kubelet and its container runtime, eg. with `kubectl describe node %s`, or delete the pod to have it rescheduled on a ready node",
+			nodeName, nodeName),
+	}
+}
+
 func processPodEvents(e corev1.EventInterface, pod v1.Pod, ps *podStatus) {
 	if _, ok := unknownConditionsOrSuccess[ps.ae.ErrCode]; !ok {
 		return
@@ -297,6 +449,10 @@ func processPodEvents(e corev1.EventInterface, pod v1.Pod, ps *podStatus) {
 	}
 	switch recentEvent.Reason {
 	case failedScheduling:
+		if strings.Contains(recentEvent.Message, unboundPVC) {
+			ps.updateAE(proto.StatusCode_STATUSCHECK_UNBOUND_PVC, recentEvent.Message)
+			return
+		}
 		ps.updateAE(proto.StatusCode_STATUSCHECK_FAILED_SCHEDULING, recentEvent.Message)
 	case unhealthy:
 		ps.updateAE(proto.StatusCode_STATUSCHECK_UNHEALTHY, recentEvent.Message)
@@ -360,10 +516,24 @@ func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerSta
 	case crashLoopBackOff:
 		// TODO, in case of container restarting, return the original failure reason due to which container failed.
 		sc, l := getPodLogs(po, c.Name, proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
+		if last := c.LastTerminationState.Terminated; last != nil && isExecFormatError(last.Reason, last.Message) {
+			sc = proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR
+		}
 		return sc, l, fmt.Errorf("container %s is backing off waiting to restart", c.Name)
 	case imagePullErr, imagePullBackOff, errImagePullBackOff:
+		if strings.Contains(strings.ToLower(c.State.Waiting.Message), manifestUnknown) {
+			// unlike other pull errors, the registry was reached but doesn't know the tag: the push didn't complete or the wrong tag is deployed.
+			return proto.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN, nil, fmt.Errorf("container %s is waiting to start: manifest for %s is unknown to the registry", c.Name, c.Image)
+		}
 		return proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR, nil, fmt.Errorf("container %s is waiting to start: %s can't be pulled", c.Name, c.Image)
+	case createConfigError:
+		if strings.Contains(c.State.Waiting.Message, runAsNonRoot) {
+			return proto.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT, nil, fmt.Errorf("container %s can't start: it must run as a non-root user, but image %s runs as root", c.Name, c.Image)
+		}
 	case runContainerError:
+		if isExecFormatError(c.State.Waiting.Message) {
+			return proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR, nil, fmt.Errorf("container %s can't start: exec format error, image %s is likely built for another architecture than the node's", c.Name, c.Image)
+		}
 		match := runContainerRe.FindStringSubmatch(c.State.Waiting.Message)
 		if len(match) != 0 {
 			return proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR, nil, fmt.Errorf("container %s in error: %s", c.Name, trimSpace(match[3]))
@@ -373,6 +543,17 @@ func extractErrorMessageFromWaitingContainerStatus(po *v1.Pod, c v1.ContainerSta
 	return proto.StatusCode_STATUSCHECK_CONTAINER_WAITING_UNKNOWN, nil, fmt.Errorf("container %s in error: %v", c.Name, c.State.Waiting)
 }
 
+// isExecFormatError returns true if a container status reason or message shows that the container's
+// entrypoint couldn't be executed, which happens when the image is built for another CPU architecture.
+func isExecFormatError(messages ...string) bool {
+	for _, m := range messages {
+		if strings.Contains(m, execFmtError) {
+			return true
+		}
+	}
+	return false
+}
+
 func newPodStatus(n string, ns string, p string) *podStatus {
 	return &podStatus{
 		name:      n,
@@ -47,6 +47,8 @@ func TestRun(t *testing.T) {
 		pods        []*v1.Pod
 		logOutput   mockLogOutput
 		events      []v1.Event
+		claims      []*v1.PersistentVolumeClaim
+		nodes       []*v1.Node
 		expected    []Resource
 	}{
 		{
@@ -95,6 +97,41 @@ func TestRun(t *testing.T) {
 					}},
 				}, nil)},
 		},
+		{
+			description: "pod is Waiting due to an unknown image manifest",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "gcr.io/project/foo-image:v1",
+							State: v1.ContainerState{
+								Waiting: &v1.ContainerStateWaiting{
+									Reason:  "ErrImagePull",
+									Message: "rpc error: code = Unknown desc = Error response from daemon: manifest for gcr.io/project/foo-image:v1 not found: manifest unknown: Failed to fetch \"v1\" from request \"/v2/project/foo-image/manifests/v1\".",
+								},
+							},
+						},
+					},
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "container foo-container is waiting to start: manifest for gcr.io/project/foo-image:v1 is unknown to the registry",
+					ErrCode: proto.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_VERIFY_IMAGE_PUSHED,
+						Action:         "Check that pushing the image succeeded and that the deployed tag matches the pushed one, eg. with `docker manifest inspect`",
+					}},
+				}, nil)},
+		},
 		{
 			description: "pod is Waiting condition due to ErrImageBackOffPullErr",
 			pods: []*v1.Pod{{
@@ -378,6 +415,245 @@ func TestRun(t *testing.T) {
 					ErrCode: proto.StatusCode_STATUSCHECK_NODE_PID_PRESSURE,
 				}, nil)},
 		},
+		{
+			description: "pod could not be scheduled due to node selector and taints",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:   v1.PodScheduled,
+						Status: v1.ConditionFalse,
+						Reason: v1.PodReasonUnschedulable,
+						Message: "0/3 nodes are available: 2 node(s) didn't match node selector, " +
+							"1 node(s) had taint {dedicated: gpu}, that the pod didn't tolerate.",
+					}},
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "Unschedulable: 0/3 nodes available: 2 node(s) didn't match the pod's node selector or affinity, " +
+						"1 node(s) had taint \"dedicated=gpu\" that the pod doesn't tolerate",
+					ErrCode: proto.StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_FIX_NODE_SELECTOR,
+						Action:         "Try adjusting the pod's `nodeSelector` or node affinity to match the labels of your nodes, or add nodes with matching labels to the cluster",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod could not be scheduled due to untolerated taint",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:    v1.PodScheduled,
+						Status:  v1.ConditionFalse,
+						Reason:  v1.PodReasonUnschedulable,
+						Message: "0/1 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/master: }.",
+					}},
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "Unschedulable: 0/1 nodes available: 1 node(s) had taint \"node-role.kubernetes.io/master\" that the pod doesn't tolerate",
+					ErrCode: proto.StatusCode_STATUSCHECK_UNTOLERATED_TAINT,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_ADD_TOLERATIONS,
+						Action:         "Try adding `tolerations` for the node taints to the pod spec, or add untainted nodes to the cluster",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod could not be scheduled due to unbound PersistentVolumeClaims",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Spec: v1.PodSpec{Volumes: []v1.Volume{
+					{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "data"}}},
+					{Name: "cache", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "cache"}}},
+					{Name: "config", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+				}},
+				Status: v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:    v1.PodScheduled,
+						Status:  v1.ConditionFalse,
+						Reason:  v1.PodReasonUnschedulable,
+						Message: "0/1 nodes are available: 1 pod has unbound immediate PersistentVolumeClaims.",
+					}},
+				},
+			}},
+			claims: []*v1.PersistentVolumeClaim{
+				{ObjectMeta: metav1.ObjectMeta{Name: "data", Namespace: "test"}, Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimPending}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "cache", Namespace: "test"}, Status: v1.PersistentVolumeClaimStatus{Phase: v1.ClaimBound}},
+			},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "Unschedulable: 0/1 nodes are available: 1 pod has unbound immediate PersistentVolumeClaims.",
+					ErrCode: proto.StatusCode_STATUSCHECK_UNBOUND_PVC,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_PVC_PROVISIONING,
+						Action:         "Check that a StorageClass can dynamically provision a volume for PersistentVolumeClaim data, or that a matching PersistentVolume exists, eg. with `kubectl describe -n test pvc data`",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod scheduling failed event due to unbound PersistentVolumeClaims",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase: v1.PodPending,
+					Conditions: []v1.PodCondition{{
+						Type:    v1.PodScheduled,
+						Status:  v1.ConditionUnknown,
+						Message: "could not determine",
+					}},
+				},
+			}},
+			events: []v1.Event{{
+				ObjectMeta: metav1.ObjectMeta{Name: "one", Namespace: "test"}, Reason: "FailedScheduling", Type: "Warning",
+				Message:   "0/3 nodes are available: 3 pod has unbound immediate PersistentVolumeClaims.",
+				EventTime: metav1.MicroTime{Time: after},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "0/3 nodes are available: 3 pod has unbound immediate PersistentVolumeClaims.",
+					ErrCode: proto.StatusCode_STATUSCHECK_UNBOUND_PVC,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_PVC_PROVISIONING,
+						Action:         "Check that a StorageClass can dynamically provision volumes for the pod's PersistentVolumeClaims, or that matching PersistentVolumes exist",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod evicted",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:   v1.PodFailed,
+					Reason:  "Evicted",
+					Message: "The node was low on resource: memory. Container foo-container was using 1Gi, which exceeds its request of 0.",
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Failed",
+				proto.ActionableErr{
+					Message: "pod foo was evicted: The node was low on resource: memory. Container foo-container was using 1Gi, which exceeds its request of 0.",
+					ErrCode: proto.StatusCode_STATUSCHECK_POD_EVICTED,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE,
+						Action:         "Check the memory and disk pressure of your nodes with `kubectl describe nodes`, and set resource requests matching the pod's actual usage",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod evicted without message",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:  v1.PodFailed,
+					Reason: "Evicted",
+				},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Failed",
+				proto.ActionableErr{
+					Message: "pod foo was evicted",
+					ErrCode: proto.StatusCode_STATUSCHECK_POD_EVICTED,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE,
+						Action:         "Check the memory and disk pressure of your nodes with `kubectl describe nodes`, and set resource requests matching the pod's actual usage",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod bound to a node that isn't ready",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Spec:     v1.PodSpec{NodeName: "node-1"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "foo-container",
+						State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+					}},
+				},
+			}},
+			nodes: []*v1.Node{{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status: v1.NodeStatus{Conditions: []v1.NodeCondition{{
+					Type:    v1.NodeReady,
+					Status:  v1.ConditionFalse,
+					Reason:  "KubeletNotReady",
+					Message: "container runtime is down",
+				}}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "pod foo can't run: node node-1 is not ready: container runtime is down",
+					ErrCode: proto.StatusCode_STATUSCHECK_NODE_NOT_READY,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_ADDRESS_NODE_NOT_READY,
+						Action:         "Check the health of node node-1, its kubelet and its container runtime, eg. with `kubectl describe node node-1`, or delete the pod to have it rescheduled on a ready node",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod bound to a ready node",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Spec:     v1.PodSpec{NodeName: "node-1"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{{
+						Name:  "foo-container",
+						State: v1.ContainerState{Waiting: &v1.ContainerStateWaiting{Reason: "ContainerCreating"}},
+					}},
+				},
+			}},
+			nodes: []*v1.Node{{
+				ObjectMeta: metav1.ObjectMeta{Name: "node-1"},
+				Status:     v1.NodeStatus{Conditions: []v1.NodeCondition{{Type: v1.NodeReady, Status: v1.ConditionTrue}}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "creating container foo-container",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_CREATING,
+				}, nil)},
+		},
 		{
 			description: "pod is running but container terminated",
 			pods: []*v1.Pod{{
@@ -723,8 +999,111 @@ func TestRun(t *testing.T) {
 				proto.ActionableErr{
 					Message: "container foo-container terminated with exit code 1",
 					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_BUILD_MULTI_ARCH_IMAGE,
+						Action:         "Try building the image for the architecture of your cluster nodes, eg. a multi-arch image with `docker buildx build --platform linux/amd64,linux/arm64`, or schedule the pod on nodes matching the image architecture",
+					}},
 				}, []string{"[foo foo-container] standard_init_linux.go:219: exec user process caused: exec format error"})},
 		},
+		{
+			description: "pod terminated with exec error in its termination message",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodRunning,
+					Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: v1.ConditionFalse}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "foo-image",
+							State: v1.ContainerState{
+								Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Reason: "Error", Message: "exec /app/server: exec format error"},
+							},
+						},
+					}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Running",
+				proto.ActionableErr{
+					Message: "container foo-container terminated with exit code 1",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_BUILD_MULTI_ARCH_IMAGE,
+						Action:         "Try building the image for the architecture of your cluster nodes, eg. a multi-arch image with `docker buildx build --platform linux/amd64,linux/arm64`, or schedule the pod on nodes matching the image architecture",
+					}},
+				}, []string{})},
+		},
+		{
+			description: "pod requiring a non-root user running a root image",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "foo-image",
+							State: v1.ContainerState{
+								Waiting: &v1.ContainerStateWaiting{
+									Reason:  "CreateContainerConfigError",
+									Message: `container has runAsNonRoot and image will run as root (pod: "foo_test(1b2c)", container: foo-container)`,
+								},
+							},
+						},
+					}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "container foo-container can't start: it must run as a non-root user, but image foo-image runs as root",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_RUN_AS_NON_ROOT_USER,
+						Action:         "Try setting a numeric non-root user in the image, eg. with `USER 1000` in its Dockerfile, or set `runAsUser` to a non-root UID in the container's `securityContext`",
+					}},
+				}, nil)},
+		},
+		{
+			description: "pod failing to start with exec error",
+			pods: []*v1.Pod{{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "foo",
+					Namespace: "test",
+				},
+				TypeMeta: metav1.TypeMeta{Kind: "Pod"},
+				Status: v1.PodStatus{
+					Phase:      v1.PodPending,
+					Conditions: []v1.PodCondition{{Type: v1.PodScheduled, Status: v1.ConditionTrue}},
+					ContainerStatuses: []v1.ContainerStatus{
+						{
+							Name:  "foo-container",
+							Image: "foo-image",
+							State: v1.ContainerState{
+								Waiting: &v1.ContainerStateWaiting{
+									Reason:  "RunContainerError",
+									Message: `failed to create containerd task: OCI runtime create failed: container_linux.go:380: starting container process caused: exec format error: unknown`,
+								},
+							},
+						},
+					}},
+			}},
+			expected: []Resource{NewResource("test", "Pod", "foo", "Pending",
+				proto.ActionableErr{
+					Message: "container foo-container can't start: exec format error, image foo-image is likely built for another architecture than the node's",
+					ErrCode: proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR,
+					Suggestions: []*proto.Suggestion{{
+						SuggestionCode: proto.SuggestionCode_BUILD_MULTI_ARCH_IMAGE,
+						Action:         "Try building the image for the architecture of your cluster nodes, eg. a multi-arch image with `docker buildx build --platform linux/amd64,linux/arm64`, or schedule the pod on nodes matching the image architecture",
+					}},
+				}, nil)},
+		},
 	}
 
 	for _, test := range tests {
@@ -741,6 +1120,12 @@ func TestRun(t *testing.T) {
 			for i, p := range test.pods {
 				rs[i] = p
 			}
+			for _, c := range test.claims {
+				rs = append(rs, c)
+			}
+			for _, n := range test.nodes {
+				rs = append(rs, n)
+			}
 			rs = append(rs, &v1.EventList{Items: test.events})
 			f := fakekubeclientset.NewSimpleClientset(rs...)
 
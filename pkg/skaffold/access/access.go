@@ -23,6 +23,7 @@ import (
 
 // Accessor defines the behavior for any implementation of a component
 // that accesses and exposes deployed resources from Skaffold.
+// Accessors that forward ports also implement portforward.Controller.
 type Accessor interface {
 	// Start starts the resource accessor.
 	Start(context.Context, io.Writer, []string) error
@@ -18,7 +18,10 @@ package access
 
 import (
 	"context"
+	"fmt"
 	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 )
 
 type AccessorMux []Accessor
@@ -37,3 +40,74 @@ func (a AccessorMux) Stop() {
 		accessor.Stop()
 	}
 }
+
+// controllers returns the accessors that forward ports.
+func (a AccessorMux) controllers() []portforward.Controller {
+	var controllers []portforward.Controller
+	for _, accessor := range a {
+		if controller, ok := accessor.(portforward.Controller); ok {
+			controllers = append(controllers, controller)
+		}
+	}
+	return controllers
+}
+
+func (a AccessorMux) Pause() {
+	for _, controller := range a.controllers() {
+		controller.Pause()
+	}
+}
+
+func (a AccessorMux) Resume() {
+	for _, controller := range a.controllers() {
+		controller.Resume()
+	}
+}
+
+func (a AccessorMux) Summary(out io.Writer) {
+	for _, controller := range a.controllers() {
+		controller.Summary(out)
+	}
+}
+
+func (a AccessorMux) EnablePortForward(localPort int, enabled bool) error {
+	err := fmt.Errorf("no port forward on local port %d", localPort)
+	for _, controller := range a.controllers() {
+		if err = controller.EnablePortForward(localPort, enabled); err == nil {
+			return nil
+		}
+	}
+	return err
+}
+
+func (a AccessorMux) ForwardPodPort(namespace, podName string, port int) (int, error) {
+	err := fmt.Errorf("pods are not forwarded on demand, run with `--port-forward-on-demand` to forward the ports of given pods or replicas")
+	for _, controller := range a.controllers() {
+		localPort, forwardErr := controller.ForwardPodPort(namespace, podName, port)
+		if forwardErr == nil {
+			return localPort, nil
+		}
+		err = forwardErr
+	}
+	return 0, err
+}
+
+func (a AccessorMux) ForwardReplicaPort(namespace, owner string, replica portforward.ReplicaSelector, port int) (int, error) {
+	err := fmt.Errorf("pods are not forwarded on demand, run with `--port-forward-on-demand` to forward the ports of given pods or replicas")
+	for _, controller := range a.controllers() {
+		localPort, forwardErr := controller.ForwardReplicaPort(namespace, owner, replica, port)
+		if forwardErr == nil {
+			return localPort, nil
+		}
+		err = forwardErr
+	}
+	return 0, err
+}
+
+func (a AccessorMux) PortForwards() []portforward.PortForwardEntry {
+	var entries []portforward.PortForwardEntry
+	for _, controller := range a.controllers() {
+		entries = append(entries, controller.PortForwards()...)
+	}
+	return entries
+}
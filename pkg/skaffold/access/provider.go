@@ -37,6 +37,9 @@ func NewAccessorProvider(labelConfig label.Config) Provider {
 	return &fullProvider{label: labelConfig, k8sAccessor: make(map[string]Accessor)}
 }
 
+// GetKubernetesAccessor returns the accessor of the kube-context, shared by its deployers. The pods running the images
+// of each deployer are forwarded, those of the deployers that get the accessor first taking precedence over the others'
+// when local ports run out.
 func (p *fullProvider) GetKubernetesAccessor(config portforward.Config, podSelector *kubernetes.ImageList) Accessor {
 	if !config.PortForwardOptions().Enabled() {
 		return &NoopAccessor{}
@@ -50,6 +53,8 @@ func (p *fullProvider) GetKubernetesAccessor(config portforward.Config, podSelec
 			config.Mode(),
 			config.PortForwardOptions(),
 			config.PortForwardResources())
+	} else if forwarderManager, ok := p.k8sAccessor[context].(*portforward.ForwarderManager); ok {
+		forwarderManager.AddPodSelector(podSelector)
 	}
 	return p.k8sAccessor[context]
 }
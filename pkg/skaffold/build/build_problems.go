@@ -19,11 +19,13 @@ package build
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
@@ -37,6 +39,26 @@ const (
 	// See https://github.com/moby/moby/blob/master/client/errors.go#L20
 	// `docker build: error during connect: Post \"https://127.0.0.1:32770/v1.24/build?buildargs=:  globalRepo canceled`
 	buildCancelled = ".*context canceled.*"
+
+	// Pushing to Artifact Registry or GCR without IAM permissions fails with
+	// `denied: Permission "artifactregistry.repositories.uploadArtifacts" denied on resource ...`
+	// or `denied: Permission denied for "tag" from request ...`
+	gcpRegistryPermissionDenied = `.*could not push image "?((?:[a-z0-9-]+-docker\.pkg\.dev|(?:[a-z]+\.)?gcr\.io)/[^\s"]+)"?: .*denied: Permission.*denied.*`
+
+	// Registries or the proxies in front of them can fail with a server error while pushing, eg.
+	// `could not push image "gcr.io/project/app": received unexpected HTTP status: 502 Bad Gateway`
+	registryServerErr = `received unexpected HTTP status: (5\d\d)( [^\n]*)?`
+
+	// Kaniko checks that it can push to its destination before building, and fails with
+	// `error checking push permissions -- make sure you entered the correct tag name, and that you are authenticated
+	// correctly, and try again: checking push permission for "gcr.io/project/app:v1": ...`
+	kanikoPushPermissionErr = `error checking push permissions`
+	kanikoDestination       = `checking push permission for "([^"]+)"`
+
+	// BuildKit fails to checksum the sources of COPY and ADD instructions that are missing from the build context, eg.
+	// `failed to compute cache key: failed to calculate checksum of ref ...: "/package.json": not found` or, with older versions,
+	// `failed to compute cache key: failed to walk /var/lib/docker/tmp/buildkit-mount123/app: lstat ...: no such file or directory`
+	copySourceNotFound = `failed to compute cache key: [^\n]*?(?:"([^"]+)":? not found|buildkit-mount\d+(/[^:\s]*): no such file or directory|not found)`
 )
 
 var (
@@ -45,6 +67,22 @@ var (
 	// for testing
 	getConfigForCurrentContext = config.GetConfigForCurrentKubectx
 	problems                   = []sErrors.Problem{
+		sErrors.HostNotFoundProblem(proto.StatusCode_BUILD_HOST_NOT_FOUND),
+		sErrors.RegistryTLSTimeoutProblem(proto.StatusCode_BUILD_REGISTRY_TLS_TIMEOUT),
+		sErrors.RegistryConnectionResetProblem(proto.StatusCode_BUILD_REGISTRY_CONNECTION_RESET),
+		sErrors.InvalidImageReferenceProblem(proto.StatusCode_BUILD_INVALID_IMAGE_REFERENCE),
+		{
+			Regexp:  re(gcpRegistryPermissionDenied),
+			ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+			Description: func(err error) string {
+				logrus.Tracef("error building %s", err)
+				if repo := gcpRegistryRepository(err); repo != "" {
+					return fmt.Sprintf("Build Failed. Permission denied pushing to %s", repo)
+				}
+				return "Build Failed. Permission denied pushing to Google Cloud registry"
+			},
+			SuggestionForErr: suggestGCPRegistryPermissionAction,
+		},
 		{
 			Regexp:  re(fmt.Sprintf(".*%s.* denied: .*", PushImageErr)),
 			ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
@@ -54,6 +92,39 @@ var (
 			},
 			Suggestion: suggestBuildPushAccessDeniedAction,
 		},
+		{
+			Regexp:           re(kanikoPushPermissionErr),
+			ErrCode:          proto.StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR,
+			Description:      kanikoPushPermissionDescription,
+			SuggestionForErr: suggestKanikoPushPermissionAction,
+		},
+		{
+			Regexp:           re(registryServerErr),
+			ErrCode:          proto.StatusCode_BUILD_REGISTRY_SERVER_ERR,
+			Description:      registryServerErrDescription,
+			SuggestionForErr: suggestRegistryPushRetry,
+			Recoverable:      true,
+		},
+		{
+			Regexp:           re(copySourceNotFound),
+			ErrCode:          proto.StatusCode_BUILD_COPY_SOURCE_NOT_FOUND,
+			Description:      copySourceNotFoundDescription,
+			SuggestionForErr: suggestFixCopySource,
+		},
+		{
+			Regexp:  re(docker.CorruptLocalImageErr),
+			ErrCode: proto.StatusCode_BUILD_CORRUPT_LOCAL_IMAGE,
+			Description: func(error) string {
+				return "Build Failed. An image of the local Docker daemon is corrupt, as some of its layers are missing from the daemon's storage"
+			},
+			Suggestion: func(interface{}) []*proto.Suggestion {
+				return []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE,
+					Action: "Remove the corrupt image, usually the base image of the Dockerfile or the image previously built for the artifact," +
+						" with `docker rmi -f <image>` and build again. If the error persists, restart the Docker daemon",
+				}}
+			},
+		},
 		{
 			Regexp:  re(buildCancelled),
 			ErrCode: proto.StatusCode_BUILD_CANCELLED,
@@ -140,6 +211,129 @@ func suggestBuildPushAccessDeniedAction(cfg interface{}) []*proto.Suggestion {
 	}}
 }
 
+// gcpRegistryRepository returns the repository path of the image that failed to push:
+// `LOCATION-docker.pkg.dev/PROJECT/REPOSITORY` for Artifact Registry or `[REGION.]gcr.io/PROJECT` for GCR.
+func gcpRegistryRepository(err error) string {
+	match := re(gcpRegistryPermissionDenied).FindStringSubmatch(err.Error())
+	if len(match) < 2 {
+		return ""
+	}
+	parts := strings.Split(match[1], "/")
+	n := 2
+	if strings.HasSuffix(parts[0], "-docker.pkg.dev") {
+		n = 3
+	}
+	if len(parts) < n {
+		return match[1]
+	}
+	return strings.Join(parts[:n], "/")
+}
+
+func suggestGCPRegistryPermissionAction(cfg interface{}, err error) []*proto.Suggestion {
+	repo := gcpRegistryRepository(err)
+	role := "Storage Admin role (`roles/storage.admin`)"
+	if strings.Contains(repo, "-docker.pkg.dev") {
+		role = "Artifact Registry Writer role (`roles/artifactregistry.writer`)"
+	}
+	suggestions := []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_GRANT_GCP_REGISTRY_ROLE,
+		Action:         fmt.Sprintf("Grant the %s on %s to the account pushing the image", role, repo),
+	}}
+
+	if kCfg, ok := cfg.(interface{ GetKubeContext() string }); ok && strings.HasPrefix(kCfg.GetKubeContext(), "gke_") {
+		suggestions = append(suggestions, &proto.Suggestion{
+			SuggestionCode: proto.SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY,
+			Action:         "if building in-cluster on GKE, check that Workload Identity binds the Kubernetes service account to a Google service account with this role",
+		})
+	}
+	return suggestions
+}
+
+// registryServerStatus returns the status of a registry server error, eg. `502 Bad Gateway`.
+func registryServerStatus(err error) (code, status string) {
+	match := re(registryServerErr).FindStringSubmatch(err.Error())
+	if len(match) < 2 {
+		return "", ""
+	}
+	return match[1], strings.TrimSpace(match[1] + match[2])
+}
+
+func registryServerErrDescription(err error) string {
+	_, status := registryServerStatus(err)
+	if image := re(PushImageErr + ` "?([^\s"]+)`).FindStringSubmatch(err.Error()); len(image) == 2 {
+		return fmt.Sprintf("Build Failed. Registry responded with %s while pushing %s", status, strings.TrimSuffix(image[1], ":"))
+	}
+	return fmt.Sprintf("Build Failed. Registry responded with %s", status)
+}
+
+func suggestRegistryPushRetry(_ interface{}, err error) []*proto.Suggestion {
+	var reason string
+	switch code, _ := registryServerStatus(err); code {
+	case "502":
+		reason = "the registry, or a proxy in front of it, returned an invalid response"
+	case "503":
+		reason = "the registry is temporarily unavailable"
+	case "504":
+		reason = "the registry, or a proxy in front of it, timed out"
+	default:
+		reason = "the registry failed to handle the request"
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_RETRY_REGISTRY_PUSH,
+		Action:         fmt.Sprintf("Retry the build as %s, and check the registry status if the error persists", reason),
+	}}
+}
+
+func kanikoPushPermissionDescription(err error) string {
+	if match := re(kanikoDestination).FindStringSubmatch(err.Error()); len(match) == 2 {
+		return fmt.Sprintf("Build Failed. Kaniko can't push to %s", match[1])
+	}
+	return "Build Failed. Kaniko can't push to its destination"
+}
+
+func suggestKanikoPushPermissionAction(_ interface{}, err error) []*proto.Suggestion {
+	destination := "the image repository"
+	if match := re(kanikoDestination).FindStringSubmatch(err.Error()); len(match) == 2 {
+		destination = match[1]
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION,
+		Action: fmt.Sprintf("Check that %s is the intended destination and that the credentials mounted into the kaniko pod, "+
+			"with `cluster.dockerConfig.secretName` or `cluster.pullSecretName`, are allowed to push to it", destination),
+	}}
+}
+
+// copySource returns the missing source reported by BuildKit, relative to the build context, if any.
+func copySource(err error) string {
+	match := re(copySourceNotFound).FindStringSubmatch(err.Error())
+	for _, path := range match[1:] {
+		if path != "" {
+			return path
+		}
+	}
+	return ""
+}
+
+func copySourceNotFoundDescription(err error) string {
+	if path := copySource(err); path != "" {
+		return fmt.Sprintf("Build Failed. %q, a source of a COPY or ADD instruction of the Dockerfile, is not in the build context", path)
+	}
+	return "Build Failed. A source of a COPY or ADD instruction of the Dockerfile is not in the build context"
+}
+
+func suggestFixCopySource(_ interface{}, err error) []*proto.Suggestion {
+	action := "Check that the sources of the COPY and ADD instructions of the Dockerfile exist in the artifact's `context`," +
+		" which they are relative to, and that they aren't excluded by its `.dockerignore` file"
+	if path := copySource(err); path != "" {
+		action = fmt.Sprintf("Check that %q exists in the artifact's `context`, which the sources of COPY and ADD instructions are relative to,"+
+			" and that it isn't excluded by its `.dockerignore` file", path)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE,
+		Action:         action,
+	}}
+}
+
 func makeAuthSuggestionsForRepo(repo string) *proto.Suggestion {
 	if re(`(.+\.)?gcr\.io.*`).MatchString(repo) || re(`.+-docker\.pkg\.dev.*`).MatchString(repo) {
 		return &proto.Suggestion{
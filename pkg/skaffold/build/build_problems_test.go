@@ -51,6 +51,7 @@ func TestBuildProblems(t *testing.T) {
 		description string
 		context     config.ContextConfig
 		optRepo     string
+		kubeContext string
 		err         error
 		expected    string
 		expectedAE  *proto.ActionableErr
@@ -104,6 +105,49 @@ func TestBuildProblems(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "Artifact Registry permission denied",
+			err:         fmt.Errorf(`could not push image "us-central1-docker.pkg.dev/my-project/my-repo/app:v1": denied: Permission "artifactregistry.repositories.uploadArtifacts" denied on resource "projects/my-project/locations/us-central1/repositories/my-repo" (or it may not exist)`),
+			expected:    "Build Failed. Permission denied pushing to us-central1-docker.pkg.dev/my-project/my-repo. Grant the Artifact Registry Writer role (`roles/artifactregistry.writer`) on us-central1-docker.pkg.dev/my-project/my-repo to the account pushing the image.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+				Message: `could not push image "us-central1-docker.pkg.dev/my-project/my-repo/app:v1": denied: Permission "artifactregistry.repositories.uploadArtifacts" denied on resource "projects/my-project/locations/us-central1/repositories/my-repo" (or it may not exist)`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_GRANT_GCP_REGISTRY_ROLE,
+					Action:         "Grant the Artifact Registry Writer role (`roles/artifactregistry.writer`) on us-central1-docker.pkg.dev/my-project/my-repo to the account pushing the image",
+				}},
+			},
+		},
+		{
+			description: "GCR permission denied on GKE",
+			kubeContext: "gke_my-project_us-central1-a_cluster",
+			err:         fmt.Errorf(`could not push image "gcr.io/my-project/app:v1": denied: Permission denied for "v1" from request "/v2/my-project/app/manifests/v1".`),
+			expected:    "Build Failed. Permission denied pushing to gcr.io/my-project. Grant the Storage Admin role (`roles/storage.admin`) on gcr.io/my-project to the account pushing the image or if building in-cluster on GKE, check that Workload Identity binds the Kubernetes service account to a Google service account with this role.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+				Message: `could not push image "gcr.io/my-project/app:v1": denied: Permission denied for "v1" from request "/v2/my-project/app/manifests/v1".`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_GRANT_GCP_REGISTRY_ROLE,
+					Action:         "Grant the Storage Admin role (`roles/storage.admin`) on gcr.io/my-project to the account pushing the image",
+				}, {
+					SuggestionCode: proto.SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY,
+					Action:         "if building in-cluster on GKE, check that Workload Identity binds the Kubernetes service account to a Google service account with this role",
+				}},
+			},
+		},
+		{
+			description: "Docker Hub access denied is not a Google Cloud registry permission error",
+			err:         fmt.Errorf(`could not push image "docker.io/library/app:v1": denied: requested access to the resource is denied`),
+			expected:    "Build Failed. No push access to specified image repository. Trying running with `--default-repo` flag.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+				Message: `could not push image "docker.io/library/app:v1": denied: requested access to the resource is denied`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_ADD_DEFAULT_REPO,
+					Action:         "Trying running with `--default-repo` flag",
+				}},
+			},
+		},
 		{
 			description: "unknown project error",
 			err:         fmt.Errorf("build failed: could not push image: unknown: Project test"),
@@ -165,6 +209,149 @@ func TestBuildProblems(t *testing.T) {
 				},
 			},
 		},
+		{
+			description: "registry server error",
+			err:         fmt.Errorf(`could not push image "gcr.io/k8s-skaffold/leeroy-web:v1": received unexpected HTTP status: 502 Bad Gateway`),
+			expected: "Build Failed. Registry responded with 502 Bad Gateway while pushing gcr.io/k8s-skaffold/leeroy-web:v1." +
+				" Retry the build as the registry, or a proxy in front of it, returned an invalid response, and check the registry status if the error persists.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_REGISTRY_SERVER_ERR,
+				Message: `could not push image "gcr.io/k8s-skaffold/leeroy-web:v1": received unexpected HTTP status: 502 Bad Gateway`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_RETRY_REGISTRY_PUSH,
+					Action:         "Retry the build as the registry, or a proxy in front of it, returned an invalid response, and check the registry status if the error persists",
+				}},
+			},
+		},
+		{
+			description: "registry unavailable",
+			err:         fmt.Errorf(`received unexpected HTTP status: 503 Service Unavailable`),
+			expected: "Build Failed. Registry responded with 503 Service Unavailable." +
+				" Retry the build as the registry is temporarily unavailable, and check the registry status if the error persists.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_REGISTRY_SERVER_ERR,
+				Message: `received unexpected HTTP status: 503 Service Unavailable`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_RETRY_REGISTRY_PUSH,
+					Action:         "Retry the build as the registry is temporarily unavailable, and check the registry status if the error persists",
+				}},
+			},
+		},
+		{
+			description: "registry host not found",
+			err:         fmt.Errorf(`could not push image "registry.example.com/leeroy-web:v1": Get "https://registry.example.com/v2/": dial tcp: lookup registry.example.com on 127.0.0.53:53: no such host`),
+			expected:    "Could not resolve host \"registry.example.com\". Check that \"registry.example.com\" is spelled correctly and resolves, eg. with `nslookup registry.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if registry.example.com is on a private network.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_HOST_NOT_FOUND,
+				Message: `could not push image "registry.example.com/leeroy-web:v1": Get "https://registry.example.com/v2/": dial tcp: lookup registry.example.com on 127.0.0.53:53: no such host`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_HOST_RESOLUTION,
+					Action:         "Check that \"registry.example.com\" is spelled correctly and resolves, eg. with `nslookup registry.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if registry.example.com is on a private network",
+				}},
+			},
+		},
+		{
+			description: "registry TLS handshake timeout",
+			err:         fmt.Errorf(`docker build: Error response from daemon: Get "https://gcr.io/v2/distroless/base/manifests/latest": net/http: TLS handshake timeout`),
+			expected:    "TLS handshake with registry \"gcr.io\" timed out. Check your network connectivity to gcr.io and any proxy or firewall in between, or configure a pull-through cache closer to you.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_REGISTRY_TLS_TIMEOUT,
+				Message: `docker build: Error response from daemon: Get "https://gcr.io/v2/distroless/base/manifests/latest": net/http: TLS handshake timeout`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+					Action:         "Check your network connectivity to gcr.io and any proxy or firewall in between, or configure a pull-through cache closer to you",
+				}},
+			},
+		},
+		{
+			description: "registry connection reset while pushing",
+			err:         fmt.Errorf(`could not push image "gcr.io/k8s-skaffold/leeroy-web:v1": Put "https://gcr.io/v2/k8s-skaffold/leeroy-web/blobs/uploads/": write tcp 10.0.0.2:51234->74.125.1.82:443: write: connection reset by peer`),
+			expected: "Connection with registry \"gcr.io\" was reset. Try again, as such resets are usually transient." +
+				" If they keep happening, check the MTU of any VPN or proxy between you and gcr.io, and lower the MTU of the Docker daemon, eg. with `\"mtu\": 1400` in its daemon.json.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_REGISTRY_CONNECTION_RESET,
+				Message: `could not push image "gcr.io/k8s-skaffold/leeroy-web:v1": Put "https://gcr.io/v2/k8s-skaffold/leeroy-web/blobs/uploads/": write tcp 10.0.0.2:51234->74.125.1.82:443: write: connection reset by peer`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+					Action: "Try again, as such resets are usually transient." +
+						" If they keep happening, check the MTU of any VPN or proxy between you and gcr.io, and lower the MTU of the Docker daemon, eg. with `\"mtu\": 1400` in its daemon.json",
+				}},
+			},
+		},
+		{
+			description: "invalid image reference",
+			err:         fmt.Errorf(`docker build: Error parsing reference: "gcr.io/k8s-skaffold/Leeroy-Web:latest" is not a valid repository/tag: invalid reference format`),
+			expected: "Invalid image name \"gcr.io/k8s-skaffold/Leeroy-Web:latest\": it is not a valid reference." +
+				" Fix the `image` field of the artifact building \"gcr.io/k8s-skaffold/Leeroy-Web:latest\" in `build.artifacts`: image names must be lowercase, and only contain letters, digits and the `.`, `_`, `-` and `/` separators.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_INVALID_IMAGE_REFERENCE,
+				Message: `docker build: Error parsing reference: "gcr.io/k8s-skaffold/Leeroy-Web:latest" is not a valid repository/tag: invalid reference format`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_IMAGE_NAME,
+					Action:         "Fix the `image` field of the artifact building \"gcr.io/k8s-skaffold/Leeroy-Web:latest\" in `build.artifacts`: image names must be lowercase, and only contain letters, digits and the `.`, `_`, `-` and `/` separators",
+				}},
+			},
+		},
+		{
+			description: "buildkit copy source not found",
+			err:         fmt.Errorf("docker build: failed to solve: failed to compute cache key: failed to calculate checksum of ref 3f1a6c1e::k2m1x: \"/package.json\": not found"),
+			expected: "Build Failed. \"/package.json\", a source of a COPY or ADD instruction of the Dockerfile, is not in the build context." +
+				" Check that \"/package.json\" exists in the artifact's `context`, which the sources of COPY and ADD instructions are relative to, and that it isn't excluded by its `.dockerignore` file.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_COPY_SOURCE_NOT_FOUND,
+				Message: "docker build: failed to solve: failed to compute cache key: failed to calculate checksum of ref 3f1a6c1e::k2m1x: \"/package.json\": not found",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE,
+					Action:         "Check that \"/package.json\" exists in the artifact's `context`, which the sources of COPY and ADD instructions are relative to, and that it isn't excluded by its `.dockerignore` file",
+				}},
+			},
+		},
+		{
+			description: "buildkit copy source not found with older docker versions",
+			err:         fmt.Errorf("running build: failed to compute cache key: failed to walk /var/lib/docker/tmp/buildkit-mount4021/app/dist: lstat /var/lib/docker/tmp/buildkit-mount4021/app/dist: no such file or directory"),
+			expected: "Build Failed. \"/app/dist\", a source of a COPY or ADD instruction of the Dockerfile, is not in the build context." +
+				" Check that \"/app/dist\" exists in the artifact's `context`, which the sources of COPY and ADD instructions are relative to, and that it isn't excluded by its `.dockerignore` file.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_COPY_SOURCE_NOT_FOUND,
+				Message: "running build: failed to compute cache key: failed to walk /var/lib/docker/tmp/buildkit-mount4021/app/dist: lstat /var/lib/docker/tmp/buildkit-mount4021/app/dist: no such file or directory",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE,
+					Action:         "Check that \"/app/dist\" exists in the artifact's `context`, which the sources of COPY and ADD instructions are relative to, and that it isn't excluded by its `.dockerignore` file",
+				}},
+			},
+		},
+		{
+			description: "corrupt local image",
+			err:         fmt.Errorf("docker build: Error response from daemon: readlink /var/lib/docker/overlay2/l: no such file or directory"),
+			expected: "Build Failed. An image of the local Docker daemon is corrupt, as some of its layers are missing from the daemon's storage." +
+				" Remove the corrupt image, usually the base image of the Dockerfile or the image previously built for the artifact, with `docker rmi -f <image>` and build again. If the error persists, restart the Docker daemon.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_CORRUPT_LOCAL_IMAGE,
+				Message: "docker build: Error response from daemon: readlink /var/lib/docker/overlay2/l: no such file or directory",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE,
+					Action: "Remove the corrupt image, usually the base image of the Dockerfile or the image previously built for the artifact," +
+						" with `docker rmi -f <image>` and build again. If the error persists, restart the Docker daemon",
+				}},
+			},
+		},
+		{
+			description: "kaniko push permissions",
+			err: fmt.Errorf("pod has failed: error checking push permissions -- make sure you entered the correct tag name, and that you are authenticated correctly, and try again: " +
+				`checking push permission for "gcr.io/k8s-skaffold/leeroy-web:v1": POST https://gcr.io/v2/k8s-skaffold/leeroy-web/blobs/uploads/: UNAUTHORIZED: You don't have the needed permissions`),
+			expected: "Build Failed. Kaniko can't push to gcr.io/k8s-skaffold/leeroy-web:v1. Check that gcr.io/k8s-skaffold/leeroy-web:v1 is the intended destination and that the credentials mounted into the kaniko pod, " +
+				"with `cluster.dockerConfig.secretName` or `cluster.pullSecretName`, are allowed to push to it.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR,
+				Message: "pod has failed: error checking push permissions -- make sure you entered the correct tag name, and that you are authenticated correctly, and try again: " +
+					`checking push permission for "gcr.io/k8s-skaffold/leeroy-web:v1": POST https://gcr.io/v2/k8s-skaffold/leeroy-web/blobs/uploads/: UNAUTHORIZED: You don't have the needed permissions`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION,
+					Action: "Check that gcr.io/k8s-skaffold/leeroy-web:v1 is the intended destination and that the credentials mounted into the kaniko pod, " +
+						"with `cluster.dockerConfig.secretName` or `cluster.pullSecretName`, are allowed to push to it",
+				}},
+			},
+		},
 		{
 			description: "build cancelled",
 			// See https://github.com/moby/moby/blob/master/client/errors.go#L20
@@ -186,7 +373,7 @@ func TestBuildProblems(t *testing.T) {
 				pc.AddPhaseProblems(constants.Build, problems)
 				return pc
 			})
-			cfg := mockConfig{optRepo: test.optRepo}
+			cfg := mockConfig{optRepo: test.optRepo, kubeContext: test.kubeContext}
 			actual := sErrors.ShowAIError(&cfg, test.err)
 			t.CheckDeepEqual(test.expected, actual.Error())
 			actualAE := sErrors.ActionableErr(&cfg, constants.Build, test.err)
@@ -194,3 +381,19 @@ func TestBuildProblems(t *testing.T) {
 		})
 	}
 }
+
+func TestRecoverableBuildProblems(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+			pc := sErrors.NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Build, problems)
+			return pc
+		})
+
+		t.CheckTrue(sErrors.IsRecoverable(fmt.Errorf("could not push image: received unexpected HTTP status: 504 Gateway Timeout")))
+		t.CheckFalse(sErrors.IsRecoverable(fmt.Errorf("could not push image: received unexpected HTTP status: 404 Not Found")))
+		t.CheckFalse(sErrors.IsRecoverable(fmt.Errorf("skaffold build failed: could not push image: denied: push access to resource")))
+		t.CheckTrue(sErrors.IsRecoverable(fmt.Errorf("pushing image: read tcp 10.0.0.2:51234->10.0.0.3:5000: read: connection reset by peer")))
+		t.CheckFalse(sErrors.IsRecoverable(fmt.Errorf("unable to upgrade connection: read: connection reset by peer")))
+	})
+}
@@ -92,8 +92,9 @@ func TestNewBuilderMux(t *testing.T) {
 }
 
 type mockConfig struct {
-	pipelines []latestV1.Pipeline
-	optRepo   string
+	pipelines   []latestV1.Pipeline
+	optRepo     string
+	kubeContext string
 }
 
 func (m *mockConfig) GetPipelines() []latestV1.Pipeline { return m.pipelines }
@@ -104,7 +105,8 @@ func (m *mockConfig) DefaultRepo() *string {
 	}
 	return nil
 }
-func (m *mockConfig) BuildConcurrency() int { return -1 }
+func (m *mockConfig) BuildConcurrency() int  { return -1 }
+func (m *mockConfig) GetKubeContext() string { return m.kubeContext }
 
 type mockPipelineBuilder struct {
 	concurrency int
@@ -21,6 +21,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
@@ -86,7 +87,7 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 
 	if err := kubernetes.WaitForPodSucceeded(ctx, pods, pod.Name, b.timeout); err != nil {
 		waitForLogs()
-		return "", err
+		return "", withTerminationMessage(ctx, pods, pod.Name, err)
 	}
 
 	waitForLogs()
@@ -97,6 +98,25 @@ func (b *Builder) buildWithKaniko(ctx context.Context, out io.Writer, workspace
 // first copy over the buildcontext tarball into the init container tmp dir via kubectl cp
 // Via kubectl exec, we extract the tarball to the empty dir
 // Then, via kubectl exec, create the /tmp/complete file via kubectl exec to complete the init container
+// withTerminationMessage adds the termination message of the kaniko container, if any, to the
+// error of a failed kaniko pod so that the cause of the failure can be classified.
+func withTerminationMessage(ctx context.Context, pods corev1.PodInterface, podName string, err error) error {
+	pod, getErr := pods.Get(ctx, podName, metav1.GetOptions{})
+	if getErr != nil {
+		logrus.Debugf("unable to get kaniko pod %s: %v", podName, getErr)
+		return err
+	}
+	for _, c := range pod.Status.ContainerStatuses {
+		if c.Name != kaniko.DefaultContainerName || c.State.Terminated == nil {
+			continue
+		}
+		if msg := strings.TrimSpace(c.State.Terminated.Message); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+	}
+	return err
+}
+
 func (b *Builder) copyKanikoBuildContext(ctx context.Context, workspace string, artifactName string, artifact *latestV1.KanikoArtifact, pods corev1.PodInterface, podName string) error {
 	if err := kubernetes.WaitForPodInitialized(ctx, pods, podName); err != nil {
 		return fmt.Errorf("waiting for pod to initialize: %w", err)
@@ -17,10 +17,15 @@ limitations under the License.
 package cluster
 
 import (
+	"context"
+	"errors"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build/kaniko"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
@@ -96,3 +101,44 @@ func TestEnvInterpolation_Latest(t *testing.T) {
 	}
 	testutil.CheckElementsMatch(t, expected, actual)
 }
+
+func TestWithTerminationMessage(t *testing.T) {
+	tests := []struct {
+		description string
+		statuses    []v1.ContainerStatus
+		expected    string
+	}{
+		{
+			description: "kaniko termination message",
+			statuses: []v1.ContainerStatus{{
+				Name:  kaniko.DefaultContainerName,
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1, Message: "error checking push permissions\n"}},
+			}},
+			expected: "pod has failed: error checking push permissions",
+		},
+		{
+			description: "no termination message",
+			statuses: []v1.ContainerStatus{{
+				Name:  kaniko.DefaultContainerName,
+				State: v1.ContainerState{Terminated: &v1.ContainerStateTerminated{ExitCode: 1}},
+			}},
+			expected: "pod has failed",
+		},
+		{
+			description: "container still running",
+			statuses:    []v1.ContainerStatus{{Name: kaniko.DefaultContainerName}},
+			expected:    "pod has failed",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pods := fakekubeclientset.NewSimpleClientset(&v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "kaniko-abc", Namespace: "ns"},
+				Status:     v1.PodStatus{ContainerStatuses: test.statuses},
+			}).CoreV1().Pods("ns")
+
+			err := withTerminationMessage(context.Background(), pods, "kaniko-abc", errors.New("pod has failed"))
+			t.CheckDeepEqual(test.expected, err.Error())
+		})
+	}
+}
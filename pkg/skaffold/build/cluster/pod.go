@@ -65,6 +65,8 @@ func (b *Builder) kanikoPodSpec(artifact *latestV1.KanikoArtifact, tag string) (
 				Env:             b.env(artifact, b.ClusterDetails.HTTPProxy, b.ClusterDetails.HTTPSProxy),
 				VolumeMounts:    []v1.VolumeMount{vm},
 				Resources:       resourceRequirements(b.ClusterDetails.Resources),
+				// kaniko doesn't write a termination message: report the end of its logs instead
+				TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 			}},
 			RestartPolicy: v1.RestartPolicyNever,
 			Volumes: []v1.Volume{{
@@ -329,6 +329,7 @@ func TestKanikoPodSpec(t *testing.T) {
 						v1.ResourceCPU: resource.MustParse("0.5"),
 					},
 				},
+				TerminationMessagePolicy: v1.TerminationMessageFallbackToLogsOnError,
 			}},
 			ServiceAccountName: "aVerySpecialSA",
 			SecurityContext: &v1.PodSecurityContext{
@@ -31,7 +31,8 @@ import (
 )
 
 var (
-	noSpaceLeft = regexp.MustCompile(".*no space left.*")
+	noSpaceLeft       = regexp.MustCompile(".*no space left.*")
+	corruptLocalImage = regexp.MustCompile(docker.CorruptLocalImageErr)
 )
 
 // newBuildError turns Docker-specific errors into actionable errors.
@@ -41,6 +42,10 @@ func newBuildError(err error, cfg docker.Config) error {
 	if errU == nil {
 		return err
 	}
+	// corrupt local images are described by the build problems, which suggest removing the image.
+	if corruptLocalImage.MatchString(err.Error()) {
+		return err
+	}
 
 	switch errU.(type) {
 	case *jsonmessage.JSONError:
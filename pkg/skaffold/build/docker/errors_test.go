@@ -18,12 +18,16 @@ package docker
 
 import (
 	"context"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
 
+	"github.com/docker/docker/errdefs"
+
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
@@ -79,3 +83,15 @@ Refer https://skaffold.dev/docs/references/yaml/#build-artifacts-docker for deta
 		})
 	}
 }
+
+func TestNewBuildErrorCorruptLocalImage(t *testing.T) {
+	testutil.Run(t, "corrupt local images are left to the build problems", func(t *testutil.T) {
+		err := fmt.Errorf("docker build: %w", errdefs.System(fmt.Errorf("Error response from daemon: readlink /var/lib/docker/overlay2/l: no such file or directory")))
+
+		buildErr := newBuildError(err, mockConfig{})
+
+		_, isErrDef := buildErr.(sErrors.ErrDef)
+		t.CheckFalse(isErrDef)
+		t.CheckTrue(buildErr == err)
+	})
+}
@@ -29,9 +29,12 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
 )
@@ -368,6 +371,39 @@ func TestInOrderForArgs(t *testing.T) {
 	}
 }
 
+func TestInOrderErrorStatistics(t *testing.T) {
+	testutil.Run(t, "a failed build is counted once", func(t *testutil.T) {
+		buildErrors := func() int {
+			for _, stat := range sErrors.Statistics() {
+				if stat.Phase == constants.Build && stat.StatusCode == proto.StatusCode_BUILD_UNKNOWN {
+					return stat.Count
+				}
+			}
+			return 0
+		}
+		sErrors.EnableStatistics()
+		before := buildErrors()
+
+		artifacts := []*latestV1.Artifact{{ImageName: "artifact1"}, {ImageName: "artifact2"}}
+		tags := tag.ImageTags{"artifact1": "artifact1@tag1", "artifact2": "artifact2@tag2"}
+		builder := func(c context.Context, _ io.Writer, a *latestV1.Artifact, tag string) (string, error) {
+			if a.ImageName == "artifact2" {
+				return "", fmt.Errorf(`some error occurred while building "artifact2"`)
+			}
+			<-c.Done()
+			return "", c.Err()
+		}
+		initializeEvents()
+		_, err := InOrder(context.Background(), ioutil.Discard, tags, artifacts, builder, 0, NewArtifactStore())
+		t.CheckError(true, err)
+
+		// the command then fails with the build error
+		sErrors.RecordError(nil, "", fmt.Errorf("build failed: %w", err))
+
+		t.CheckDeepEqual(before+1, buildErrors())
+	})
+}
+
 // setDependencies constructs a graph of artifact dependencies using the map as an adjacency list representation of indices in the artifacts array.
 // For example:
 // m = {
@@ -20,9 +20,12 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // These are the list of accepted port-forward modes.
@@ -49,6 +52,64 @@ type PortForwardOptions struct {
 	forwardDebug    bool
 	// compat is true if we're in backwards-compatible mode when --port-forward was boolean
 	compat bool
+
+	// DrainTimeout is how long active connections are given to finish when port forwarding stops.
+	DrainTimeout time.Duration
+	// DeleteGracePeriod is how long the forwards of a deleted pod are kept in case it reappears, eg. after a gap in the watch.
+	DeleteGracePeriod time.Duration
+	// DevImagesOnly restricts pod port forwarding to containers running images built by Skaffold.
+	DevImagesOnly bool
+	// PrimaryContainerOnly restricts pod port forwarding to the primary container of pods with sidecars.
+	PrimaryContainerOnly bool
+	// OnePodPerWorkload forwards a single Ready pod of each workload instead of all its replicas.
+	OnePodPerWorkload bool
+	// OnDemand tracks the pods to forward without forwarding them, until one of their ports is requested through the API.
+	OnDemand bool
+	// PauseDuringRebuilds defers forwarding new pods while the dev loop rebuilds and redeploys,
+	// and forwards the pods running once it's done.
+	PauseDuringRebuilds bool
+	// ReadinessProbe forwards the port targeted by the readinessProbe of pod containers, even if it isn't exposed.
+	ReadinessProbe bool
+	// InCluster forwards ports through Services within the cluster instead of local ports,
+	// for Skaffold running in a pod.
+	InCluster bool
+	// WebSocket serves each port forward as a WebSocket endpoint instead of a plain TCP port.
+	WebSocket bool
+	// Multiplex forwards all the ports of a pod over a single port-forward connection to the API server,
+	// instead of one `kubectl port-forward`, and connection, per port.
+	Multiplex bool
+	// Proxy is the URL of the HTTP CONNECT proxy used to reach the cluster when port forwarding.
+	Proxy string
+	// FieldSelector restricts pod port forwarding to the pods matching a field selector, eg. `spec.nodeName=node-1`.
+	FieldSelector string
+	// LabelCondition only forwards the ports of pods while their labels match it, eg. `track=canary`.
+	LabelCondition string
+	// AllowedPorts, if not empty, restricts pod port forwarding to these container ports.
+	AllowedPorts []int
+	// DeniedPorts are container ports that are never forwarded, eg. 22 or 2375.
+	DeniedPorts []int
+	// HostsAliases registers a `<port name>.<pod name>.local` alias of each forwarded named port of pods
+	// in the system's hosts file, which usually requires administrator privileges.
+	HostsAliases bool
+	// NamespaceOffsets shifts the local ports of the resources of a namespace by its offset,
+	// eg. dev=10000 forwards port 8080 of resources in the `dev` namespace to local port 18080.
+	NamespaceOffsets map[string]int
+	// OpenBrowser opens the port forwards that look like HTTP in the default browser once they're ready.
+	OpenBrowser bool
+	// AdvertiseMDNS advertises the port forwards that look like HTTP as `_http._tcp` services over mDNS once they're ready.
+	AdvertiseMDNS bool
+	// ReservationsFile, if set, is the file remembering the local port of each forwarded resource,
+	// so that resources are forwarded to the same local ports across sessions when they are still free.
+	ReservationsFile string
+	// PortSeed, if not 0, seeds the choice of the local ports that aren't available as requested,
+	// so that runs with the same seed forward resources to the same local ports.
+	PortSeed int64
+	// HealthEndpoints serves the state of each port forward as JSON on a local port of its own, for watchdogs.
+	HealthEndpoints bool
+	// OutputFormat is how the messages of port forwarding are rendered: "text", the default, or "json" for one JSON object per line.
+	OutputFormat string
+	// KubeContexts are other kube-contexts than the current one whose pods are forwarded too, for multi-cluster development.
+	KubeContexts []string
 }
 
 var _ pflag.Value = (*PortForwardOptions)(nil)
@@ -158,6 +219,23 @@ func (p PortForwardOptions) Enabled() bool {
 	return true
 }
 
+// Validate checks that the port forwarding options are well-formed and can be used together.
+func (p PortForwardOptions) Validate() error {
+	if p.InCluster && p.Multiplex {
+		return fmt.Errorf("--port-forward-in-cluster can't be combined with --port-forward-multiplex: in-cluster forwards don't connect to pods")
+	}
+	if p.InCluster && p.WebSocket {
+		return fmt.Errorf("--port-forward-in-cluster can't be combined with --port-forward-websocket: in-cluster forwards have no local port")
+	}
+	if _, err := fields.ParseSelector(p.FieldSelector); err != nil {
+		return fmt.Errorf("invalid --port-forward-field-selector %q: %w", p.FieldSelector, err)
+	}
+	if _, err := labels.Parse(p.LabelCondition); err != nil {
+		return fmt.Errorf("invalid --port-forward-label-condition %q: %w", p.LabelCondition, err)
+	}
+	return nil
+}
+
 // validateModes checks that the given set of port-forward modes are ok.
 // For example, `off` and boolean values should not be combined with other values.
 func validateModes(modes []string) error {
@@ -114,3 +114,26 @@ func TestPortForwardOptions_Forwards(t *testing.T) {
 		}
 	}
 }
+
+func TestPortForwardOptions_Validate(t *testing.T) {
+	tests := []struct {
+		description string
+		options     PortForwardOptions
+		shouldErr   bool
+	}{
+		{description: "defaults"},
+		{description: "in cluster", options: PortForwardOptions{InCluster: true}},
+		{description: "multiplexed websockets", options: PortForwardOptions{Multiplex: true, WebSocket: true}},
+		{description: "in cluster and multiplexed", options: PortForwardOptions{InCluster: true, Multiplex: true}, shouldErr: true},
+		{description: "in cluster and websockets", options: PortForwardOptions{InCluster: true, WebSocket: true}, shouldErr: true},
+		{description: "field selector", options: PortForwardOptions{FieldSelector: "spec.nodeName=node-1"}},
+		{description: "invalid field selector", options: PortForwardOptions{FieldSelector: "spec.nodeName"}, shouldErr: true},
+		{description: "label condition", options: PortForwardOptions{LabelCondition: "track in (canary,stable)"}},
+		{description: "invalid label condition", options: PortForwardOptions{LabelCondition: "track in (canary"}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckError(test.shouldErr, test.options.Validate())
+		})
+	}
+}
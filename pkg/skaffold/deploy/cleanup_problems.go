@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches the deletion of a resource that's already gone, e.g.
+	// the server could not find the requested resource (delete deployments.apps web)
+	resourceAlreadyDeletedErr = regexp.MustCompile(`(?:Error from server \(NotFound\): )?the server could not find the requested resource \(delete ([^)]+)\)`)
+
+	// matches the other failures a cleanup error can report along with resources that are already deleted, e.g.
+	// Error from server (Forbidden): deployments.apps "web" is forbidden
+	cleanupFailureErr = regexp.MustCompile(`(?i)error|fail|forbidden|denied|unauthorized|refused|timeout|timed out|invalid`)
+
+	cleanupProblems = []sErrors.Problem{
+		{
+			Regexp:      resourceAlreadyDeletedErr,
+			ErrCode:     proto.StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND,
+			Description: resourceAlreadyDeletedDescription,
+			Benign:      true,
+		},
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.Cleanup, cleanupProblems)
+}
+
+func resourceAlreadyDeletedDescription(err error) string {
+	var resources []string
+	for _, match := range resourceAlreadyDeletedErr.FindAllStringSubmatch(err.Error(), -1) {
+		resources = append(resources, match[1])
+	}
+	switch len(resources) {
+	case 0:
+		return "Resources are already deleted"
+	case 1:
+		return fmt.Sprintf("Resource %s is already deleted", resources[0])
+	default:
+		return fmt.Sprintf("Resources %s are already deleted", strings.Join(resources, ", "))
+	}
+}
+
+// onlyAlreadyDeleted returns true if every failure reported by a cleanup error is a resource that's already deleted.
+func onlyAlreadyDeleted(err error) bool {
+	return !cleanupFailureErr.MatchString(resourceAlreadyDeletedErr.ReplaceAllString(err.Error(), ""))
+}
+
+// ReportBenignCleanupErr reports the cleanup errors that don't need to fail the cleanup,
+// eg. when resources are already deleted, and returns nil for them. Other errors are returned as is,
+// including the ones that report resources already deleted along with other failures.
+func ReportBenignCleanupErr(out io.Writer, err error) error {
+	if !sErrors.IsBenign(sErrors.WithPhase(constants.Cleanup, err)) || !onlyAlreadyDeleted(err) {
+		return err
+	}
+	output.Yellow.Fprintln(out, resourceAlreadyDeletedDescription(err))
+	return nil
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestResourceAlreadyDeletedProblem(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+			pc := sErrors.NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Cleanup, cleanupProblems)
+			return pc
+		})
+		err := fmt.Errorf("Error from server (NotFound): the server could not find the requested resource (delete deployments.apps web)")
+
+		t.CheckDeepEqual("Resource deployments.apps web is already deleted", sErrors.ShowAIError(nil, err).Error())
+		t.CheckDeepEqual(proto.StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND, sErrors.ActionableErr(nil, constants.Cleanup, err).ErrCode)
+		t.CheckTrue(sErrors.IsBenign(err))
+	})
+}
+
+func TestReportBenignCleanupErr(t *testing.T) {
+	tests := []struct {
+		description    string
+		err            error
+		shouldErr      bool
+		expectedOutput string
+	}{
+		{
+			description: "no error",
+		},
+		{
+			description:    "resource already deleted",
+			err:            fmt.Errorf("the server could not find the requested resource (delete foos.example.com bar)"),
+			expectedOutput: "Resource foos.example.com bar is already deleted\n",
+		},
+		{
+			description:    "resources already deleted",
+			err:            fmt.Errorf("kubectl delete: exit status 1\nError from server (NotFound): the server could not find the requested resource (delete deployments.apps web)\nError from server (NotFound): the server could not find the requested resource (delete services web)"),
+			expectedOutput: "Resources deployments.apps web, services web are already deleted\n",
+		},
+		{
+			description: "resource already deleted along with other failures",
+			err:         fmt.Errorf("Error from server (NotFound): the server could not find the requested resource (delete deployments.apps web)\nError from server (Forbidden): services \"web\" is forbidden: User \"dev\" cannot delete resource \"services\""),
+			shouldErr:   true,
+		},
+		{
+			description: "resource already deleted along with a timeout",
+			err:         fmt.Errorf("uninstall: the server could not find the requested resource (delete foos.example.com bar); timed out waiting for the condition"),
+			shouldErr:   true,
+		},
+		{
+			description: "other error",
+			err:         fmt.Errorf("kubectl delete: exit status 1"),
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var out bytes.Buffer
+
+			err := ReportBenignCleanupErr(&out, test.err)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
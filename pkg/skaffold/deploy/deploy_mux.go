@@ -143,7 +143,7 @@ func (m DeployerMux) Dependencies() ([]string, error) {
 func (m DeployerMux) Cleanup(ctx context.Context, w io.Writer) error {
 	for _, deployer := range m.deployers {
 		ctx, endTrace := instrumentation.StartTrace(ctx, "Cleanup")
-		if err := deployer.Cleanup(ctx, w); err != nil {
+		if err := ReportBenignCleanupErr(w, deployer.Cleanup(ctx, w)); err != nil {
 			return err
 		}
 		endTrace()
@@ -45,6 +45,7 @@ type MockDeployer struct {
 	dependencies     []string
 	dependenciesErr  error
 	cleanupErr       error
+	cleanedUp        bool
 	renderResult     string
 	renderErr        error
 }
@@ -78,6 +79,7 @@ func (m *MockDeployer) Dependencies() ([]string, error) {
 }
 
 func (m *MockDeployer) Cleanup(context.Context, io.Writer) error {
+	m.cleanedUp = true
 	return m.cleanupErr
 }
 
@@ -243,6 +245,46 @@ func TestDeployerMux_Dependencies(t *testing.T) {
 	}
 }
 
+func TestDeployerMux_Cleanup(t *testing.T) {
+	tests := []struct {
+		name           string
+		err1           error
+		shouldErr      bool
+		expectedOutput string
+	}{
+		{
+			name: "all deployers are cleaned up",
+		},
+		{
+			name:      "short-circuits when first call fails",
+			err1:      fmt.Errorf("failed in first"),
+			shouldErr: true,
+		},
+		{
+			name:           "resources already deleted",
+			err1:           fmt.Errorf("uninstall: the server could not find the requested resource (delete foos.example.com bar)"),
+			expectedOutput: "Resource foos.example.com bar is already deleted\n",
+		},
+	}
+
+	for _, test := range tests {
+		testutil.Run(t, test.name, func(t *testutil.T) {
+			second := NewMockDeployer()
+			deployerMux := NewDeployerMux([]Deployer{
+				NewMockDeployer().WithCleanupErr(test.err1),
+				second,
+			}, false)
+
+			var out bytes.Buffer
+			err := deployerMux.Cleanup(context.Background(), &out)
+
+			t.CheckError(test.shouldErr, err)
+			t.CheckDeepEqual(!test.shouldErr, second.cleanedUp)
+			t.CheckDeepEqual(test.expectedOutput, out.String())
+		})
+	}
+}
+
 func TestDeployerMux_Render(t *testing.T) {
 	tests := []struct {
 		name           string
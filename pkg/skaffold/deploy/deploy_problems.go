@@ -29,7 +29,15 @@ import (
 
 var (
 	clusterConnectionErr = regexp.MustCompile("(?i).*unable to connect.*: Get (.*)")
-	problems             = []sErrors.Problem{
+	// manifestYAMLErr matches manifests with misplaced mapping values, usually because of their indentation, eg.
+	// `error: error parsing k8s/deployment.yaml: error converting YAML to JSON: yaml: line 12: mapping values are not allowed in this context`
+	manifestYAMLErr = regexp.MustCompile(`error parsing (\S+?): error converting YAML to JSON: yaml: line (\d+):(?: column (\d+):)? mapping values are not allowed`)
+	problems        = []sErrors.Problem{
+		sErrors.HostNotFoundProblem(proto.StatusCode_DEPLOY_HOST_NOT_FOUND),
+		sErrors.RegistryTLSTimeoutProblem(proto.StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT),
+		sErrors.RegistryConnectionResetProblem(proto.StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET),
+		sErrors.InvalidImageReferenceProblem(proto.StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE),
+		sErrors.ClusterUnknownAuthorityProblem(proto.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY),
 		{
 			Regexp:  clusterConnectionErr,
 			ErrCode: proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR,
@@ -41,6 +49,15 @@ var (
 			},
 			Suggestion: suggestDeployFailedAction,
 		},
+		{
+			Regexp:           manifestYAMLErr,
+			ErrCode:          proto.StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR,
+			Description:      manifestYAMLDescription,
+			SuggestionForErr: manifestYAMLSuggestion,
+		},
+		deployerr.KubectlNotLoggedInProblem(nil),
+		deployerr.ClusterUnauthorizedProblem(proto.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED, nil),
+		validationSchemaProblem,
 	}
 )
 
@@ -60,6 +77,39 @@ func suggestDeployFailedAction(cfg interface{}) []*proto.Suggestion {
 	}}
 }
 
+// manifestYAMLPosition returns the manifest file, and the position of the misplaced mapping value in it.
+func manifestYAMLPosition(err error) (file string, position string, found bool) {
+	match := manifestYAMLErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return "", "", false
+	}
+	position = "line " + match[2]
+	if match[3] != "" {
+		position += ", column " + match[3]
+	}
+	return match[1], position, true
+}
+
+func manifestYAMLDescription(err error) string {
+	file, position, found := manifestYAMLPosition(err)
+	if !found {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. Manifest %s is not valid YAML: mapping values are not allowed at %s", file, position)
+}
+
+func manifestYAMLSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	file, position, found := manifestYAMLPosition(err)
+	if !found {
+		return nil
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_FIX_MANIFEST_YAML,
+		Action: fmt.Sprintf("Fix the indentation at %s of %s, or quote the value if it contains `: `, and validate the file with a YAML linter, eg. `yamllint %s`",
+			position, file, file),
+	}}
+}
+
 func init() {
 	sErrors.AddPhaseProblems(constants.Deploy, problems)
 }
@@ -81,6 +81,174 @@ func TestSuggestDeployFailedAction(t *testing.T) {
 				}},
 			},
 		},
+		{
+			description: "api server host not found",
+			context:     "corp",
+			err:         fmt.Errorf(`exiting dev mode because first deploy failed: unable to connect to Kubernetes: Get "https://k8s.internal.example.com:6443/version?timeout=32s": dial tcp: lookup k8s.internal.example.com: no such host`),
+			expected:    "Could not resolve host \"k8s.internal.example.com\". Check that \"k8s.internal.example.com\" is spelled correctly and resolves, eg. with `nslookup k8s.internal.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if k8s.internal.example.com is on a private network.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_HOST_NOT_FOUND,
+				Message: `exiting dev mode because first deploy failed: unable to connect to Kubernetes: Get "https://k8s.internal.example.com:6443/version?timeout=32s": dial tcp: lookup k8s.internal.example.com: no such host`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_HOST_RESOLUTION,
+					Action:         "Check that \"k8s.internal.example.com\" is spelled correctly and resolves, eg. with `nslookup k8s.internal.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if k8s.internal.example.com is on a private network",
+				}},
+			},
+		},
+		{
+			description: "invalid image reference",
+			err:         fmt.Errorf("parsing image tag: invalid reference format: repository name must be lowercase"),
+			expected: "Invalid image name: repository names must be lowercase." +
+				" Fix the `image` field of the artifacts in `build.artifacts`: image names must be lowercase, and only contain letters, digits and the `.`, `_`, `-` and `/` separators.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE,
+				Message: "parsing image tag: invalid reference format: repository name must be lowercase",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_IMAGE_NAME,
+					Action:         "Fix the `image` field of the artifacts in `build.artifacts`: image names must be lowercase, and only contain letters, digits and the `.`, `_`, `-` and `/` separators",
+				}},
+			},
+		},
+		{
+			description: "registry TLS handshake timeout while pulling",
+			err:         fmt.Errorf(`failed to pull image "registry.example.com:5000/leeroy-web:v1": rpc error: code = Unknown desc = net/http: TLS handshake timeout`),
+			expected:    "TLS handshake with registry \"registry.example.com:5000\" timed out. Check your network connectivity to registry.example.com:5000 and any proxy or firewall in between, or configure a pull-through cache closer to you.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT,
+				Message: `failed to pull image "registry.example.com:5000/leeroy-web:v1": rpc error: code = Unknown desc = net/http: TLS handshake timeout`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+					Action:         "Check your network connectivity to registry.example.com:5000 and any proxy or firewall in between, or configure a pull-through cache closer to you",
+				}},
+			},
+		},
+		{
+			description: "registry connection reset while pulling",
+			err:         fmt.Errorf(`failed to pull image "leeroy-web:v1": rpc error: code = Unknown desc = read tcp 10.0.0.2:51234->104.18.121.25:443: read: connection reset by peer`),
+			expected: "Connection with registry \"docker.io\" was reset. Try again, as such resets are usually transient." +
+				" If they keep happening, check the MTU of any VPN or proxy between you and docker.io, and lower the MTU of the Docker daemon, eg. with `\"mtu\": 1400` in its daemon.json.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET,
+				Message: `failed to pull image "leeroy-web:v1": rpc error: code = Unknown desc = read tcp 10.0.0.2:51234->104.18.121.25:443: read: connection reset by peer`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+					Action: "Try again, as such resets are usually transient." +
+						" If they keep happening, check the MTU of any VPN or proxy between you and docker.io, and lower the MTU of the Docker daemon, eg. with `\"mtu\": 1400` in its daemon.json",
+				}},
+			},
+		},
+		{
+			description: "api server certificate signed by unknown authority",
+			err:         fmt.Errorf(`getting pods: Get "https://10.0.0.1:6443/api/v1/namespaces/default/pods?labelSelector=app": x509: certificate signed by unknown authority`),
+			expected: "The certificate of the cluster's API server 10.0.0.1:6443 is signed by an unknown authority." +
+				" Set the CA bundle of the cluster in your kubeconfig, eg. with `kubectl config set-cluster <cluster> --certificate-authority=<ca.crt> --embed-certs`, or, for test clusters only, mark the cluster insecure with `kubectl config set-cluster <cluster> --insecure-skip-tls-verify=true`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY,
+				Message: `getting pods: Get "https://10.0.0.1:6443/api/v1/namespaces/default/pods?labelSelector=app": x509: certificate signed by unknown authority`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CONFIGURE_CLUSTER_CA,
+					Action:         "Set the CA bundle of the cluster in your kubeconfig, eg. with `kubectl config set-cluster <cluster> --certificate-authority=<ca.crt> --embed-certs`, or, for test clusters only, mark the cluster insecure with `kubectl config set-cluster <cluster> --insecure-skip-tls-verify=true`",
+				}},
+			},
+		},
+		{
+			description: "registry certificate signed by unknown authority",
+			err:         fmt.Errorf(`failed to pull image "registry.internal:5000/app:v1": rpc error: code = Unknown desc = Get "https://registry.internal:5000/v2/": x509: certificate signed by unknown authority`),
+			expected:    `failed to pull image "registry.internal:5000/app:v1": rpc error: code = Unknown desc = Get "https://registry.internal:5000/v2/": x509: certificate signed by unknown authority`,
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_DEPLOY_UNKNOWN,
+				Message:     `failed to pull image "registry.internal:5000/app:v1": rpc error: code = Unknown desc = Get "https://registry.internal:5000/v2/": x509: certificate signed by unknown authority`,
+				Suggestions: sErrors.ReportIssueSuggestion(nil),
+			},
+		},
+		{
+			description: "unauthorized on gke",
+			context:     "gke_project_zone_cluster",
+			err:         fmt.Errorf("getting pods: Unauthorized"),
+			expected:    "The cluster rejected the credentials in use: getting pods: Unauthorized. Refresh your credentials with `gcloud auth login` and try again.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED,
+				Message: "getting pods: Unauthorized",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_REFRESH_CLUSTER_CREDENTIALS,
+					Action:         "Refresh your credentials with `gcloud auth login` and try again",
+				}},
+			},
+		},
+		{
+			description: "kubectl not logged in with exec plugin",
+			context:     "eks",
+			err:         fmt.Errorf("error: You must be logged in to the server (Unauthorized)"),
+			expected: "Deploy Failed. kubectl is not logged in to the cluster." +
+				" Log in to the cluster of kube-context \"eks\" again, eg. by refreshing its credentials or the `exec` credential plugin configured for it in your kubeconfig," +
+				" and check that `kubectl --context eks get namespaces` succeeds.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN,
+				Message: "error: You must be logged in to the server (Unauthorized)",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_LOGIN_TO_CLUSTER,
+					Action: "Log in to the cluster of kube-context \"eks\" again, eg. by refreshing its credentials or the `exec` credential plugin configured for it in your kubeconfig," +
+						" and check that `kubectl --context eks get namespaces` succeeds",
+				}},
+			},
+		},
+		{
+			description: "kubectl not logged in on gke",
+			context:     "gke_project_zone_cluster",
+			err:         fmt.Errorf("kubectl apply: error: You must be logged in to the server (Unauthorized)"),
+			expected: "Deploy Failed. kubectl is not logged in to the cluster." +
+				" Log in again with `gcloud auth login`, and check that `kubectl --context gke_project_zone_cluster get namespaces` succeeds.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN,
+				Message: "kubectl apply: error: You must be logged in to the server (Unauthorized)",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_LOGIN_TO_CLUSTER,
+					Action:         "Log in again with `gcloud auth login`, and check that `kubectl --context gke_project_zone_cluster get namespaces` succeeds",
+				}},
+			},
+		},
+		{
+			description: "manifest with misplaced mapping value",
+			err:         fmt.Errorf("kubectl create: running [kubectl --context kind-kind create --dry-run=client -oyaml -f k8s/deployment.yaml]\n - stdout: \"\"\n - stderr: \"error: error parsing k8s/deployment.yaml: error converting YAML to JSON: yaml: line 12: mapping values are not allowed in this context\\n\""),
+			expected: "Deploy Failed. Manifest k8s/deployment.yaml is not valid YAML: mapping values are not allowed at line 12." +
+				" Fix the indentation at line 12 of k8s/deployment.yaml, or quote the value if it contains `: `, and validate the file with a YAML linter, eg. `yamllint k8s/deployment.yaml`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR,
+				Message: "kubectl create: running [kubectl --context kind-kind create --dry-run=client -oyaml -f k8s/deployment.yaml]\n - stdout: \"\"\n - stderr: \"error: error parsing k8s/deployment.yaml: error converting YAML to JSON: yaml: line 12: mapping values are not allowed in this context\\n\"",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_MANIFEST_YAML,
+					Action:         "Fix the indentation at line 12 of k8s/deployment.yaml, or quote the value if it contains `: `, and validate the file with a YAML linter, eg. `yamllint k8s/deployment.yaml`",
+				}},
+			},
+		},
+		{
+			description: "manifest with misplaced mapping value at a column",
+			err:         fmt.Errorf("error: error parsing manifests/app.yaml: error converting YAML to JSON: yaml: line 4: column 9: mapping values are not allowed in this context"),
+			expected: "Deploy Failed. Manifest manifests/app.yaml is not valid YAML: mapping values are not allowed at line 4, column 9." +
+				" Fix the indentation at line 4, column 9 of manifests/app.yaml, or quote the value if it contains `: `, and validate the file with a YAML linter, eg. `yamllint manifests/app.yaml`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR,
+				Message: "error: error parsing manifests/app.yaml: error converting YAML to JSON: yaml: line 4: column 9: mapping values are not allowed in this context",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_MANIFEST_YAML,
+					Action:         "Fix the indentation at line 4, column 9 of manifests/app.yaml, or quote the value if it contains `: `, and validate the file with a YAML linter, eg. `yamllint manifests/app.yaml`",
+				}},
+			},
+		},
+		{
+			description: "validation schema can't be loaded",
+			err:         fmt.Errorf("kubectl apply: validation failed: unable to load schema: the server could not find the requested resource"),
+			expected: "Could not validate the manifests: the OpenAPI schema couldn't be loaded from the API server: the server could not find the requested resource." +
+				" Check that the API server can be reached, or turn client-side validation off with `--validate=false`, eg. by setting `deploy.kubectl.flags.disableValidation: true`, for clusters whose schema can't be fetched such as air-gapped ones.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE,
+				Message: "kubectl apply: validation failed: unable to load schema: the server could not find the requested resource",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_DISABLE_CLIENT_VALIDATION,
+					Action:         "Check that the API server can be reached, or turn client-side validation off with `--validate=false`, eg. by setting `deploy.kubectl.flags.disableValidation: true`, for clusters whose schema can't be fetched such as air-gapped ones",
+				}},
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
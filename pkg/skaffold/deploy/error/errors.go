@@ -17,6 +17,7 @@ limitations under the License.
 package error
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -35,6 +36,59 @@ const (
 
 var (
 	clusterInternalSystemErr = regexp.MustCompile(".*Internal Server Error")
+	// matches kubectl reporting that it isn't logged in to the cluster, which is a more specific
+	// phrasing of the API server rejecting the credentials, so it's matched first, e.g.
+	// error: You must be logged in to the server (Unauthorized)
+	kubectlNotLoggedInErr = regexp.MustCompile(`You must be logged in to the server(?: \(Unauthorized\))?`)
+	// matches the API server rejecting expired or invalid credentials
+	clusterUnauthorizedErr = regexp.MustCompile(`\bUnauthorized\b|the server has asked for the client to provide credentials`)
+	// matches `kubectl apply` schema validation errors, e.g.
+	// error validating data: ValidationError(Deployment.spec): unknown field "replica" in io.k8s.api.apps.v1.DeploymentSpec
+	manifestValidationErr = regexp.MustCompile(`error validating data: ValidationError\((\w+)(?:\.([^)]+))?\): ([^;\n]+)`)
+	// matches resources rejected by an admission webhook, e.g.
+	// admission webhook "validation.gatekeeper.sh" denied the request: [required-labels] you must provide labels: {"owner"}
+	admissionWebhookDeniedErr = regexp.MustCompile(`admission webhook "([^"]+)" denied the request: ([^\n]+)`)
+	// matches resources whose annotations exceed the API server's limit, usually because of the
+	// last-applied-configuration annotation of large manifests, e.g.
+	// The CustomResourceDefinition "prometheuses.monitoring.coreos.com" is invalid: metadata.annotations: Too long: must have at most 262144 bytes
+	annotationsTooLongErr = regexp.MustCompile(`(?:The (\w+) "([^"]+)" is invalid: )?metadata\.annotations: Too long: must have at most (\d+) bytes`)
+	// matches changes to immutable fields of existing resources, usually the selector of a workload, e.g.
+	// The Deployment "web" is invalid: spec.selector: Invalid value: v1.LabelSelector{...}: field is immutable
+	immutableFieldErr = regexp.MustCompile(`(\w+)(?:\.[\w.]+)? "([^"]+)" is invalid: ([\w.\[\]]+): [^\n]*field is immutable`)
+	// matches resources referencing a Secret or ConfigMap that doesn't exist, as reported by the API server
+	// or by the kubelet, e.g. secrets "db-credentials" not found, or configmap "app-config" not found
+	referencedResourceNotFoundErr = regexp.MustCompile(`\b(secret|configmap)s? "([^"]+)" not found`)
+	// matches resources of an API version that the cluster doesn't serve, e.g.
+	// error: unable to recognize "STDIN": no matches for kind "Ingress" in version "extensions/v1beta1"
+	noKindMatchErr = regexp.MustCompile(`no matches for kind "?(\w+)"? in version "?((?:[\w.-]+/)?v\w+)"?`)
+	// matches containers without an image, usually because Skaffold didn't replace the image of the manifest, e.g.
+	// The Deployment "web" is invalid: spec.template.spec.containers[0].image: Required value
+	emptyContainerImageErr = regexp.MustCompile(`(?:The (\w+) "([^"]+)" is invalid: )?((?:[\w.]+\.)?(?:initContainers|containers|ephemeralContainers)\[\d+\]\.image): Required value`)
+	// stableAPIVersions are the API versions that replace the beta versions of built-in kinds removed in Kubernetes 1.16 to 1.26.
+	stableAPIVersions = map[string]string{
+		"ClusterRole":                    "rbac.authorization.k8s.io/v1",
+		"ClusterRoleBinding":             "rbac.authorization.k8s.io/v1",
+		"CronJob":                        "batch/v1",
+		"CustomResourceDefinition":       "apiextensions.k8s.io/v1",
+		"DaemonSet":                      "apps/v1",
+		"Deployment":                     "apps/v1",
+		"HorizontalPodAutoscaler":        "autoscaling/v2",
+		"Ingress":                        "networking.k8s.io/v1",
+		"IngressClass":                   "networking.k8s.io/v1",
+		"MutatingWebhookConfiguration":   "admissionregistration.k8s.io/v1",
+		"NetworkPolicy":                  "networking.k8s.io/v1",
+		"PodDisruptionBudget":            "policy/v1",
+		"PriorityClass":                  "scheduling.k8s.io/v1",
+		"ReplicaSet":                     "apps/v1",
+		"Role":                           "rbac.authorization.k8s.io/v1",
+		"RoleBinding":                    "rbac.authorization.k8s.io/v1",
+		"StatefulSet":                    "apps/v1",
+		"StorageClass":                   "storage.k8s.io/v1",
+		"ValidatingWebhookConfiguration": "admissionregistration.k8s.io/v1",
+	}
+
+	// the certificate of the API server is signed by a CA that kubectl doesn't trust
+	clusterUnknownAuthority = sErrors.ClusterUnknownAuthorityProblem(proto.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY)
 
 	// for testing
 	internalSystemErrSuggestion = internalSystemErrSuggestionFunc
@@ -73,20 +127,167 @@ func UserError(err error, sc proto.StatusCode) error {
 	if sErrors.IsSkaffoldErr(err) {
 		return err
 	}
-	if clusterInternalSystemErr.MatchString(err.Error()) {
+	if p, ok := userProblem(err); ok {
+		return p
+	}
+	return sErrors.NewError(err,
+		proto.ActionableErr{
+			Message: err.Error(),
+			ErrCode: sc,
+		})
+}
+
+// ApplyError classifies the error of a deployer applying resources, using the deployer's output.
+// When the output reports per-resource results, the error lists the resources that failed to apply.
+func ApplyError(err error, output string, sc proto.StatusCode) error {
+	var suggestions []*proto.Suggestion
+	withOutput := fmt.Errorf("%w: %s", err, strings.TrimSpace(output))
+	p, known := userProblem(withOutput)
+	if known {
+		sc = p.ErrCode
+		var aiErr sErrors.Error
+		if errors.As(p.AIError(nil, withOutput), &aiErr) {
+			suggestions = aiErr.Suggestions()
+		}
+	}
+	if report := sErrors.NewApplyErr(err, output, sc, suggestions); report != nil {
+		return report
+	}
+	if known {
+		return p
+	}
+	return UserError(err, sc)
+}
+
+// userProblem matches errors caused by the user's cluster or manifests.
+func userProblem(err error) (sErrors.Problem, bool) {
+	switch {
+	case clusterInternalSystemErr.MatchString(err.Error()):
 		return sErrors.NewProblem(
 			func(err error) string {
 				return fmt.Sprintf("Deploy Failed. %v", err)
 			},
 			proto.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR,
 			internalSystemErrSuggestion,
-			err)
+			err), true
+	case clusterUnknownAuthority.Regexp.MatchString(err.Error()):
+		p := clusterUnknownAuthority
+		p.Err = err
+		return p, true
+	case kubectlNotLoggedInErr.MatchString(err.Error()):
+		return KubectlNotLoggedInProblem(err), true
+	case clusterUnauthorizedErr.MatchString(err.Error()):
+		return ClusterUnauthorizedProblem(proto.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED, err), true
+	case manifestValidationErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      manifestValidationErrDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			SuggestionForErr: manifestValidationErrSuggestion,
+			Err:              err,
+		}, true
+	case admissionWebhookDeniedErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      admissionWebhookDeniedDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED,
+			SuggestionForErr: admissionWebhookDeniedSuggestion,
+			Err:              err,
+		}, true
+	case annotationsTooLongErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      annotationsTooLongDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG,
+			SuggestionForErr: annotationsTooLongSuggestion,
+			Err:              err,
+		}, true
+	case immutableFieldErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      immutableFieldDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_IMMUTABLE_FIELD,
+			SuggestionForErr: immutableFieldSuggestion,
+			Err:              err,
+		}, true
+	case emptyContainerImageErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      emptyContainerImageDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE,
+			SuggestionForErr: emptyContainerImageSuggestion,
+			Err:              err,
+		}, true
+	case isRemovedAPIVersion(err):
+		return sErrors.Problem{
+			Description:      removedAPIVersionDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_REMOVED_API_VERSION,
+			SuggestionForErr: removedAPIVersionSuggestion,
+			Err:              err,
+		}, true
+	case referencedResourceNotFoundErr.MatchString(err.Error()):
+		return sErrors.Problem{
+			Description:      referencedResourceNotFoundDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND,
+			SuggestionForErr: referencedResourceNotFoundSuggestion,
+			Err:              err,
+		}, true
+	}
+	return sErrors.Problem{}, false
+}
+
+// ClusterUnauthorizedProblem is the problem of the API server rejecting the credentials in use.
+// Every phase talking to the API server can hit it, so the status code is the calling phase's.
+func ClusterUnauthorizedProblem(sc proto.StatusCode, err error) sErrors.Problem {
+	return sErrors.Problem{
+		Regexp:  clusterUnauthorizedErr,
+		ErrCode: sc,
+		Description: func(err error) string {
+			return fmt.Sprintf("The cluster rejected the credentials in use: %v", err)
+		},
+		Suggestion: clusterUnauthorizedSuggestion,
+		Err:        err,
 	}
-	return sErrors.NewError(err,
-		proto.ActionableErr{
-			Message: err.Error(),
-			ErrCode: sc,
-		})
+}
+
+func clusterUnauthorizedSuggestion(cfg interface{}) []*proto.Suggestion {
+	action := "Refresh your cluster credentials, eg. with `gcloud auth login`, or check the `exec` credential plugin configured in your kubeconfig"
+	if kubeCfg, ok := cfg.(interface{ GetKubeContext() string }); ok {
+		if kubeContext := kubeCfg.GetKubeContext(); strings.HasPrefix(kubeContext, "gke_") {
+			action = "Refresh your credentials with `gcloud auth login` and try again"
+		} else if kubeContext != "" {
+			action = fmt.Sprintf("Refresh the credentials of kube-context %q or check the `exec` credential plugin configured for it in your kubeconfig", kubeContext)
+		}
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_REFRESH_CLUSTER_CREDENTIALS,
+		Action:         action,
+	}}
+}
+
+// KubectlNotLoggedInProblem is the problem of kubectl not being logged in to the cluster during deploy.
+// It's a special case of ClusterUnauthorizedProblem, and must be matched before it.
+func KubectlNotLoggedInProblem(err error) sErrors.Problem {
+	return sErrors.Problem{
+		Regexp:  kubectlNotLoggedInErr,
+		ErrCode: proto.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN,
+		Description: func(error) string {
+			return "Deploy Failed. kubectl is not logged in to the cluster"
+		},
+		Suggestion: kubectlNotLoggedInSuggestion,
+		Err:        err,
+	}
+}
+
+func kubectlNotLoggedInSuggestion(cfg interface{}) []*proto.Suggestion {
+	action := "Log in to the cluster of the current kube-context again, eg. by refreshing its credentials in your kubeconfig, and check that `kubectl get namespaces` succeeds"
+	if deployCfg, ok := cfg.(types.Config); ok {
+		if kubeContext := deployCfg.GetKubeContext(); strings.HasPrefix(kubeContext, "gke_") {
+			action = fmt.Sprintf("Log in again with `gcloud auth login`, and check that `kubectl --context %s get namespaces` succeeds", kubeContext)
+		} else if kubeContext != "" {
+			action = fmt.Sprintf("Log in to the cluster of kube-context %q again, eg. by refreshing its credentials or the `exec` credential plugin configured for it in your kubeconfig,"+
+				" and check that `kubectl --context %s get namespaces` succeeds", kubeContext, kubeContext)
+		}
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_LOGIN_TO_CLUSTER,
+		Action:         action,
+	}}
 }
 
 func CheckMinikubeStatusSuggestion(cfg types.Config) *proto.Suggestion {
@@ -123,3 +324,216 @@ func internalSystemErrSuggestionFunc(cfg interface{}) []*proto.Suggestion {
 		Action:         fmt.Sprintf("Something went wrong with your cluster %q. Try again.\nIf this keeps happening please open an issue at %s", deployCfg.GetKubeContext(), constants.GithubIssueLink),
 	}}
 }
+
+func manifestValidationErrDescription(err error) string {
+	match := manifestValidationErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	kind, field, reason := match[1], match[2], strings.TrimSpace(match[3])
+	if field == "" {
+		return fmt.Sprintf("Deploy Failed. Invalid %s manifest: %s", kind, reason)
+	}
+	return fmt.Sprintf("Deploy Failed. Invalid %s manifest at %q: %s", kind, field, reason)
+}
+
+func manifestValidationErrSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := manifestValidationErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	kind, field := match[1], match[2]
+	action := fmt.Sprintf("Fix the %s manifest so that it matches the Kubernetes API schema", kind)
+	if field != "" {
+		action = fmt.Sprintf("Fix the %q field of the %s manifest so that it matches the Kubernetes API schema", field, kind)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_FIX_INVALID_MANIFEST_FIELD,
+		Action:         action,
+	}}
+}
+
+func admissionWebhookDeniedDescription(err error) string {
+	match := admissionWebhookDeniedErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. Admission webhook %q denied the request: %s", match[1], strings.TrimSpace(match[2]))
+}
+
+func admissionWebhookDeniedSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := admissionWebhookDeniedErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	webhook := match[1]
+	var action string
+	switch {
+	case strings.Contains(webhook, "gatekeeper"):
+		action = fmt.Sprintf("Check the Gatekeeper constraints enforced by admission webhook %q, eg. with `kubectl get constraints`, and update the manifest to comply with them", webhook)
+	case strings.Contains(webhook, "kyverno"):
+		action = fmt.Sprintf("Check the Kyverno policies enforced by admission webhook %q, eg. with `kubectl get clusterpolicies,policies -A`, and update the manifest to comply with them", webhook)
+	default:
+		action = fmt.Sprintf("Check the policies enforced by admission webhook %q, eg. OPA Gatekeeper or Kyverno constraints, and update the manifest to comply with them", webhook)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_ADMISSION_POLICY,
+		Action:         action,
+	}}
+}
+
+func annotationsTooLongDescription(err error) string {
+	match := annotationsTooLongErr.FindStringSubmatch(err.Error())
+	if match == nil || match[1] == "" {
+		return fmt.Sprintf("Deploy Failed. The annotations of a resource are too long: %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. The annotations of %s %q exceed %s bytes", match[1], match[2], match[3])
+}
+
+func annotationsTooLongSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := annotationsTooLongErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	manifest := "the manifest"
+	if match[1] != "" {
+		manifest = fmt.Sprintf("the %s %q manifest", match[1], match[2])
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_USE_SERVER_SIDE_APPLY,
+		Action: fmt.Sprintf("`kubectl apply` stores the whole manifest in the `kubectl.kubernetes.io/last-applied-configuration` annotation. "+
+			"Add `--server-side` to `deploy.kubectl.flags.apply` to use server-side apply, which doesn't, or reduce the size of %s", manifest),
+	}}
+}
+
+func immutableFieldDescription(err error) string {
+	match := immutableFieldErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. The %q field of %s %q can't be changed once it's created", match[3], match[1], match[2])
+}
+
+func immutableFieldSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := immutableFieldErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	kind, name, field := match[1], match[2], match[3]
+	change := fmt.Sprintf("Revert the change to the %q field of %s %q", field, kind, name)
+	if field == "spec.selector" {
+		change = fmt.Sprintf("Keep the selector of %s %q as it was created, eg. by changing the pod template's labels without changing the selector", kind, name)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_RECREATE_RESOURCE,
+		Action: fmt.Sprintf("%s, or recreate it by running with `--force` or deleting it with `kubectl delete %s %s`",
+			change, strings.ToLower(kind), name),
+	}}
+}
+
+// referencedResource returns the kind and name of the missing Secret or ConfigMap of the error, if any.
+func referencedResource(err error) (string, string, bool) {
+	match := referencedResourceNotFoundErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return "", "", false
+	}
+	if match[1] == "secret" {
+		return "Secret", match[2], true
+	}
+	return "ConfigMap", match[2], true
+}
+
+func referencedResourceNotFoundDescription(err error) string {
+	kind, name, found := referencedResource(err)
+	if !found {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. %s %q referenced by the deployed resources was not found", kind, name)
+}
+
+func referencedResourceNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	kind, name, found := referencedResource(err)
+	if !found {
+		return nil
+	}
+	create := fmt.Sprintf("kubectl create configmap %s", name)
+	if kind == "Secret" {
+		create = fmt.Sprintf("kubectl create secret generic %s", name)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CREATE_REFERENCED_RESOURCE,
+		Action: fmt.Sprintf("Create %s %q in the namespace of the resources referencing it, eg. with `%s`, "+
+			"or add its manifest to the deployed manifests, and check that its name isn't misspelled", kind, name, create),
+	}}
+}
+
+func emptyContainerImageDescription(err error) string {
+	match := emptyContainerImageErr.FindStringSubmatch(err.Error())
+	if match == nil || match[1] == "" {
+		return fmt.Sprintf("Deploy Failed. A container of the deployed resources has no image: %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. The %q field of %s %q is empty", match[3], match[1], match[2])
+}
+
+func emptyContainerImageSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := emptyContainerImageErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	manifest := "the manifest"
+	if match[1] != "" {
+		manifest = fmt.Sprintf("the %s %q manifest", match[1], match[2])
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_IMAGE_SUBSTITUTION,
+		Action: fmt.Sprintf("Check that the `image` field of the containers of %s is set to the name of an artifact in `build.artifacts`, so that Skaffold replaces it with the built image, "+
+			"and, when deploying with Helm, that the chart's templates read the image from the value set by `artifactOverrides`", manifest),
+	}}
+}
+
+// unmatchedKind returns the kind and API version of a resource that the cluster doesn't serve, if any.
+func unmatchedKind(err error) (string, string, bool) {
+	match := noKindMatchErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return "", "", false
+	}
+	return match[1], match[2], true
+}
+
+// isRemovedAPIVersion tells apart built-in API versions that the cluster doesn't serve anymore
+// from custom resources whose CustomResourceDefinition isn't installed, which are left unclassified.
+// Built-in API groups are the core group and the groups without a domain or under `k8s.io`.
+func isRemovedAPIVersion(err error) bool {
+	_, version, found := unmatchedKind(err)
+	if !found {
+		return false
+	}
+	group := ""
+	if i := strings.LastIndex(version, "/"); i >= 0 {
+		group = version[:i]
+	}
+	return !strings.Contains(group, ".") || group == "k8s.io" || strings.HasSuffix(group, ".k8s.io")
+}
+
+func removedAPIVersionDescription(err error) string {
+	kind, version, found := unmatchedKind(err)
+	if !found {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	return fmt.Sprintf("Deploy Failed. The cluster doesn't serve %s in version %q, which was likely removed in its Kubernetes version", kind, version)
+}
+
+func removedAPIVersionSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	kind, version, found := unmatchedKind(err)
+	if !found {
+		return nil
+	}
+	action := fmt.Sprintf("Update the `apiVersion` of the %s manifests from %q to a version served by the cluster, as listed by `kubectl api-resources | grep -w %s`", kind, version, kind)
+	if stable, known := stableAPIVersions[kind]; known && stable != version {
+		action = fmt.Sprintf("Update the `apiVersion` of the %s manifests from %q to %q, adapting them to the changes of the API if needed", kind, version, stable)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_UPDATE_API_VERSION,
+		Action:         action,
+	}}
+}
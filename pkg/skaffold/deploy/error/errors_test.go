@@ -41,6 +41,151 @@ func TestUserError(t *testing.T) {
 			expectedErr: "Deploy Failed. Error: (Internal Server Error: the server is currently unable to handle the request)." +
 				" Something went wrong.",
 		},
+		{
+			description: "manifest validation error",
+			err: fmt.Errorf(`kubectl apply: error: error validating "STDIN": error validating data: ValidationError(Deployment.spec): ` +
+				`unknown field "replica" in io.k8s.api.apps.v1.DeploymentSpec; if you choose to ignore these errors, turn validation off with --validate=false`),
+			statusCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:   proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			expectedErr: `Deploy Failed. Invalid Deployment manifest at "spec": unknown field "replica" in io.k8s.api.apps.v1.DeploymentSpec.` +
+				` Fix the "spec" field of the Deployment manifest so that it matches the Kubernetes API schema.`,
+		},
+		{
+			description: "manifest validation error without field path",
+			err:         fmt.Errorf(`error validating data: ValidationError(Service): missing required field "spec" in io.k8s.api.core.v1.Service`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			expectedErr: `Deploy Failed. Invalid Service manifest: missing required field "spec" in io.k8s.api.core.v1.Service.` +
+				` Fix the Service manifest so that it matches the Kubernetes API schema.`,
+		},
+		{
+			description: "api server certificate signed by unknown authority",
+			err:         fmt.Errorf("kubectl apply: Unable to connect to the server: x509: certificate signed by unknown authority"),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY,
+			expectedErr: "The certificate of the cluster's API server is signed by an unknown authority." +
+				" Set the CA bundle of the cluster in your kubeconfig, eg. with `kubectl config set-cluster <cluster> --certificate-authority=<ca.crt> --embed-certs`, or, for test clusters only, mark the cluster insecure with `kubectl config set-cluster <cluster> --insecure-skip-tls-verify=true`.",
+		},
+		{
+			description: "unauthorized",
+			err:         fmt.Errorf("error: the server has asked for the client to provide credentials"),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED,
+			expectedErr: "The cluster rejected the credentials in use: error: the server has asked for the client to provide credentials." +
+				" Refresh your cluster credentials, eg. with `gcloud auth login`, or check the `exec` credential plugin configured in your kubeconfig.",
+		},
+		{
+			description: "kubectl not logged in takes precedence over unauthorized",
+			err:         fmt.Errorf("kubectl apply: error: You must be logged in to the server (Unauthorized)"),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN,
+			expectedErr: "Deploy Failed. kubectl is not logged in to the cluster." +
+				" Log in to the cluster of the current kube-context again, eg. by refreshing its credentials in your kubeconfig, and check that `kubectl get namespaces` succeeds.",
+		},
+		{
+			description: "gatekeeper admission webhook denied",
+			err: fmt.Errorf(`kubectl apply: Error from server (Forbidden): error when creating "STDIN": admission webhook "validation.gatekeeper.sh" denied the request: ` +
+				`[required-labels] you must provide labels: {"owner"}`),
+			statusCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:   proto.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED,
+			expectedErr: `Deploy Failed. Admission webhook "validation.gatekeeper.sh" denied the request: [required-labels] you must provide labels: {"owner"}.` +
+				" Check the Gatekeeper constraints enforced by admission webhook \"validation.gatekeeper.sh\"",
+		},
+		{
+			description: "other admission webhook denied",
+			err:         fmt.Errorf(`admission webhook "policy.example.com" denied the request: privileged containers are not allowed`),
+			statusCode:  proto.StatusCode_DEPLOY_HELM_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED,
+			expectedErr: `Deploy Failed. Admission webhook "policy.example.com" denied the request: privileged containers are not allowed.` +
+				` Check the policies enforced by admission webhook "policy.example.com", eg. OPA Gatekeeper or Kyverno constraints`,
+		},
+		{
+			description: "annotations too long",
+			err: fmt.Errorf(`kubectl apply: Error from server (Invalid): error when creating "STDIN": The CustomResourceDefinition "prometheuses.monitoring.coreos.com" ` +
+				`is invalid: metadata.annotations: Too long: must have at most 262144 bytes`),
+			statusCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:   proto.StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG,
+			expectedErr: `Deploy Failed. The annotations of CustomResourceDefinition "prometheuses.monitoring.coreos.com" exceed 262144 bytes.` +
+				" `kubectl apply` stores the whole manifest in the `kubectl.kubernetes.io/last-applied-configuration` annotation." +
+				" Add `--server-side` to `deploy.kubectl.flags.apply` to use server-side apply, which doesn't," +
+				` or reduce the size of the CustomResourceDefinition "prometheuses.monitoring.coreos.com" manifest`,
+		},
+		{
+			description: "annotations too long without resource",
+			err:         fmt.Errorf(`metadata.annotations: Too long: must have at most 262144 bytes`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG,
+			expectedErr: "Deploy Failed. The annotations of a resource are too long: metadata.annotations: Too long: must have at most 262144 bytes." +
+				" `kubectl apply` stores the whole manifest",
+		},
+		{
+			description: "immutable selector",
+			err: fmt.Errorf(`kubectl apply: Error from server (Invalid): error when applying patch: The Deployment "leeroy-web" is invalid: ` +
+				`spec.selector: Invalid value: v1.LabelSelector{MatchLabels:map[string]string{"app":"leeroy-web-v2"}, MatchExpressions:[]v1.LabelSelectorRequirement(nil)}: field is immutable`),
+			statusCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:   proto.StatusCode_DEPLOY_IMMUTABLE_FIELD,
+			expectedErr: `Deploy Failed. The "spec.selector" field of Deployment "leeroy-web" can't be changed once it's created.` +
+				` Keep the selector of Deployment "leeroy-web" as it was created, eg. by changing the pod template's labels without changing the selector,` +
+				" or recreate it by running with `--force` or deleting it with `kubectl delete deployment leeroy-web`.",
+		},
+		{
+			description: "other immutable field reported by helm",
+			err:         fmt.Errorf(`UPGRADE FAILED: cannot patch "migrate" with kind Job: Job.batch "migrate" is invalid: spec.template: Invalid value: core.PodTemplateSpec{...}: field is immutable`),
+			statusCode:  proto.StatusCode_DEPLOY_HELM_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_IMMUTABLE_FIELD,
+			expectedErr: `Deploy Failed. The "spec.template" field of Job "migrate" can't be changed once it's created.` +
+				` Revert the change to the "spec.template" field of Job "migrate", or recreate it by running with ` + "`--force` or deleting it with `kubectl delete job migrate`.",
+		},
+		{
+			description: "secret not found",
+			err:         fmt.Errorf(`kubectl apply: Error from server (NotFound): error when creating "STDIN": secrets "db-credentials" not found`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND,
+			expectedErr: `Deploy Failed. Secret "db-credentials" referenced by the deployed resources was not found.` +
+				` Create Secret "db-credentials" in the namespace of the resources referencing it, eg. with ` + "`kubectl create secret generic db-credentials`," +
+				" or add its manifest to the deployed manifests, and check that its name isn't misspelled.",
+		},
+		{
+			description: "configmap not found",
+			err:         fmt.Errorf(`UPGRADE FAILED: pod web-5d8f7 failed: CreateContainerConfigError: configmap "app-config" not found`),
+			statusCode:  proto.StatusCode_DEPLOY_HELM_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND,
+			expectedErr: `Deploy Failed. ConfigMap "app-config" referenced by the deployed resources was not found.` +
+				` Create ConfigMap "app-config" in the namespace of the resources referencing it, eg. with ` + "`kubectl create configmap app-config`," +
+				" or add its manifest to the deployed manifests, and check that its name isn't misspelled.",
+		},
+		{
+			description: "container without an image",
+			err:         fmt.Errorf(`kubectl apply: The Deployment "web" is invalid: spec.template.spec.containers[0].image: Required value`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE,
+			expectedErr: `Deploy Failed. The "spec.template.spec.containers[0].image" field of Deployment "web" is empty.` +
+				" Check that the `image` field of the containers of the Deployment \"web\" manifest is set to the name of an artifact in `build.artifacts`, so that Skaffold replaces it with the built image," +
+				" and, when deploying with Helm, that the chart's templates read the image from the value set by `artifactOverrides`.",
+		},
+		{
+			description: "removed built-in api version",
+			err:         fmt.Errorf(`kubectl apply: error: unable to recognize "STDIN": no matches for kind "Ingress" in version "extensions/v1beta1"`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_REMOVED_API_VERSION,
+			expectedErr: `Deploy Failed. The cluster doesn't serve Ingress in version "extensions/v1beta1", which was likely removed in its Kubernetes version.` +
+				" Update the `apiVersion` of the Ingress manifests from \"extensions/v1beta1\" to \"networking.k8s.io/v1\", adapting them to the changes of the API if needed.",
+		},
+		{
+			description: "removed built-in api version of an unknown kind",
+			err: fmt.Errorf(`resource mapping not found for name: "tokens" namespace: "" from "STDIN": no matches for kind "FlowSchema" in version "flowcontrol.apiserver.k8s.io/v1beta1"` +
+				"\nensure CRDs are installed first"),
+			statusCode: proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:   proto.StatusCode_DEPLOY_REMOVED_API_VERSION,
+			expectedErr: `Deploy Failed. The cluster doesn't serve FlowSchema in version "flowcontrol.apiserver.k8s.io/v1beta1", which was likely removed in its Kubernetes version.` +
+				" Update the `apiVersion` of the FlowSchema manifests from \"flowcontrol.apiserver.k8s.io/v1beta1\" to a version served by the cluster, as listed by `kubectl api-resources | grep -w FlowSchema`.",
+		},
+		{
+			description: "custom resource without its crd",
+			err:         fmt.Errorf(`kubectl apply: error: unable to recognize "STDIN": no matches for kind "Certificate" in version "cert-manager.io/v1"`),
+			statusCode:  proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expected:    proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+		},
 		{
 			description: "not an internal system err",
 			err:         fmt.Errorf("helm tiller not running"),
@@ -70,3 +215,50 @@ func TestUserError(t *testing.T) {
 		})
 	}
 }
+
+func TestApplyError(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		expected    proto.StatusCode
+		expectedErr string
+		suggestion  proto.SuggestionCode
+	}{
+		{
+			description: "denied resource is reported with the webhook suggestion",
+			output: `deployment.apps/leeroy-app created
+Error from server (Forbidden): error when creating "STDIN": admission webhook "validate.kyverno.svc" denied the request: require-requests-limits: validation error
+`,
+			expected: proto.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED,
+			expectedErr: `kubectl apply: exit status 1: 1 of 2 resources failed to apply
+ - deployment.apps/leeroy-app created
+ - admission webhook "validate.kyverno.svc" denied the request: require-requests-limits: validation error`,
+			suggestion: proto.SuggestionCode_CHECK_ADMISSION_POLICY,
+		},
+		{
+			description: "unknown failure keeps the given status code",
+			output:      "Error from server (Conflict): error when creating \"STDIN\": Operation cannot be fulfilled\n",
+			expected:    proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expectedErr: "kubectl apply: exit status 1: 1 of 1 resources failed to apply\n - Operation cannot be fulfilled",
+		},
+		{
+			description: "no per-resource results",
+			output:      "error: unable to recognize \"STDIN\": no matches for kind \"Foo\"\n",
+			expected:    proto.StatusCode_DEPLOY_KUBECTL_USER_ERR,
+			expectedErr: "kubectl apply: exit status 1",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := ApplyError(fmt.Errorf("kubectl apply: exit status 1"), test.output, proto.StatusCode_DEPLOY_KUBECTL_USER_ERR)
+
+			t.CheckErrorContains(test.expectedErr, err)
+			aiErr, ok := err.(sErrors.Error)
+			t.CheckTrue(ok)
+			t.CheckDeepEqual(test.expected, aiErr.StatusCode())
+			if test.suggestion != proto.SuggestionCode_NIL {
+				t.CheckDeepEqual(test.suggestion, aiErr.Suggestions()[0].SuggestionCode)
+			}
+		})
+	}
+}
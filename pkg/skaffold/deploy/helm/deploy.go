@@ -409,7 +409,7 @@ func (h *Deployer) Render(ctx context.Context, out io.Writer, builds []graph.Art
 
 		outBuffer := new(bytes.Buffer)
 		if err := h.exec(ctx, outBuffer, false, nil, args...); err != nil {
-			return userErr("std out err", fmt.Errorf(outBuffer.String()))
+			return renderErr("std out err", fmt.Errorf(outBuffer.String()))
 		}
 		renderedManifests.Write(outBuffer.Bytes())
 	}
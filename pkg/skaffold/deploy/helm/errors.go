@@ -18,14 +18,32 @@ package helm
 
 import (
 	"fmt"
+	"regexp"
 
 	"github.com/pkg/errors"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
+var (
+	// matches `chart "nginx" version "1.2.3" not found in https://charts.bitnami.com/bitnami repository`
+	chartNotFoundErr = regexp.MustCompile(`chart "([^"]+)" version "([^"]*)" not found(?: in (\S+) repository)?`)
+	// matches `no repository definition for https://charts.bitnami.com/bitnami` and `repo bitnami not found`
+	repoNotFoundErr = regexp.MustCompile(`no repository definition for ([^\s.,]+(?:\.[^\s.,]+)*)|repo (\S+) not found`)
+	// matches `Error: UPGRADE FAILED: timed out waiting for the condition`, as reported by `helm --wait`.
+	// Skaffold's own status check reports timeouts differently, and only errors of the Helm CLI are matched here.
+	waitTimeoutErr = regexp.MustCompile(`timed out waiting for the condition`)
+	// matches malformed values, e.g.
+	// failed to parse values.yaml: error converting YAML to JSON: yaml: line 3: mapping values are not allowed in this context
+	valuesParseErr = regexp.MustCompile(`(?:(?:failed to parse|cannot load) (\S+?): )?error converting YAML to JSON: yaml: line (\d+): ([^\n]+)`)
+	// matches malformed manifests rendered from templates, which have the same error as malformed values, e.g.
+	// YAML parse error on app/templates/deployment.yaml: error converting YAML to JSON: yaml: line 12: did not find expected key
+	templateParseErr = regexp.MustCompile(`YAML parse error on \S+`)
+)
+
 const (
 	installLink            = "https://helm.sh/docs/intro/install"
 	toolName               = "Helm"
@@ -69,7 +87,134 @@ func helmLabelErr(err error) error {
 }
 
 func userErr(prefix string, err error) error {
-	return deployerr.UserError(errors.Wrap(err, prefix), proto.StatusCode_DEPLOY_HELM_USER_ERR)
+	err = errors.Wrap(err, prefix)
+	if chartNotFoundErr.MatchString(err.Error()) || repoNotFoundErr.MatchString(err.Error()) {
+		return sErrors.Problem{
+			Description:      chartNotFoundDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND,
+			SuggestionForErr: chartNotFoundSuggestion,
+			Err:              err,
+		}
+	}
+	if isValuesParseErr(err) {
+		return sErrors.Problem{
+			Description:      valuesParseDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR,
+			SuggestionForErr: valuesParseSuggestion,
+			Err:              err,
+		}
+	}
+	if waitTimeoutErr.MatchString(err.Error()) {
+		return sErrors.Problem{
+			Description:      waitTimeoutDescription,
+			ErrCode:          proto.StatusCode_DEPLOY_HELM_WAIT_TIMEOUT,
+			SuggestionForErr: waitTimeoutSuggestion,
+			Err:              err,
+		}
+	}
+	return deployerr.UserError(err, proto.StatusCode_DEPLOY_HELM_USER_ERR)
+}
+
+// renderErr reports a failure of `helm template`. Missing charts and malformed values are reported as in deploys,
+// and other failures are left to the problems of the Render phase instead of being reported as deploy user errors.
+func renderErr(prefix string, err error) error {
+	if chartNotFoundErr.MatchString(err.Error()) || repoNotFoundErr.MatchString(err.Error()) || isValuesParseErr(err) {
+		return userErr(prefix, err)
+	}
+	return sErrors.WithPhase(constants.Render, errors.Wrap(err, prefix))
+}
+
+func chartNotFoundDescription(err error) string {
+	if match := chartNotFoundErr.FindStringSubmatch(err.Error()); match != nil {
+		if match[3] != "" {
+			return fmt.Sprintf("Deploy Failed. Version %q of Helm chart %q was not found in repository %s", match[2], match[1], match[3])
+		}
+		return fmt.Sprintf("Deploy Failed. Version %q of Helm chart %q was not found", match[2], match[1])
+	}
+	if match := repoNotFoundErr.FindStringSubmatch(err.Error()); match != nil {
+		return fmt.Sprintf("Deploy Failed. Helm chart repository %s is not configured", repoName(match))
+	}
+	return fmt.Sprintf("Deploy Failed. %v", err)
+}
+
+func chartNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	if match := chartNotFoundErr.FindStringSubmatch(err.Error()); match != nil {
+		return []*proto.Suggestion{
+			{
+				SuggestionCode: proto.SuggestionCode_ADD_HELM_REPO,
+				Action:         "Run `helm repo update` to refresh the list of available chart versions",
+			},
+			{
+				SuggestionCode: proto.SuggestionCode_FIX_HELM_CHART_VERSION,
+				Action:         fmt.Sprintf("fix the `version` of chart %q to one listed by `helm search repo %s --versions`", match[1], match[1]),
+			},
+		}
+	}
+	if match := repoNotFoundErr.FindStringSubmatch(err.Error()); match != nil {
+		return []*proto.Suggestion{{
+			SuggestionCode: proto.SuggestionCode_ADD_HELM_REPO,
+			Action:         fmt.Sprintf("Add the chart repository with `helm repo add <name> %s`", repoName(match)),
+		}}
+	}
+	return nil
+}
+
+func waitTimeoutDescription(error) string {
+	return "Deploy Failed. Helm timed out waiting for the resources of the release to be ready"
+}
+
+func waitTimeoutSuggestion(interface{}, error) []*proto.Suggestion {
+	return []*proto.Suggestion{
+		{
+			SuggestionCode: proto.SuggestionCode_INCREASE_HELM_TIMEOUT,
+			Action:         "Increase the Helm timeout, which defaults to 5m0s, with `--timeout` in `deploy.helm.flags.install` and `deploy.helm.flags.upgrade`",
+		},
+		{
+			SuggestionCode: proto.SuggestionCode_CHECK_CONTAINER_LOGS,
+			Action:         "check why the release's pods are not ready with `kubectl get events` and `kubectl logs`",
+		},
+	}
+}
+
+// isValuesParseErr returns true if the values of a release, rather than its rendered templates, are not valid YAML.
+func isValuesParseErr(err error) bool {
+	return valuesParseErr.MatchString(err.Error()) && !templateParseErr.MatchString(err.Error())
+}
+
+func valuesParseDescription(err error) string {
+	match := valuesParseErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return fmt.Sprintf("Deploy Failed. %v", err)
+	}
+	if match[1] != "" {
+		return fmt.Sprintf("Deploy Failed. Helm values file %s is not valid YAML: line %s: %s", match[1], match[2], match[3])
+	}
+	return fmt.Sprintf("Deploy Failed. Helm values are not valid YAML: line %s: %s", match[2], match[3])
+}
+
+func valuesParseSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	match := valuesParseErr.FindStringSubmatch(err.Error())
+	if match == nil {
+		return nil
+	}
+	action := fmt.Sprintf("Fix line %s of the release's `valuesFiles`, or of the values given with `setValues` and `setValueTemplates`", match[2])
+	if match[1] != "" {
+		action = fmt.Sprintf("Fix line %s of %s", match[2], match[1])
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_FIX_HELM_VALUES,
+		Action:         action,
+	}}
+}
+
+// repoName returns the repository URL or name captured by repoNotFoundErr.
+func repoName(match []string) string {
+	for _, m := range match[1:] {
+		if m != "" {
+			return m
+		}
+	}
+	return ""
 }
 
 func noMatchingBuild(image string) error {
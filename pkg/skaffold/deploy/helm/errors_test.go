@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"testing"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestUserErr(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    proto.StatusCode
+		expectedErr string
+	}{
+		{
+			description: "chart version not found",
+			err:         fmt.Errorf(`exit status 1: Error: chart "bitnami/nginx" version "99.0.0" not found in https://charts.bitnami.com/bitnami repository`),
+			expected:    proto.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND,
+			expectedErr: `Deploy Failed. Version "99.0.0" of Helm chart "bitnami/nginx" was not found in repository https://charts.bitnami.com/bitnami.` +
+				" Run `helm repo update` to refresh the list of available chart versions or fix the `version` of chart \"bitnami/nginx\" to one listed by `helm search repo bitnami/nginx --versions`.",
+		},
+		{
+			description: "missing repository definition",
+			err:         fmt.Errorf(`exit status 1: Error: no repository definition for https://charts.bitnami.com/bitnami. Please add the missing repos via 'helm repo add'`),
+			expected:    proto.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND,
+			expectedErr: "Deploy Failed. Helm chart repository https://charts.bitnami.com/bitnami is not configured." +
+				" Add the chart repository with `helm repo add <name> https://charts.bitnami.com/bitnami`.",
+		},
+		{
+			description: "repository not found",
+			err:         fmt.Errorf(`exit status 1: Error: repo bitnami not found`),
+			expected:    proto.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND,
+			expectedErr: "Deploy Failed. Helm chart repository bitnami is not configured. Add the chart repository with `helm repo add <name> bitnami`.",
+		},
+		{
+			description: "helm --wait timeout",
+			err:         fmt.Errorf("exit status 1: Error: UPGRADE FAILED: timed out waiting for the condition"),
+			expected:    proto.StatusCode_DEPLOY_HELM_WAIT_TIMEOUT,
+			expectedErr: "Deploy Failed. Helm timed out waiting for the resources of the release to be ready." +
+				" Increase the Helm timeout, which defaults to 5m0s, with `--timeout` in `deploy.helm.flags.install` and `deploy.helm.flags.upgrade`" +
+				" or check why the release's pods are not ready with `kubectl get events` and `kubectl logs`.",
+		},
+		{
+			description: "malformed values file",
+			err:         fmt.Errorf("exit status 1: Error: failed to parse values-dev.yaml: error converting YAML to JSON: yaml: line 3: mapping values are not allowed in this context"),
+			expected:    proto.StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR,
+			expectedErr: "Deploy Failed. Helm values file values-dev.yaml is not valid YAML: line 3: mapping values are not allowed in this context. Fix line 3 of values-dev.yaml.",
+		},
+		{
+			description: "malformed values",
+			err:         fmt.Errorf("exit status 1: Error: error converting YAML to JSON: yaml: line 1: did not find expected key"),
+			expected:    proto.StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR,
+			expectedErr: "Deploy Failed. Helm values are not valid YAML: line 1: did not find expected key." +
+				" Fix line 1 of the release's `valuesFiles`, or of the values given with `setValues` and `setValueTemplates`.",
+		},
+		{
+			description: "malformed template is not a values error",
+			err:         fmt.Errorf("exit status 1: Error: YAML parse error on app/templates/deployment.yaml: error converting YAML to JSON: yaml: line 12: did not find expected key"),
+			expected:    proto.StatusCode_DEPLOY_HELM_USER_ERR,
+			expectedErr: "install: exit status 1: Error: YAML parse error on app/templates/deployment.yaml: error converting YAML to JSON: yaml: line 12: did not find expected key",
+		},
+		{
+			description: "other error",
+			err:         fmt.Errorf("exit status 1: Error: UPGRADE FAILED: another operation is in progress"),
+			expected:    proto.StatusCode_DEPLOY_HELM_USER_ERR,
+			expectedErr: "install: exit status 1: Error: UPGRADE FAILED: another operation is in progress",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			err := sErrors.ShowAIError(nil, userErr("install", test.err))
+
+			t.CheckDeepEqual(test.expectedErr, err.Error())
+			t.CheckDeepEqual(test.expected, sErrors.ActionableErr(nil, "Deploy", userErr("install", test.err)).ErrCode)
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package helm
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -215,8 +216,16 @@ func (h *Deployer) exec(ctx context.Context, out io.Writer, useSecrets bool, env
 	if len(env) > 0 {
 		cmd.Env = env
 	}
+	// helm only explains its failures on stderr
+	var stderr bytes.Buffer
 	cmd.Stdout = out
-	cmd.Stderr = out
+	cmd.Stderr = io.MultiWriter(out, &stderr)
 
-	return util.RunCmd(cmd)
+	if err := util.RunCmd(cmd); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%w: %s", err, msg)
+		}
+		return err
+	}
+	return nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package kubectl
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
@@ -36,6 +37,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 	kstatus "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/status"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 // CLI holds parameters to run kubectl.
@@ -103,9 +105,11 @@ func (c *CLI) Apply(ctx context.Context, out io.Writer, manifests manifest.Manif
 		args = append(args, "--validate=false")
 	}
 
-	if err := c.Run(ctx, updated.Reader(), out, "apply", c.args(c.Flags.Apply, args...)...); err != nil {
+	// keep a copy of the output to report which resources failed to apply
+	var output bytes.Buffer
+	if err := c.Run(ctx, updated.Reader(), io.MultiWriter(out, &output), "apply", c.args(c.Flags.Apply, args...)...); err != nil {
 		endTrace(instrumentation.TraceEndError(err))
-		return userErr(fmt.Errorf("kubectl apply: %w", err))
+		return deployerr.ApplyError(fmt.Errorf("kubectl apply: %w", err), output.String(), proto.StatusCode_DEPLOY_KUBECTL_USER_ERR)
 	}
 
 	return nil
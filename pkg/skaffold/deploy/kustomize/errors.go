@@ -17,10 +17,18 @@ limitations under the License.
 package kustomize
 
 import (
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 func userErr(err error) error {
 	return deployerr.UserError(err, proto.StatusCode_DEPLOY_KUSTOMIZE_USER_ERR)
 }
+
+// buildErr reports a failure of `kustomize build`, which renders the manifests
+// both when deploying and rendering, with the problems of the Render phase.
+func buildErr(err error) error {
+	return sErrors.WithPhase(constants.Render, err)
+}
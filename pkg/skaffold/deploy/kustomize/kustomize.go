@@ -398,7 +398,7 @@ func (k *Deployer) readManifests(ctx context.Context) (manifest.ManifestList, er
 		}
 
 		if err != nil {
-			return nil, userErr(err)
+			return nil, buildErr(err)
 		}
 
 		if len(out) == 0 {
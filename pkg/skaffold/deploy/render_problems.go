@@ -0,0 +1,99 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// helmTemplateErr matches the failures of Go templates in Helm charts, eg.
+	// `template: app/templates/deployment.yaml:12:20: executing "app/templates/deployment.yaml" at <.Values.image.repo>: nil pointer evaluating interface {}.repo`
+	// or `execution error at (app/templates/deployment.yaml:3:12): image.tag is required`
+	helmTemplateErr = regexp.MustCompile(`(?:template: |(?:execution|parse) error at \()([^\s:()]+)(?::\d+)+\)?: (?:executing "[^"]*" at <[^>]*>: )?(.*)`)
+	// kustomizeBuildErr matches the failures of `kustomize build`, eg.
+	// `accumulating resources: accumulation err='accumulating resources from 'deployment.yaml': ...`
+	kustomizeBuildErr = regexp.MustCompile(`((?:accumulating resources|unable to find one of 'kustomization\.yaml'|invalid Kustomization|trouble configuring builtin \w+).*)`)
+	// validationSchemaErr matches kubectl failing to fetch the OpenAPI schema it validates manifests against, eg.
+	// `error validating data: failed to download openapi: Get "https://10.0.0.1/openapi/v2?timeout=32s": dial tcp 10.0.0.1:443: i/o timeout; if you choose to ignore these errors, turn validation off with --validate=false`
+	// or `validation failed: unable to load schema: the server could not find the requested resource`
+	validationSchemaErr = regexp.MustCompile(`(?:unable to load schema|failed to download openapi): ([^;\n]+)`)
+
+	// validationSchemaProblem is a problem of both rendering and deploying, since kubectl validates manifests in either phase.
+	validationSchemaProblem = sErrors.Problem{
+		Regexp:  validationSchemaErr,
+		ErrCode: proto.StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE,
+		Description: func(err error) string {
+			if match := validationSchemaErr.FindStringSubmatch(err.Error()); len(match) >= 2 {
+				return fmt.Sprintf("Could not validate the manifests: the OpenAPI schema couldn't be loaded from the API server: %s", match[1])
+			}
+			return "Could not validate the manifests: the OpenAPI schema couldn't be loaded from the API server"
+		},
+		Suggestion: func(interface{}) []*proto.Suggestion {
+			return []*proto.Suggestion{{
+				SuggestionCode: proto.SuggestionCode_DISABLE_CLIENT_VALIDATION,
+				Action: "Check that the API server can be reached, or turn client-side validation off with `--validate=false`, " +
+					"eg. by setting `deploy.kubectl.flags.disableValidation: true`, for clusters whose schema can't be fetched such as air-gapped ones",
+			}}
+		},
+	}
+
+	renderProblems = []sErrors.Problem{
+		{
+			Regexp:  helmTemplateErr,
+			ErrCode: proto.StatusCode_RENDER_HELM_TEMPLATE_ERR,
+			Description: func(err error) string {
+				if match := helmTemplateErr.FindStringSubmatch(err.Error()); len(match) >= 3 {
+					return fmt.Sprintf("Render Failed. Helm template %q could not be rendered: %s", match[1], match[2])
+				}
+				return "Render Failed. A Helm template could not be rendered"
+			},
+			Suggestion: func(interface{}) []*proto.Suggestion {
+				return []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_HELM_TEMPLATE,
+					Action:         "Fix the template, or set the values it requires with the `setValues` or `valuesFiles` of the release in `deploy.helm.releases`",
+				}}
+			},
+		},
+		{
+			Regexp:  kustomizeBuildErr,
+			ErrCode: proto.StatusCode_RENDER_KUSTOMIZE_BUILD_ERR,
+			Description: func(err error) string {
+				if match := kustomizeBuildErr.FindStringSubmatch(err.Error()); len(match) >= 2 {
+					return fmt.Sprintf("Render Failed. Could not build the kustomization: %s", match[1])
+				}
+				return "Render Failed. Could not build the kustomization"
+			},
+			Suggestion: func(interface{}) []*proto.Suggestion {
+				return []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_KUSTOMIZATION,
+					Action:         "Fix the kustomization in `deploy.kustomize.paths`, and check that the resources, bases and patches it lists exist",
+				}}
+			},
+		},
+		validationSchemaProblem,
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.Render, renderProblems)
+}
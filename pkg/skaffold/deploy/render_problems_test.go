@@ -0,0 +1,115 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deploy
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestRenderProblems(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "helm template nil pointer",
+			err:         fmt.Errorf(`std out err: Error: template: app/templates/deployment.yaml:12:20: executing "app/templates/deployment.yaml" at <.Values.image.repo>: nil pointer evaluating interface {}.repo`),
+			expected: "Render Failed. Helm template \"app/templates/deployment.yaml\" could not be rendered: nil pointer evaluating interface {}.repo." +
+				" Fix the template, or set the values it requires with the `setValues` or `valuesFiles` of the release in `deploy.helm.releases`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_RENDER_HELM_TEMPLATE_ERR,
+				Message: `std out err: Error: template: app/templates/deployment.yaml:12:20: executing "app/templates/deployment.yaml" at <.Values.image.repo>: nil pointer evaluating interface {}.repo`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_HELM_TEMPLATE,
+					Action:         "Fix the template, or set the values it requires with the `setValues` or `valuesFiles` of the release in `deploy.helm.releases`",
+				}},
+			},
+		},
+		{
+			description: "helm required value",
+			err:         fmt.Errorf("std out err: Error: execution error at (app/templates/deployment.yaml:3:12): image.tag is required\n"),
+			expected: "Render Failed. Helm template \"app/templates/deployment.yaml\" could not be rendered: image.tag is required." +
+				" Fix the template, or set the values it requires with the `setValues` or `valuesFiles` of the release in `deploy.helm.releases`.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_RENDER_HELM_TEMPLATE_ERR,
+				Message: "std out err: Error: execution error at (app/templates/deployment.yaml:3:12): image.tag is required\n",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_HELM_TEMPLATE,
+					Action:         "Fix the template, or set the values it requires with the `setValues` or `valuesFiles` of the release in `deploy.helm.releases`",
+				}},
+			},
+		},
+		{
+			description: "kustomize missing resource",
+			err:         fmt.Errorf("Error: accumulating resources: accumulation err='accumulating resources from 'service.yaml': open /app/k8s/service.yaml: no such file or directory'"),
+			expected: "Render Failed. Could not build the kustomization: accumulating resources: accumulation err='accumulating resources from 'service.yaml': open /app/k8s/service.yaml: no such file or directory'." +
+				" Fix the kustomization in `deploy.kustomize.paths`, and check that the resources, bases and patches it lists exist.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_RENDER_KUSTOMIZE_BUILD_ERR,
+				Message: "Error: accumulating resources: accumulation err='accumulating resources from 'service.yaml': open /app/k8s/service.yaml: no such file or directory'",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_FIX_KUSTOMIZATION,
+					Action:         "Fix the kustomization in `deploy.kustomize.paths`, and check that the resources, bases and patches it lists exist",
+				}},
+			},
+		},
+		{
+			description: "validation schema can't be downloaded",
+			err:         fmt.Errorf(`error: error validating "STDIN": error validating data: failed to download openapi: Get "https://10.0.0.1/openapi/v2?timeout=32s": dial tcp 10.0.0.1:443: i/o timeout; if you choose to ignore these errors, turn validation off with --validate=false`),
+			expected: "Could not validate the manifests: the OpenAPI schema couldn't be loaded from the API server: Get \"https://10.0.0.1/openapi/v2?timeout=32s\": dial tcp 10.0.0.1:443: i/o timeout." +
+				" Check that the API server can be reached, or turn client-side validation off with `--validate=false`, eg. by setting `deploy.kubectl.flags.disableValidation: true`, for clusters whose schema can't be fetched such as air-gapped ones.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE,
+				Message: `error: error validating "STDIN": error validating data: failed to download openapi: Get "https://10.0.0.1/openapi/v2?timeout=32s": dial tcp 10.0.0.1:443: i/o timeout; if you choose to ignore these errors, turn validation off with --validate=false`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_DISABLE_CLIENT_VALIDATION,
+					Action:         "Check that the API server can be reached, or turn client-side validation off with `--validate=false`, eg. by setting `deploy.kubectl.flags.disableValidation: true`, for clusters whose schema can't be fetched such as air-gapped ones",
+				}},
+			},
+		},
+		{
+			description: "unknown render error",
+			err:         fmt.Errorf("rendering manifests: something went wrong"),
+			expected:    "rendering manifests: something went wrong",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_RENDER_UNKNOWN,
+				Message:     "rendering manifests: something went wrong",
+				Suggestions: sErrors.ReportIssueSuggestion(nil),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.Render, renderProblems)
+				return pc
+			})
+			err := sErrors.WithPhase(constants.Render, test.err)
+			t.CheckDeepEqual(test.expected, sErrors.ShowAIError(nil, err).Error())
+			t.CheckDeepEqual(test.expectedAE, sErrors.ActionableErr(nil, constants.Deploy, err))
+		})
+	}
+}
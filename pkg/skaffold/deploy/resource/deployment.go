@@ -26,6 +26,7 @@ import (
 
 	"github.com/GoogleContainerTools/skaffold/pkg/diag"
 	"github.com/GoogleContainerTools/skaffold/pkg/diag/validator"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
@@ -47,12 +48,16 @@ const (
 var (
 	msgKubectlKilled     = "kubectl rollout status command interrupted\n"
 	MsgKubectlConnection = "kubectl connection error\n"
+	clusterUnauthorized  = deployerr.ClusterUnauthorizedProblem(proto.StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED, nil)
 
 	nonRetryContainerErrors = map[proto.StatusCode]struct{}{
-		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:       {},
-		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:    {},
-		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED: {},
-		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING: {},
+		proto.StatusCode_STATUSCHECK_IMAGE_PULL_ERR:         {},
+		proto.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN: {},
+		proto.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR:      {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_TERMINATED:   {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RESTARTING:   {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR:   {},
+		proto.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT:  {},
 	}
 )
 
@@ -251,6 +256,12 @@ func parseKubectlRolloutError(details string, err error) proto.ActionableErr {
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR,
 			Message: MsgKubectlConnection,
 		}
+	case clusterUnauthorized.Regexp.MatchString(err.Error()):
+		return proto.ActionableErr{
+			ErrCode:     clusterUnauthorized.ErrCode,
+			Message:     err.Error(),
+			Suggestions: clusterUnauthorized.Suggestion(nil),
+		}
 	case strings.Contains(err.Error(), killedErrMsg):
 		return proto.ActionableErr{
 			ErrCode: proto.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED,
@@ -143,6 +143,18 @@ func TestParseKubectlError(t *testing.T) {
 				Message: msgKubectlKilled,
 			},
 		},
+		{
+			description: "rollout status unauthorized",
+			err:         errors.New("error: You must be logged in to the server (Unauthorized)"),
+			expectedAe: proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED,
+				Message: "error: You must be logged in to the server (Unauthorized)",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_REFRESH_CLUSTER_CREDENTIALS,
+					Action:         "Refresh your cluster credentials, eg. with `gcloud auth login`, or check the `exec` credential plugin configured in your kubeconfig",
+				}},
+			},
+		},
 		{
 			description: "rollout status random error",
 			err:         errors.New("deployment test not found"),
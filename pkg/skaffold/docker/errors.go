@@ -23,6 +23,11 @@ import (
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
+// CorruptLocalImageErr matches errors of the Docker daemon reading an image whose layers are missing from its storage,
+// eg. after the daemon was stopped while pulling or building the image:
+// `Error response from daemon: readlink /var/lib/docker/overlay2/l: no such file or directory`.
+const CorruptLocalImageErr = `Error response from daemon: readlink [^\n]*?: (?:no such file or directory|invalid argument)`
+
 func remoteDigestGetErr(err error) error {
 	return sErrors.NewError(err,
 		proto.ActionableErr{
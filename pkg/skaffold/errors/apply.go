@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches `deployment.apps/leeroy-web created`
+	appliedResource = regexp.MustCompile(`^(\S+/\S+) (created|configured|unchanged|serverside-applied)\b`)
+	// matches `Error from server (Invalid): error when creating "STDIN": Deployment.apps "leeroy-web" is invalid: ...`
+	failedResource = regexp.MustCompile(`^Error from server(?: \(\w+\))?: error when [^"]*"[^"]*": (.*)$`)
+	// matches the last line of a failed patch, which kubectl prints over several lines
+	failedPatch = regexp.MustCompile(`^for: "[^"]*": (.*)$`)
+	// matches the `Deployment.apps "leeroy-web" is invalid: ...` part of a failure
+	failedResourceName = regexp.MustCompile(`^(\S+) "([^"]+)" (.*)$`)
+)
+
+// ApplyResult is the outcome of applying a single resource with `kubectl apply`.
+type ApplyResult struct {
+	// Resource is the applied resource, as `kind.group/name`. It is empty if it couldn't be determined from the output.
+	Resource string
+	// Status is what happened to the resource, eg. `created` or `unchanged`. It is empty when the resource failed to apply.
+	Status string
+	// Reason explains why the resource failed to apply.
+	Reason string
+}
+
+// Failed returns true if the resource could not be applied.
+func (r ApplyResult) Failed() bool {
+	return r.Status == ""
+}
+
+func (r ApplyResult) String() string {
+	switch {
+	case !r.Failed():
+		return fmt.Sprintf("%s %s", r.Resource, r.Status)
+	case r.Resource == "":
+		return r.Reason
+	default:
+		return fmt.Sprintf("%s %s", r.Resource, r.Reason)
+	}
+}
+
+// ParseApplyOutput splits the combined output of `kubectl apply` into per-resource results.
+func ParseApplyOutput(output string) []ApplyResult {
+	var results []ApplyResult
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if match := appliedResource.FindStringSubmatch(line); match != nil {
+			results = append(results, ApplyResult{Resource: match[1], Status: match[2]})
+			continue
+		}
+		match := failedResource.FindStringSubmatch(line)
+		if match == nil {
+			match = failedPatch.FindStringSubmatch(line)
+		}
+		if match == nil {
+			continue
+		}
+		if name := failedResourceName.FindStringSubmatch(match[1]); name != nil {
+			results = append(results, ApplyResult{
+				Resource: fmt.Sprintf("%s/%s", strings.ToLower(name[1]), name[2]),
+				Reason:   name[3],
+			})
+		} else {
+			results = append(results, ApplyResult{Reason: match[1]})
+		}
+	}
+	return results
+}
+
+// NewApplyErr returns an actionable error for a failed `kubectl apply` which enumerates
+// the resources that were applied and the ones that failed, with their individual reasons.
+// It returns nil if the output doesn't report any resource failing to apply.
+func NewApplyErr(err error, output string, sc proto.StatusCode, suggestions []*proto.Suggestion) error {
+	results := ParseApplyOutput(output)
+
+	var failed int
+	for _, r := range results {
+		if r.Failed() {
+			failed++
+		}
+	}
+	if failed == 0 {
+		return nil
+	}
+
+	var report strings.Builder
+	fmt.Fprintf(&report, "%v: %d of %d resources failed to apply", err, failed, len(results))
+	for _, r := range results {
+		fmt.Fprintf(&report, "\n - %s", r)
+	}
+	return NewError(err, proto.ActionableErr{
+		ErrCode:     sc,
+		Message:     report.String(),
+		Suggestions: suggestions,
+	})
+}
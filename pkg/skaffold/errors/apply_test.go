@@ -0,0 +1,94 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+const partialApplyOutput = `deployment.apps/leeroy-app created
+service/leeroy-app unchanged
+Error from server (Invalid): error when creating "STDIN": Deployment.apps "leeroy-web" is invalid: spec.template.spec.containers[0].image: Required value
+Error from server (Forbidden): error when applying patch:
+{"metadata":{"annotations":{}}}
+to:
+Resource: "rbac.authorization.k8s.io/v1, Resource=roles", GroupVersionKind: "rbac.authorization.k8s.io/v1, Kind=Role"
+Name: "reader", Namespace: "default"
+for: "STDIN": roles.rbac.authorization.k8s.io "reader" is forbidden: user "dev" cannot patch resource "roles"
+Error from server (InternalError): error when creating "STDIN": Internal error occurred: failed calling webhook
+`
+
+func TestParseApplyOutput(t *testing.T) {
+	tests := []struct {
+		description string
+		output      string
+		expected    []ApplyResult
+	}{
+		{
+			description: "all applied",
+			output:      "deployment.apps/leeroy-app configured\nservice/leeroy-app serverside-applied\n",
+			expected: []ApplyResult{
+				{Resource: "deployment.apps/leeroy-app", Status: "configured"},
+				{Resource: "service/leeroy-app", Status: "serverside-applied"},
+			},
+		},
+		{
+			description: "partial failure",
+			output:      partialApplyOutput,
+			expected: []ApplyResult{
+				{Resource: "deployment.apps/leeroy-app", Status: "created"},
+				{Resource: "service/leeroy-app", Status: "unchanged"},
+				{Resource: "deployment.apps/leeroy-web", Reason: "is invalid: spec.template.spec.containers[0].image: Required value"},
+				{Resource: "roles.rbac.authorization.k8s.io/reader", Reason: `is forbidden: user "dev" cannot patch resource "roles"`},
+				{Reason: "Internal error occurred: failed calling webhook"},
+			},
+		},
+		{
+			description: "no resources",
+			output:      `error: error validating "STDIN": error validating data: kind not set`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, ParseApplyOutput(test.output))
+		})
+	}
+}
+
+func TestNewApplyErr(t *testing.T) {
+	testutil.Run(t, "partial failure", func(t *testutil.T) {
+		err := NewApplyErr(fmt.Errorf("kubectl apply: exit status 1"), partialApplyOutput, proto.StatusCode_DEPLOY_KUBECTL_USER_ERR, nil)
+
+		t.CheckDeepEqual(`kubectl apply: exit status 1: 3 of 5 resources failed to apply
+ - deployment.apps/leeroy-app created
+ - service/leeroy-app unchanged
+ - deployment.apps/leeroy-web is invalid: spec.template.spec.containers[0].image: Required value
+ - roles.rbac.authorization.k8s.io/reader is forbidden: user "dev" cannot patch resource "roles"
+ - Internal error occurred: failed calling webhook`, err.Error())
+		t.CheckDeepEqual(proto.StatusCode_DEPLOY_KUBECTL_USER_ERR, err.(Error).StatusCode())
+	})
+
+	testutil.Run(t, "no failed resources", func(t *testutil.T) {
+		err := NewApplyErr(fmt.Errorf("kubectl apply: exit status 1"), "deployment.apps/leeroy-app created\n", proto.StatusCode_DEPLOY_KUBECTL_USER_ERR, nil)
+
+		t.CheckNoError(err)
+	})
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// clusterUnknownAuthority matches certificates of the cluster's API server signed by an untrusted CA, as reported by kubectl,
+// eg. `Unable to connect to the server: x509: certificate signed by unknown authority`, or by client-go, eg.
+// `Get "https://10.0.0.1:6443/api/v1/namespaces/default/pods": x509: certificate signed by unknown authority`.
+// Only requests to the API server match, as opposed to registries, which are trusted in the registry or Docker config instead.
+var clusterUnknownAuthority = regexp.MustCompile(`(?:Unable to connect to the server|https?://([^/\s"]+)/(?:api|apis|version|openapi)\b[^\s"]*"?): x509: certificate signed by unknown authority`)
+
+// ClusterUnknownAuthorityProblem is the problem of a cluster whose API server's certificate is signed by a CA
+// that isn't trusted by the kubeconfig, typical of self-hosted clusters. Both deploying and checking the status of
+// the deployed resources reach the API server, so the status code is the calling phase's.
+func ClusterUnknownAuthorityProblem(sc proto.StatusCode) Problem {
+	return Problem{
+		Regexp:           clusterUnknownAuthority,
+		ErrCode:          sc,
+		Description:      clusterUnknownAuthorityDescription,
+		SuggestionForErr: clusterUnknownAuthoritySuggestion,
+	}
+}
+
+func clusterUnknownAuthorityDescription(err error) string {
+	if host := untrustedAPIServer(err); host != "" {
+		return fmt.Sprintf("The certificate of the cluster's API server %s is signed by an unknown authority", host)
+	}
+	return "The certificate of the cluster's API server is signed by an unknown authority"
+}
+
+func clusterUnknownAuthoritySuggestion(interface{}, error) []*proto.Suggestion {
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CONFIGURE_CLUSTER_CA,
+		Action: "Set the CA bundle of the cluster in your kubeconfig, eg. with `kubectl config set-cluster <cluster> --certificate-authority=<ca.crt> --embed-certs`," +
+			" or, for test clusters only, mark the cluster insecure with `kubectl config set-cluster <cluster> --insecure-skip-tls-verify=true`",
+	}}
+}
+
+func untrustedAPIServer(err error) string {
+	if match := clusterUnknownAuthority.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
@@ -45,6 +45,11 @@ func (e ErrDef) Unwrap() error {
 	return e.err
 }
 
+// Is reports whether target is the sentinel error for this error's status code.
+func (e ErrDef) Is(target error) bool {
+	return isSentinel(e.ae.ErrCode, target)
+}
+
 func (e ErrDef) StatusCode() proto.StatusCode {
 	return e.ae.ErrCode
 }
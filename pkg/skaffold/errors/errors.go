@@ -82,6 +82,7 @@ func V2fromV1(ae proto.ActionableErr) protoV2.ActionableErr {
 }
 
 func ShowAIError(cfg interface{}, err error) error {
+	catalog := problemsFor(err)
 	if uErr := errors.Unwrap(err); uErr != nil {
 		err = uErr
 	}
@@ -92,10 +93,14 @@ func ShowAIError(cfg interface{}, err error) error {
 
 	if p, ok := isProblem(err); ok {
 		instrumentation.SetErrorCode(p.ErrCode)
+		if p.Err != nil {
+			// describe the wrapped error rather than the problem's own description.
+			return p.AIError(cfg, p.Err)
+		}
 		return p.AIError(cfg, err)
 	}
 
-	for _, problems := range GetProblemCatalogCopy().allErrors {
+	for _, problems := range catalog {
 		for _, p := range problems {
 			if p.Regexp.MatchString(err.Error()) {
 				instrumentation.SetErrorCode(p.ErrCode)
@@ -106,25 +111,56 @@ func ShowAIError(cfg interface{}, err error) error {
 	return err
 }
 
+// Classify returns the status code of an error of the given phase, its description and the suggestions to fix it,
+// for callers rendering them on their own. Unlike the message of ShowAIError, the description doesn't include the suggestions.
+func Classify(cfg interface{}, phase constants.Phase, err error) (proto.StatusCode, string, []*proto.Suggestion) {
+	return classify(cfg, phase, err)
+}
+
 func getErrorCodeFromError(cfg interface{}, phase constants.Phase, err error) (proto.StatusCode, []*proto.Suggestion) {
+	errCode, _, suggestions := classify(cfg, phase, err)
+	return errCode, suggestions
+}
+
+func classify(cfg interface{}, phase constants.Phase, err error) (proto.StatusCode, string, []*proto.Suggestion) {
+	if p, ok := phaseOf(err); ok {
+		phase = p
+	}
 	var sErr Error
 	if errors.As(err, &sErr) {
-		return sErr.StatusCode(), sErr.Suggestions()
+		description := sErr.Error()
+		var def ErrDef
+		if errors.As(err, &def) {
+			description = def.ae.Message
+		}
+		return sErr.StatusCode(), description, sErr.Suggestions()
+	}
+	var p Problem
+	if errors.As(err, &p) {
+		return p.ErrCode, strings.TrimSuffix(p.Error(), "."), p.suggestions(cfg, p.Err)
 	}
 
 	if problems, ok := GetProblemCatalogCopy().allErrors[phase]; ok {
 		for _, p := range problems {
 			if p.Regexp.MatchString(err.Error()) {
-				return p.ErrCode, p.Suggestion(cfg)
+				p.Err = err
+				return p.ErrCode, strings.TrimSuffix(p.Error(), "."), p.suggestions(cfg, err)
 			}
 		}
 	}
-	return unknownErrForPhase(phase), ReportIssueSuggestion(cfg)
+	return unknownErrForPhase(phase), err.Error(), ReportIssueSuggestion(cfg)
 }
 
+// concatSuggestions joins the actions of suggestions with " or ". Suggestions of several problems
+// matching the same error can repeat, so each action is only written once.
 func concatSuggestions(suggestions []*proto.Suggestion) string {
 	var s strings.Builder
+	seen := map[string]bool{}
 	for _, suggestion := range suggestions {
+		if seen[suggestion.Action] {
+			continue
+		}
+		seen[suggestion.Action] = true
 		if s.String() != "" {
 			s.WriteString(" or ")
 		}
@@ -155,6 +191,8 @@ func unknownErrForPhase(phase constants.Phase) proto.StatusCode {
 		return proto.StatusCode_DEVINIT_UNKNOWN
 	case constants.Cleanup:
 		return proto.StatusCode_CLEANUP_UNKNOWN
+	case constants.Render:
+		return proto.StatusCode_RENDER_UNKNOWN
 	default:
 		return proto.StatusCode_UNKNOWN_ERROR
 	}
@@ -18,6 +18,7 @@ package errors
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
@@ -120,6 +121,17 @@ func TestShowAIError(t *testing.T) {
 				Suggestions: ReportIssueSuggestion(dummyRunCtx),
 			},
 		},
+		{
+			description: "render unknown error",
+			phase:       constants.Render,
+			err:         fmt.Errorf("render failed: something went wrong"),
+			expected:    "render failed: something went wrong",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_RENDER_UNKNOWN,
+				Message:     "render failed: something went wrong",
+				Suggestions: ReportIssueSuggestion(dummyRunCtx),
+			},
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -131,3 +143,208 @@ func TestShowAIError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRecoverable(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&GetProblemCatalogCopy, func() ProblemCatalog {
+			pc := NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Build, []Problem{{
+				Regexp:      regexp.MustCompile("service unavailable"),
+				ErrCode:     proto.StatusCode_BUILD_REGISTRY_SERVER_ERR,
+				Recoverable: true,
+			}})
+			pc.AddPhaseProblems(constants.Deploy, []Problem{RegistryTLSTimeoutProblem(proto.StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT)})
+			return pc
+		})
+
+		t.CheckFalse(IsRecoverable(nil))
+		t.CheckTrue(IsRecoverable(fmt.Errorf("pushing: service unavailable")))
+		t.CheckFalse(IsRecoverable(fmt.Errorf("pushing: not found")))
+		t.CheckTrue(IsRecoverable(fmt.Errorf(`failed to pull image "gcr.io/project/app": net/http: TLS handshake timeout`)))
+		t.CheckTrue(IsRecoverable(fmt.Errorf("wrapped: %w", Problem{Recoverable: true, Err: fmt.Errorf("timeout")})))
+		t.CheckFalse(IsRecoverable(Problem{Err: fmt.Errorf("service unavailable")}))
+	})
+}
+
+func TestIsBenign(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&GetProblemCatalogCopy, func() ProblemCatalog {
+			pc := NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Cleanup, []Problem{{
+				Regexp:  regexp.MustCompile("already deleted"),
+				ErrCode: proto.StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND,
+				Benign:  true,
+			}})
+			pc.AddPhaseProblems(constants.Deploy, []Problem{{
+				Regexp:  regexp.MustCompile("forbidden"),
+				ErrCode: proto.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED,
+			}})
+			return pc
+		})
+
+		t.CheckFalse(IsBenign(nil))
+		t.CheckTrue(IsBenign(fmt.Errorf("deleting: already deleted")))
+		t.CheckFalse(IsBenign(fmt.Errorf("deleting: forbidden")))
+		t.CheckFalse(IsBenign(WithPhase(constants.Deploy, fmt.Errorf("already deleted"))))
+		t.CheckTrue(IsBenign(fmt.Errorf("wrapped: %w", Problem{Benign: true, Err: fmt.Errorf("gone")})))
+	})
+}
+
+func TestWithPhase(t *testing.T) {
+	catalog := func() ProblemCatalog {
+		pc := NewProblemCatalog()
+		pc.AddPhaseProblems(constants.Build, []Problem{{
+			Regexp:      regexp.MustCompile("connection refused"),
+			ErrCode:     proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING,
+			Description: func(error) string { return "Build Failed. Could not connect to Docker daemon" },
+		}})
+		pc.AddPhaseProblems(constants.Deploy, []Problem{{
+			Regexp:      regexp.MustCompile("connection refused"),
+			ErrCode:     proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR,
+			Description: func(error) string { return "Deploy Failed. Could not connect to cluster" },
+		}})
+		return pc
+	}
+
+	tests := []struct {
+		description string
+		phase       constants.Phase
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "recorded phase is used over the caller's",
+			phase:       constants.DevLoop,
+			err:         fmt.Errorf("dev loop: %w", WithPhase(constants.Deploy, fmt.Errorf("connection refused"))),
+			expected:    "Deploy Failed. Could not connect to cluster",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR,
+				Message: "dev loop: connection refused",
+			},
+		},
+		{
+			description: "innermost phase wins",
+			phase:       constants.DevLoop,
+			err:         WithPhase(constants.Deploy, fmt.Errorf("deploying: %w", WithPhase(constants.Build, fmt.Errorf("connection refused")))),
+			expected:    "Build Failed. Could not connect to Docker daemon",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING,
+				Message: "deploying: connection refused",
+			},
+		},
+		{
+			description: "unknown error of the recorded phase",
+			phase:       constants.DevLoop,
+			err:         WithPhase(constants.Build, fmt.Errorf("something went wrong")),
+			expected:    "something went wrong",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_BUILD_UNKNOWN,
+				Message:     "something went wrong",
+				Suggestions: ReportIssueSuggestion(nil),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetProblemCatalogCopy, catalog)
+
+			t.CheckDeepEqual(test.expected, ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(test.expectedAE, ActionableErr(nil, test.phase, test.err))
+		})
+	}
+
+	testutil.CheckDeepEqual(t, nil, WithPhase(constants.Build, nil))
+}
+
+func TestClassify(t *testing.T) {
+	suggestion := &proto.Suggestion{SuggestionCode: proto.SuggestionCode_CHECK_DOCKER_RUNNING, Action: "Check if docker is running"}
+	tests := []struct {
+		description         string
+		phase               constants.Phase
+		err                 error
+		expectedCode        proto.StatusCode
+		expectedMessage     string
+		expectedSuggestions []*proto.Suggestion
+	}{
+		{
+			description:         "matched problem",
+			phase:               constants.Build,
+			err:                 fmt.Errorf("building: connection refused"),
+			expectedCode:        proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING,
+			expectedMessage:     "Build Failed. Could not connect to Docker daemon: building: connection refused",
+			expectedSuggestions: []*proto.Suggestion{suggestion},
+		},
+		{
+			description:         "actionable error",
+			phase:               constants.Deploy,
+			err:                 fmt.Errorf("deploying: %w", NewError(fmt.Errorf("invalid"), proto.ActionableErr{ErrCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR, Message: "invalid manifest", Suggestions: []*proto.Suggestion{suggestion}})),
+			expectedCode:        proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR,
+			expectedMessage:     "invalid manifest",
+			expectedSuggestions: []*proto.Suggestion{suggestion},
+		},
+		{
+			description:         "unknown error",
+			phase:               constants.Deploy,
+			err:                 fmt.Errorf("connection refused"),
+			expectedCode:        proto.StatusCode_DEPLOY_UNKNOWN,
+			expectedMessage:     "connection refused",
+			expectedSuggestions: ReportIssueSuggestion(nil),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetProblemCatalogCopy, func() ProblemCatalog {
+				pc := NewProblemCatalog()
+				pc.AddPhaseProblems(constants.Build, []Problem{{
+					Regexp:  regexp.MustCompile("connection refused"),
+					ErrCode: proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING,
+					Description: func(err error) string {
+						return fmt.Sprintf("Build Failed. Could not connect to Docker daemon: %v", err)
+					},
+					Suggestion: func(interface{}) []*proto.Suggestion { return []*proto.Suggestion{suggestion} },
+				}})
+				return pc
+			})
+
+			code, message, suggestions := Classify(nil, test.phase, test.err)
+			t.CheckDeepEqual(test.expectedCode, code)
+			t.CheckDeepEqual(test.expectedMessage, message)
+			t.CheckDeepEqual(test.expectedSuggestions, suggestions)
+		})
+	}
+}
+
+func TestConcatSuggestions(t *testing.T) {
+	tests := []struct {
+		description string
+		suggestions []*proto.Suggestion
+		expected    string
+	}{
+		{
+			description: "no suggestions",
+		},
+		{
+			description: "distinct suggestions",
+			suggestions: []*proto.Suggestion{
+				{SuggestionCode: proto.SuggestionCode_CHECK_CLUSTER_CONNECTION, Action: "Check your connection for the cluster"},
+				{SuggestionCode: proto.SuggestionCode_CHECK_MINIKUBE_STATUS, Action: "Check if minikube is running using `minikube status` command and try again"},
+			},
+			expected: "Check your connection for the cluster or Check if minikube is running using `minikube status` command and try again.",
+		},
+		{
+			description: "overlapping suggestions",
+			suggestions: []*proto.Suggestion{
+				{SuggestionCode: proto.SuggestionCode_CHECK_CLUSTER_CONNECTION, Action: "Check your connection for the cluster"},
+				{SuggestionCode: proto.SuggestionCode_CHECK_MINIKUBE_STATUS, Action: "Check if minikube is running using `minikube status` command and try again"},
+				{SuggestionCode: proto.SuggestionCode_CHECK_CLUSTER_CONNECTION, Action: "Check your connection for the cluster"},
+			},
+			expected: "Check your connection for the cluster or Check if minikube is running using `minikube status` command and try again.",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, concatSuggestions(test.suggestions))
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// hostNotFound matches DNS resolution failures, eg. `dial tcp: lookup gcr.io on 127.0.0.53:53: no such host`
+var hostNotFound = regexp.MustCompile(`lookup ([^\s:]+)(?: on \S+)?: no such host`)
+
+// HostNotFoundProblem is the problem of a host name that can't be resolved, be it a registry,
+// the cluster's API server or any other endpoint. Every phase reaching out over the network
+// can hit it, so the status code is the calling phase's.
+func HostNotFoundProblem(sc proto.StatusCode) Problem {
+	return Problem{
+		Regexp:           hostNotFound,
+		ErrCode:          sc,
+		Description:      hostNotFoundDescription,
+		SuggestionForErr: hostNotFoundSuggestion,
+	}
+}
+
+func hostNotFoundDescription(err error) string {
+	if host := unresolvedHost(err); host != "" {
+		return fmt.Sprintf("Could not resolve host %q", host)
+	}
+	return fmt.Sprintf("Could not resolve host: %v", err)
+}
+
+func hostNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	host := unresolvedHost(err)
+	if host == "" {
+		return nil
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_HOST_RESOLUTION,
+		Action: fmt.Sprintf("Check that %q is spelled correctly and resolves, eg. with `nslookup %s`. "+
+			"If it does, check your DNS settings and /etc/hosts, or connect to the VPN if %s is on a private network", host, host, host),
+	}}
+}
+
+func unresolvedHost(err error) string {
+	if match := hostNotFound.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
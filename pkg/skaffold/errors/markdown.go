@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// Markdown renders an actionable error for chat tools and bots: the error message
+// in a code block followed by one bullet per suggested action.
+func Markdown(ae *proto.ActionableErr) string {
+	if ae == nil {
+		return ""
+	}
+
+	var s strings.Builder
+	if msg := strings.TrimRight(ae.Message, "\n"); msg != "" {
+		fence := codeFence(msg)
+		s.WriteString(fence + "\n" + msg + "\n" + fence + "\n")
+	}
+	for _, suggestion := range ae.Suggestions {
+		if suggestion == nil || suggestion.Action == "" {
+			continue
+		}
+		// keep multi-line actions within their bullet
+		s.WriteString("- " + strings.ReplaceAll(suggestion.Action, "\n", "\n  ") + "\n")
+	}
+	return s.String()
+}
+
+// codeFence returns a backtick fence longer than any run of backticks in the message.
+func codeFence(msg string) string {
+	longest, run := 0, 0
+	for _, c := range msg {
+		if c != '`' {
+			run = 0
+			continue
+		}
+		run++
+		if run > longest {
+			longest = run
+		}
+	}
+	if longest < 3 {
+		return "```"
+	}
+	return strings.Repeat("`", longest+1)
+}
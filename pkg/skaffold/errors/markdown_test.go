@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestMarkdown(t *testing.T) {
+	tests := []struct {
+		description string
+		ae          *proto.ActionableErr
+		expected    string
+	}{
+		{
+			description: "nil error",
+		},
+		{
+			description: "message and suggestions",
+			ae: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_BUILD_PUSH_ACCESS_DENIED,
+				Message: "could not push image \"gcr.io/project/app\": denied\n",
+				Suggestions: []*proto.Suggestion{
+					{SuggestionCode: proto.SuggestionCode_CHECK_DEFAULT_REPO, Action: "Check your `--default-repo` value"},
+					{SuggestionCode: proto.SuggestionCode_GCLOUD_DOCKER_AUTH_CONFIGURE, Action: "try `gcloud auth configure-docker`"},
+				},
+			},
+			expected: "```\ncould not push image \"gcr.io/project/app\": denied\n```\n" +
+				"- Check your `--default-repo` value\n" +
+				"- try `gcloud auth configure-docker`\n",
+		},
+		{
+			description: "message without suggestions",
+			ae:          &proto.ActionableErr{Message: "deploy failed"},
+			expected:    "```\ndeploy failed\n```\n",
+		},
+		{
+			description: "message containing a code fence",
+			ae: &proto.ActionableErr{
+				Message:     "invalid manifest:\n```\nkind: Foo\n```",
+				Suggestions: []*proto.Suggestion{{Action: ""}, {Action: "Fix the manifest\nand try again"}},
+			},
+			expected: "````\ninvalid manifest:\n```\nkind: Foo\n```\n````\n" +
+				"- Fix the manifest\n  and try again\n",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, Markdown(test.ae))
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+)
+
+// phaseErr is an error tagged with the phase it happened in.
+type phaseErr struct {
+	phase constants.Phase
+	err   error
+}
+
+func (e phaseErr) Error() string {
+	return e.err.Error()
+}
+
+func (e phaseErr) Unwrap() error {
+	return e.err
+}
+
+// WithPhase records the phase an error happened in. `ShowAIError` and `ActionableErr` then
+// classify the error against the problems of that phase, whatever the phase known at their call site.
+func WithPhase(phase constants.Phase, err error) error {
+	if err == nil {
+		return nil
+	}
+	return phaseErr{phase: phase, err: err}
+}
+
+// phaseOf returns the phase recorded with WithPhase. When the error was tagged
+// more than once along the way, the phase closest to where it happened wins.
+func phaseOf(err error) (constants.Phase, bool) {
+	var phase constants.Phase
+	found := false
+	for ; err != nil; err = errors.Unwrap(err) {
+		if p, ok := err.(phaseErr); ok {
+			phase, found = p.phase, true
+		}
+	}
+	return phase, found
+}
+
+// problemsFor returns the problems an error is matched against: the ones of its recorded phase, if any, or all of them.
+func problemsFor(err error) map[constants.Phase][]Problem {
+	all := GetProblemCatalogCopy().allErrors
+	if phase, ok := phaseOf(err); ok {
+		return map[constants.Phase][]Problem{phase: all[phase]}
+	}
+	return all
+}
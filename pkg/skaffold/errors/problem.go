@@ -17,6 +17,7 @@ limitations under the License.
 package errors
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -41,7 +42,13 @@ type Problem struct {
 	Description func(error) string
 	ErrCode     proto.StatusCode
 	Suggestion  func(cfg interface{}) []*proto.Suggestion
-	Err         error
+	// SuggestionForErr is used instead of Suggestion when the suggestions depend on the matched error.
+	SuggestionForErr func(cfg interface{}, err error) []*proto.Suggestion
+	// Recoverable is set for transient problems, after which the failed operation can be retried.
+	Recoverable bool
+	// Benign is set for problems that don't compromise the failed operation, which can report them rather than fail.
+	Benign bool
+	Err    error
 }
 
 func NewProblem(d descriptionFunc, sc proto.StatusCode, s suggestionFunc, err error) Problem {
@@ -63,15 +70,74 @@ func (p Problem) Error() string {
 
 func (p Problem) AIError(i interface{}, err error) error {
 	p.Err = err
-	if p.Suggestion == nil {
+	if p.Suggestion == nil && p.SuggestionForErr == nil {
 		return p
 	}
-	if suggestions := p.Suggestion(i); len(suggestions) > 0 {
-		return fmt.Errorf("%s. %s", strings.Trim(p.Error(), "."), concatSuggestions(suggestions))
+	if suggestions := p.suggestions(i, err); len(suggestions) > 0 {
+		return NewError(err, proto.ActionableErr{
+			ErrCode:     p.ErrCode,
+			Message:     strings.Trim(p.Error(), "."),
+			Suggestions: suggestions,
+		})
 	}
 	return p
 }
 
+func (p Problem) suggestions(cfg interface{}, err error) []*proto.Suggestion {
+	if p.SuggestionForErr != nil {
+		return p.SuggestionForErr(cfg, err)
+	}
+	if p.Suggestion != nil {
+		return p.Suggestion(cfg)
+	}
+	return nil
+}
+
+// Is reports whether target is the sentinel error for the problem's status code.
+func (p Problem) Is(target error) bool {
+	return isSentinel(p.ErrCode, target)
+}
+
+// IsRecoverable returns true if the error is a transient problem, in which case the failed operation
+// may succeed if retried.
+func IsRecoverable(err error) bool {
+	if err == nil {
+		return false
+	}
+	var p Problem
+	if errors.As(err, &p) {
+		return p.Recoverable
+	}
+	for _, problems := range problemsFor(err) {
+		for _, p := range problems {
+			if p.Regexp.MatchString(err.Error()) {
+				return p.Recoverable
+			}
+		}
+	}
+	return false
+}
+
+// IsBenign returns true if the error is a problem that doesn't compromise the failed operation,
+// eg. deleting resources that are already gone, in which case it can be reported rather than fail.
+func IsBenign(err error) bool {
+	if err == nil {
+		return false
+	}
+	var p Problem
+	if errors.As(err, &p) {
+		return p.Benign
+	}
+	for _, problems := range problemsFor(err) {
+		for _, p := range problems {
+			if p.Regexp.MatchString(err.Error()) {
+				return p.Benign
+			}
+		}
+	}
+	return false
+}
+
 func isProblem(err error) (Problem, bool) {
 	if p, ok := err.(Problem); ok {
 		return p, true
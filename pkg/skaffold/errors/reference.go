@@ -0,0 +1,72 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// invalidReference matches image names rejected by Docker or go-containerregistry, eg.
+	// `invalid reference format: repository name must be lowercase` or
+	// `Error parsing reference: "gcr.io/Project/app" is not a valid repository/tag: invalid reference format`
+	invalidReference = regexp.MustCompile(`invalid reference format(: repository name must be lowercase)?`)
+	invalidImageName = regexp.MustCompile(`"([^"\s]+)"(?: is not a valid repository/tag)?: invalid reference format`)
+)
+
+// InvalidImageReferenceProblem is the problem of an image name that can't be parsed, usually a typo in
+// the configuration. Images are named when building and when deploying, so the status code is the calling phase's.
+func InvalidImageReferenceProblem(sc proto.StatusCode) Problem {
+	return Problem{
+		Regexp:           invalidReference,
+		ErrCode:          sc,
+		Description:      invalidReferenceDescription,
+		SuggestionForErr: invalidReferenceSuggestion,
+	}
+}
+
+func invalidReferenceDescription(err error) string {
+	reason := "it is not a valid reference"
+	if match := invalidReference.FindStringSubmatch(err.Error()); len(match) > 1 && match[1] != "" {
+		reason = "repository names must be lowercase"
+	}
+	if image := invalidImage(err); image != "" {
+		return fmt.Sprintf("Invalid image name %q: %s", image, reason)
+	}
+	return fmt.Sprintf("Invalid image name: %s", reason)
+}
+
+func invalidReferenceSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	action := "Fix the `image` field of the artifacts in `build.artifacts`"
+	if image := invalidImage(err); image != "" {
+		action = fmt.Sprintf("Fix the `image` field of the artifact building %q in `build.artifacts`", image)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_FIX_IMAGE_NAME,
+		Action:         action + ": image names must be lowercase, and only contain letters, digits and the `.`, `_`, `-` and `/` separators",
+	}}
+}
+
+func invalidImage(err error) string {
+	if match := invalidImageName.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
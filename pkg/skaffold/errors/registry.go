@@ -0,0 +1,122 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// registryTLSTimeout matches TLS handshake timeouts with a registry, as opposed to the cluster's API server, eg.
+	// `failed to pull image "gcr.io/k8s-skaffold/app": ... net/http: TLS handshake timeout` or
+	// `Get https://registry-1.docker.io/v2/: net/http: TLS handshake timeout`
+	registryTLSTimeout = regexp.MustCompile(`(?i)(?:pull|/v2/).*net/http: TLS handshake timeout`)
+	// registryConnectionReset matches connections with a registry reset while pushing or pulling an image, eg.
+	// `Put "https://gcr.io/v2/project/app/blobs/uploads/...": write tcp 10.0.0.2:51234->74.125.1.82:443: write: connection reset by peer`
+	registryConnectionReset = regexp.MustCompile(`(?i)(?:push|pull|/v2/)[^\n]*connection reset by peer`)
+	registryURL             = regexp.MustCompile(`https?://([^/\s"]+)/v2/`)
+	pulledImage             = regexp.MustCompile(`(?i)pull(?:ing)? image "?([^"\s]+)`)
+)
+
+// RegistryTLSTimeoutProblem is the problem of a registry that is too slow, or blocked, to complete TLS handshakes.
+// Both building and deploying pull images, so the status code is the calling phase's.
+// Such timeouts are usually transient.
+func RegistryTLSTimeoutProblem(sc proto.StatusCode) Problem {
+	return Problem{
+		Regexp:           registryTLSTimeout,
+		ErrCode:          sc,
+		Description:      registryTLSTimeoutDescription,
+		SuggestionForErr: registryTLSTimeoutSuggestion,
+		Recoverable:      true,
+	}
+}
+
+func registryTLSTimeoutDescription(err error) string {
+	if host := timedOutRegistry(err); host != "" {
+		return fmt.Sprintf("TLS handshake with registry %q timed out", host)
+	}
+	return fmt.Sprintf("TLS handshake with the image registry timed out: %v", err)
+}
+
+func registryTLSTimeoutSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	host := timedOutRegistry(err)
+	if host == "" {
+		host = "the registry"
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+		Action:         fmt.Sprintf("Check your network connectivity to %s and any proxy or firewall in between, or configure a pull-through cache closer to you", host),
+	}}
+}
+
+// RegistryConnectionResetProblem is the problem of a registry connection that is reset while pushing or pulling an image.
+// Resets are usually transient, but keep happening when packets are dropped along the way, eg. by a VPN with a lower MTU.
+func RegistryConnectionResetProblem(sc proto.StatusCode) Problem {
+	return Problem{
+		Regexp:           registryConnectionReset,
+		ErrCode:          sc,
+		Description:      registryConnectionResetDescription,
+		SuggestionForErr: registryConnectionResetSuggestion,
+		Recoverable:      true,
+	}
+}
+
+func registryConnectionResetDescription(err error) string {
+	if host := timedOutRegistry(err); host != "" {
+		return fmt.Sprintf("Connection with registry %q was reset", host)
+	}
+	return fmt.Sprintf("Connection with the image registry was reset: %v", err)
+}
+
+func registryConnectionResetSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	host := timedOutRegistry(err)
+	if host == "" {
+		host = "the registry"
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY,
+		Action: fmt.Sprintf("Try again, as such resets are usually transient. If they keep happening, check the MTU of any VPN or proxy between you and %s,"+
+			" and lower the MTU of the Docker daemon, eg. with `\"mtu\": 1400` in its daemon.json", host),
+	}}
+}
+
+// timedOutRegistry returns the host of the registry from the URL or the image found in the error.
+func timedOutRegistry(err error) string {
+	if match := registryURL.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	if match := pulledImage.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return imageRegistry(match[1])
+	}
+	return ""
+}
+
+// imageRegistry returns the registry host of an image reference, defaulting to Docker Hub.
+func imageRegistry(image string) string {
+	i := strings.Index(image, "/")
+	if i < 0 {
+		return "docker.io"
+	}
+	if host := image[:i]; strings.ContainsAny(host, ".:") || host == "localhost" {
+		return host
+	}
+	return "docker.io"
+}
@@ -0,0 +1,58 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// Sentinel errors for well-known problem categories.
+// Errors classified by `ShowAIError`, and any `Error` carrying one of the
+// matching status codes, report true for `errors.Is(err, ErrXxx)`.
+var (
+	ErrPushAccessDenied       = errors.New("push access denied")
+	ErrProjectNotFound        = errors.New("project not found")
+	ErrDockerDaemonNotRunning = errors.New("docker daemon not running")
+	ErrDockerfileNotFound     = errors.New("dockerfile not found")
+	ErrBuildCancelled         = errors.New("build cancelled")
+	ErrClusterConnection      = errors.New("cluster connection failed")
+	ErrMinikubeNotRunning     = errors.New("minikube not running")
+	ErrConfigNotFound         = errors.New("skaffold config not found")
+	ErrManifestNotFound       = errors.New("manifest not found")
+)
+
+var sentinels = map[proto.StatusCode]error{
+	proto.StatusCode_BUILD_PUSH_ACCESS_DENIED:           ErrPushAccessDenied,
+	proto.StatusCode_BUILD_PROJECT_NOT_FOUND:            ErrProjectNotFound,
+	proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING:    ErrDockerDaemonNotRunning,
+	proto.StatusCode_BUILD_DOCKERFILE_NOT_FOUND:         ErrDockerfileNotFound,
+	proto.StatusCode_BUILD_CANCELLED:                    ErrBuildCancelled,
+	proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR:      ErrClusterConnection,
+	proto.StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR: ErrClusterConnection,
+	proto.StatusCode_INIT_MINIKUBE_NOT_RUNNING_ERROR:    ErrMinikubeNotRunning,
+	proto.StatusCode_INIT_MINIKUBE_PAUSED_ERROR:         ErrMinikubeNotRunning,
+	proto.StatusCode_CONFIG_FILE_NOT_FOUND_ERR:          ErrConfigNotFound,
+	proto.StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR:   ErrManifestNotFound,
+}
+
+// isSentinel reports whether target is the sentinel error registered for the status code.
+func isSentinel(code proto.StatusCode, target error) bool {
+	s, ok := sentinels[code]
+	return ok && s == target
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSentinelErrors(t *testing.T) {
+	suggestion := func(interface{}) []*proto.Suggestion {
+		return []*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_CHECK_DOCKER_RUNNING, Action: "Check if docker is running"}}
+	}
+	tests := []struct {
+		description string
+		problem     Problem
+		err         error
+		expected    error
+		notExpected error
+	}{
+		{
+			description: "problem with suggestions",
+			problem: Problem{
+				Regexp:     regexp.MustCompile(".*Cannot connect to the Docker daemon.*"),
+				ErrCode:    proto.StatusCode_BUILD_DOCKER_DAEMON_NOT_RUNNING,
+				Suggestion: suggestion,
+			},
+			err:         fmt.Errorf("docker build: Cannot connect to the Docker daemon"),
+			expected:    ErrDockerDaemonNotRunning,
+			notExpected: ErrPushAccessDenied,
+		},
+		{
+			description: "problem without suggestions",
+			problem: Problem{
+				Regexp:  regexp.MustCompile(".*context canceled.*"),
+				ErrCode: proto.StatusCode_BUILD_CANCELLED,
+			},
+			err:         fmt.Errorf("docker build: context canceled"),
+			expected:    ErrBuildCancelled,
+			notExpected: ErrDockerDaemonNotRunning,
+		},
+		{
+			description: "problem without a sentinel",
+			problem: Problem{
+				Regexp:  regexp.MustCompile(".*creating tagger.*"),
+				ErrCode: proto.StatusCode_INIT_CREATE_TAGGER_ERROR,
+			},
+			err:         fmt.Errorf("creating tagger: bad template"),
+			notExpected: ErrConfigNotFound,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&GetProblemCatalogCopy, func() ProblemCatalog {
+				pc := NewProblemCatalog()
+				pc.AddPhaseProblems(constants.Build, []Problem{test.problem})
+				return pc
+			})
+			actual := ShowAIError(nil, test.err)
+			if test.expected != nil {
+				t.CheckTrue(errors.Is(actual, test.expected))
+			}
+			t.CheckFalse(errors.Is(actual, test.notExpected))
+		})
+	}
+}
+
+func TestSentinelErrDef(t *testing.T) {
+	err := NewError(fmt.Errorf("dial tcp: connection refused"), proto.ActionableErr{
+		ErrCode: proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR,
+		Message: "unable to connect to Kubernetes",
+	})
+
+	testutil.CheckDeepEqual(t, true, errors.Is(err, ErrClusterConnection))
+	testutil.CheckDeepEqual(t, true, errors.Is(fmt.Errorf("deploying: %w", err), ErrClusterConnection))
+	testutil.CheckDeepEqual(t, false, errors.Is(err, ErrManifestNotFound))
+}
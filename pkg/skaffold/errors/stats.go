@@ -0,0 +1,137 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+// ErrorCount is the number of errors of a phase classified with a given status code.
+type ErrorCount struct {
+	Phase      constants.Phase
+	StatusCode proto.StatusCode
+	Count      int
+}
+
+func (c ErrorCount) String() string {
+	if c.Phase == "" {
+		return fmt.Sprintf("%s: %d", c.StatusCode, c.Count)
+	}
+	return fmt.Sprintf("%s %s: %d", c.Phase, c.StatusCode, c.Count)
+}
+
+type errorKey struct {
+	phase      constants.Phase
+	statusCode proto.StatusCode
+}
+
+// errorStats counts the errors recorded with RecordError, once enabled.
+var errorStats struct {
+	enabled  bool
+	counts   map[errorKey]int
+	recorded []error
+	lock     sync.Mutex
+}
+
+// EnableStatistics starts counting the errors reported over the lifetime of the process.
+func EnableStatistics() {
+	errorStats.lock.Lock()
+	defer errorStats.lock.Unlock()
+
+	errorStats.enabled = true
+	if errorStats.counts == nil {
+		errorStats.counts = map[errorKey]int{}
+	}
+}
+
+// Statistics returns the number of errors reported for each phase and status code
+// since EnableStatistics was called, ordered by phase and status code.
+func Statistics() []ErrorCount {
+	errorStats.lock.Lock()
+	defer errorStats.lock.Unlock()
+
+	counts := make([]ErrorCount, 0, len(errorStats.counts))
+	for key, count := range errorStats.counts {
+		counts = append(counts, ErrorCount{Phase: key.phase, StatusCode: key.statusCode, Count: count})
+	}
+	sort.Slice(counts, func(i, j int) bool {
+		if counts[i].Phase != counts[j].Phase {
+			return counts[i].Phase < counts[j].Phase
+		}
+		return counts[i].StatusCode < counts[j].StatusCode
+	})
+	return counts
+}
+
+// RecordError counts an error where it's reported, under the phase recorded with WithPhase if any,
+// or else under the given phase. An error is only counted once: errors already recorded, and the ones
+// wrapping them, like the error a command fails with after a build failure was reported, are skipped.
+// Errors of an unknown phase are counted under the phase of the problem they match, if any.
+// Cancellations aren't counted.
+func RecordError(cfg interface{}, phase constants.Phase, err error) {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return
+	}
+
+	errorStats.lock.Lock()
+	defer errorStats.lock.Unlock()
+
+	if !errorStats.enabled {
+		return
+	}
+	for _, recorded := range errorStats.recorded {
+		if errors.Is(err, recorded) {
+			return
+		}
+	}
+	errorStats.recorded = append(errorStats.recorded, err)
+
+	phase = phaseForStatistics(phase, err)
+	sc, _, _ := classify(cfg, phase, err)
+	errorStats.counts[errorKey{phase: phase, statusCode: sc}]++
+}
+
+// phaseForStatistics returns the phase an error is counted under.
+func phaseForStatistics(phase constants.Phase, err error) constants.Phase {
+	if p, ok := phaseOf(err); ok {
+		return p
+	}
+	if phase != "" {
+		return phase
+	}
+	catalog := GetProblemCatalogCopy().allErrors
+	phases := make([]string, 0, len(catalog))
+	for p := range catalog {
+		phases = append(phases, string(p))
+	}
+	sort.Strings(phases)
+	for _, p := range phases {
+		for _, problem := range catalog[constants.Phase(p)] {
+			if problem.Regexp.MatchString(err.Error()) {
+				return constants.Phase(p)
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package errors
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sync"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func resetStatistics() {
+	errorStats.lock.Lock()
+	errorStats.enabled = false
+	errorStats.counts = nil
+	errorStats.recorded = nil
+	errorStats.lock.Unlock()
+}
+
+func TestStatistics(t *testing.T) {
+	testutil.Run(t, "errors are not counted until enabled", func(t *testutil.T) {
+		resetStatistics()
+		t.Cleanup(resetStatistics)
+
+		RecordError(nil, constants.Deploy, fmt.Errorf("deploy failed"))
+		t.CheckDeepEqual([]ErrorCount{}, Statistics())
+	})
+
+	testutil.Run(t, "errors are counted by phase and status code", func(t *testutil.T) {
+		resetStatistics()
+		t.Cleanup(resetStatistics)
+		EnableStatistics()
+
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(2)
+			go func() {
+				defer wg.Done()
+				RecordError(nil, constants.Deploy, fmt.Errorf("deploy failed"))
+			}()
+			go func() {
+				defer wg.Done()
+				RecordError(nil, constants.Deploy, WithPhase(constants.Build, fmt.Errorf("build failed")))
+			}()
+		}
+		wg.Wait()
+		RecordError(nil, constants.Deploy, NewError(fmt.Errorf("invalid manifest"), proto.ActionableErr{ErrCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR}))
+
+		t.CheckDeepEqual([]ErrorCount{
+			{Phase: constants.Build, StatusCode: proto.StatusCode_BUILD_UNKNOWN, Count: 10},
+			{Phase: constants.Deploy, StatusCode: proto.StatusCode_DEPLOY_UNKNOWN, Count: 10},
+			{Phase: constants.Deploy, StatusCode: proto.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR, Count: 1},
+		}, Statistics())
+	})
+
+	testutil.Run(t, "errors are counted once", func(t *testutil.T) {
+		resetStatistics()
+		t.Cleanup(resetStatistics)
+		EnableStatistics()
+
+		err := fmt.Errorf("build failed")
+		RecordError(nil, constants.Build, err)
+		RecordError(nil, constants.Build, err)
+		RecordError(nil, "", fmt.Errorf("running build: %w", err))
+		ActionableErr(nil, constants.Build, err)
+
+		t.CheckDeepEqual([]ErrorCount{{Phase: constants.Build, StatusCode: proto.StatusCode_BUILD_UNKNOWN, Count: 1}}, Statistics())
+	})
+
+	testutil.Run(t, "errors of unknown phases are counted under the phase of the problem they match", func(t *testutil.T) {
+		resetStatistics()
+		t.Cleanup(resetStatistics)
+		t.Override(&GetProblemCatalogCopy, func() ProblemCatalog {
+			pc := NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Deploy, []Problem{{
+				Regexp:      regexp.MustCompile("no space left"),
+				ErrCode:     proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR,
+				Description: func(error) string { return "no space left" },
+			}})
+			return pc
+		})
+		EnableStatistics()
+
+		RecordError(nil, "", fmt.Errorf("no space left on device"))
+		RecordError(nil, "", fmt.Errorf("exit status 1"))
+		RecordError(nil, constants.Build, context.Canceled)
+
+		t.CheckDeepEqual([]ErrorCount{
+			{StatusCode: proto.StatusCode_UNKNOWN_ERROR, Count: 1},
+			{Phase: constants.Deploy, StatusCode: proto.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR, Count: 1},
+		}, Statistics())
+	})
+}
@@ -208,6 +208,7 @@ func DeployInProgress() {
 
 // DeployFailed notifies that non-fatal errors were encountered during a deployment.
 func DeployFailed(err error) {
+	sErrors.RecordError(handler.cfg, constants.Deploy, err)
 	aiErr := sErrors.ActionableErr(handler.cfg, constants.Deploy, err)
 	handler.stateLock.Lock()
 	handler.state.DeployState.StatusCode = aiErr.ErrCode
@@ -325,6 +326,7 @@ func BuildCanceled(imageName string) {
 
 // BuildFailed notifies that a build has failed.
 func BuildFailed(imageName string, err error) {
+	sErrors.RecordError(handler.cfg, constants.Build, err)
 	aiErr := sErrors.ActionableErr(handler.cfg, constants.Build, err)
 	handler.handleBuildEvent(&proto.BuildEvent{
 		Artifact:      imageName,
@@ -351,6 +353,7 @@ func TestCanceled() {
 
 // TestFailed notifies that a test has failed.
 func TestFailed(imageName string, err error) {
+	sErrors.RecordError(handler.cfg, constants.Test, err)
 	aiErr := sErrors.ActionableErr(handler.cfg, constants.Test, err)
 	handler.stateLock.Lock()
 	handler.state.TestState.StatusCode = aiErr.ErrCode
@@ -399,6 +402,7 @@ func DevLoopFailedInPhase(iteration int, phase constants.Phase, err error) {
 	case constants.Test:
 		DevLoopFailedWithErrorCode(iteration, state.TestState.StatusCode, err)
 	default:
+		sErrors.RecordError(handler.cfg, phase, err)
 		ai := sErrors.ActionableErr(handler.cfg, phase, err)
 		DevLoopFailedWithErrorCode(iteration, ai.ErrCode, err)
 	}
@@ -416,6 +420,7 @@ func FileSyncInProgress(fileCount int, image string) {
 
 // FileSyncFailed notifies that a file sync has failed.
 func FileSyncFailed(fileCount int, image string, err error) {
+	sErrors.RecordError(handler.cfg, constants.Sync, err)
 	aiErr := sErrors.ActionableErr(handler.cfg, constants.Sync, err)
 	handler.handleFileSyncEvent(&proto.FileSyncEvent{FileCount: int32(fileCount), Image: image, Status: Failed,
 		Err: err.Error(), ErrCode: aiErr.ErrCode, ActionableErr: aiErr})
@@ -800,6 +805,7 @@ func BuildSequenceFailed(err error) {
 }
 
 func InititializationFailed(err error) {
+	sErrors.RecordError(handler.cfg, constants.Init, err)
 	handler.handle(&proto.Event{
 		EventType: &proto.Event_TerminationEvent{
 			TerminationEvent: &proto.TerminationEvent{
@@ -348,10 +348,11 @@ func TaskSucceeded(task constants.Phase) {
 	})
 }
 
-// PortForwarded notifies that a remote port has been forwarded locally.
-func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address string) {
+// PortForwarded notifies that a remote port has been forwarded locally,
+// during the given dev loop iteration.
+func PortForwarded(localPort int32, remotePort util.IntOrString, podName, containerName, namespace string, portName string, resourceType, resourceName, address, webSocketURL string, iteration int) {
 	event := proto.PortForwardEvent{
-		TaskId:        fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration),
+		TaskId:        fmt.Sprintf("%s-%d", constants.PortForward, iteration),
 		LocalPort:     localPort,
 		PodName:       podName,
 		ContainerName: containerName,
@@ -365,6 +366,8 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 			IntVal: int32(remotePort.IntVal),
 			StrVal: remotePort.StrVal,
 		},
+		WebSocketUrl: webSocketURL,
+		Iteration:    int32(iteration),
 	}
 	handler.handle(&proto.Event{
 		EventType: &proto.Event_PortEvent{
@@ -373,6 +376,20 @@ func PortForwarded(localPort int32, remotePort util.IntOrString, podName, contai
 	})
 }
 
+// PortForwardReadinessChanged notifies that the aggregate readiness of all active port forwards has changed.
+func PortForwardReadinessChanged(ready bool, readyCount, totalCount int) {
+	handler.handle(&proto.Event{
+		EventType: &proto.Event_PortForwardReadinessEvent{
+			PortForwardReadinessEvent: &proto.PortForwardReadinessEvent{
+				TaskId:     fmt.Sprintf("%s-%d", constants.PortForward, handler.iteration),
+				Ready:      ready,
+				ReadyCount: int32(readyCount),
+				TotalCount: int32(totalCount),
+			},
+		},
+	})
+}
+
 func (ev *eventHandler) setState(state proto.State) {
 	ev.stateLock.Lock()
 	ev.state = state
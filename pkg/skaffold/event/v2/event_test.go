@@ -289,6 +289,17 @@ func TestTaskFailed(t *testing.T) {
 	}
 }
 
+func TestPortForwardReadinessChanged(t *testing.T) {
+	PortForwardReadinessChanged(false, 1, 2)
+	wait(t, func() bool {
+		handler.logLock.Lock()
+		logEntry := handler.eventLog[len(handler.eventLog)-1]
+		handler.logLock.Unlock()
+		re := logEntry.GetPortForwardReadinessEvent()
+		return re != nil && !re.Ready && re.ReadyCount == 1 && re.TotalCount == 2 && re.TaskId == "PortForward-0"
+	})
+}
+
 func TestAutoTriggerDiff(t *testing.T) {
 	tests := []struct {
 		description  string
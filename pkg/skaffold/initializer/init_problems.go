@@ -17,14 +17,34 @@ limitations under the License.
 package initializer
 
 import (
+	"fmt"
 	"regexp"
+	"strings"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/apiversion"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 var (
+	// matches `Profile "dev" not found. Run "minikube profile list" to view all profiles.`,
+	// possibly with escaped quotes when quoted from the command output
+	minikubeProfileNotFound = re(`(?i)profile \\?"([^"\\]*)\\?" not found`)
+	// matches `no nodes found for cluster "kind"` and `no clusters found`
+	kindClusterNotFound = re(`no (?:nodes found for cluster \\?"([^"\\]*)\\?"|clusters found)`)
+	// matches `unknown skaffold config API version "skaffold/v9beta1"` and `unknown apiVersion skaffold/v9beta1`,
+	// possibly with escaped quotes when quoted from the output of another skaffold command
+	unsupportedAPIVersion = re(`unknown (?:skaffold config API version|apiVersion) \\?"?([^"\\\s]+)`)
+	// matches docker-compose's `Network dev declared as external, but could not be found.`
+	// and `network dev not found`, possibly with escaped quotes around the network name
+	composeNetworkNotFound = re(`(?i)network \\?"?([\w.-]+)\\?"? (?:declared as external, but could )?not (?:be )?found`)
+	// matches values of the wrong type in the config, as reported by yaml, eg. "line 12: cannot unmarshal !!str `eight` into int",
+	// or by json, eg. "cannot unmarshal string into Go struct field PortForwardResource.localPort of type int"
+	fieldTypeMismatch = re("(?:line (\\d+): )?cannot unmarshal (!!\\w+|\\w+)(?: `([^`]*)`)? into (?:Go struct field (\\S+) of type |Go value of type )?(\\S+)")
+
 	problems = []sErrors.Problem{
 		{
 			Regexp:     re(".*creating tagger.*"),
@@ -42,6 +62,37 @@ var (
 				}}
 			},
 		},
+		{
+			Regexp:           minikubeProfileNotFound,
+			ErrCode:          proto.StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND,
+			Description:      minikubeProfileNotFoundDescription,
+			SuggestionForErr: minikubeProfileNotFoundSuggestion,
+		},
+		{
+			Regexp:           kindClusterNotFound,
+			ErrCode:          proto.StatusCode_INIT_KIND_CLUSTER_NOT_FOUND,
+			Description:      kindClusterNotFoundDescription,
+			SuggestionForErr: kindClusterNotFoundSuggestion,
+		},
+		{
+			Regexp:           unsupportedAPIVersion,
+			ErrCode:          proto.StatusCode_CONFIG_UNKNOWN_API_VERSION_ERR,
+			Description:      unsupportedAPIVersionDescription,
+			SuggestionForErr: unsupportedAPIVersionSuggestion,
+		},
+		sErrors.HostNotFoundProblem(proto.StatusCode_INIT_HOST_NOT_FOUND),
+		{
+			Regexp:           composeNetworkNotFound,
+			ErrCode:          proto.StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND,
+			Description:      composeNetworkNotFoundDescription,
+			SuggestionForErr: composeNetworkNotFoundSuggestion,
+		},
+		{
+			Regexp:           fieldTypeMismatch,
+			ErrCode:          proto.StatusCode_CONFIG_FIELD_TYPE_ERR,
+			Description:      fieldTypeMismatchDescription,
+			SuggestionForErr: fieldTypeMismatchSuggestion,
+		},
 		{
 			Regexp:     re(".*creating builder.*"),
 			ErrCode:    proto.StatusCode_INIT_CREATE_BUILDER_ERROR,
@@ -75,6 +126,137 @@ var (
 	}
 )
 
+func minikubeProfileNotFoundDescription(err error) string {
+	if profile := submatch(minikubeProfileNotFound, err); profile != "" {
+		return fmt.Sprintf("minikube profile %q does not exist", profile)
+	}
+	return "minikube profile does not exist"
+}
+
+func minikubeProfileNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	action := `Try running "minikube start"`
+	if profile := submatch(minikubeProfileNotFound, err); profile != "" && profile != "minikube" {
+		action = fmt.Sprintf(`Try running "minikube start -p %s"`, profile)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_START_MINIKUBE,
+		Action:         action,
+	}}
+}
+
+func kindClusterNotFoundDescription(err error) string {
+	if cluster := submatch(kindClusterNotFound, err); cluster != "" {
+		return fmt.Sprintf("kind cluster %q does not exist", cluster)
+	}
+	return "no kind cluster exists"
+}
+
+func kindClusterNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	action := `Try running "kind create cluster"`
+	if cluster := submatch(kindClusterNotFound, err); cluster != "" && cluster != "kind" {
+		action = fmt.Sprintf(`Try running "kind create cluster --name %s"`, cluster)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CREATE_KIND_CLUSTER,
+		Action:         action,
+	}}
+}
+
+func unsupportedAPIVersionDescription(err error) string {
+	return fmt.Sprintf("skaffold config API version %q is not supported: this version of skaffold supports %s to %s",
+		submatch(unsupportedAPIVersion, err), schema.AllVersions[0].APIVersion, latestV1.Version)
+}
+
+func unsupportedAPIVersionSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	action := fmt.Sprintf("Set the config 'apiVersion' to a supported version, then run `skaffold fix --overwrite` to upgrade the config to %s", latestV1.Version)
+	version, parseErr := apiversion.Parse(submatch(unsupportedAPIVersion, err))
+	if latest, _ := apiversion.Parse(latestV1.Version); parseErr == nil && version.GT(latest) {
+		action = fmt.Sprintf("Upgrade skaffold to a release supporting %s, or set the config 'apiVersion' to %s", submatch(unsupportedAPIVersion, err), latestV1.Version)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CONFIG_FIX_API_VERSION,
+		Action:         action,
+	}}
+}
+
+func composeNetworkNotFoundDescription(err error) string {
+	return fmt.Sprintf("network %q referenced by the docker-compose file does not exist", submatch(composeNetworkNotFound, err))
+}
+
+func composeNetworkNotFoundSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	network := submatch(composeNetworkNotFound, err)
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CREATE_COMPOSE_NETWORK,
+		Action:         fmt.Sprintf(`Try running "docker network create %s", or fix the networks of the docker-compose file`, network),
+	}}
+}
+
+// yamlTags are the names of the yaml tags reported as the source type of mismatched values.
+var yamlTags = map[string]string{
+	"!!str":   "string",
+	"!!int":   "int",
+	"!!float": "float",
+	"!!bool":  "bool",
+	"!!seq":   "list",
+	"!!map":   "map",
+	"!!null":  "null",
+}
+
+// fieldTypeMismatchOf returns the line, source type, value, field and target type of a mismatched value, when reported.
+func fieldTypeMismatchOf(err error) (line, source, value, field, target string) {
+	match := fieldTypeMismatch.FindStringSubmatch(err.Error())
+	if len(match) < 6 {
+		return
+	}
+	line, source, value, field, target = match[1], match[2], match[3], match[4], match[5]
+	if name, found := yamlTags[source]; found {
+		source = name
+	}
+	// json reports the path of the field from its struct, eg. `PortForwardResource.localPort`
+	if i := strings.LastIndex(field, "."); i >= 0 {
+		field = field[i+1:]
+	}
+	return
+}
+
+func fieldTypeMismatchDescription(err error) string {
+	line, source, value, field, target := fieldTypeMismatchOf(err)
+	subject := "the value"
+	if value != "" {
+		subject += fmt.Sprintf(" `%s`", value)
+	}
+	switch {
+	case field != "":
+		subject += fmt.Sprintf(" of field %q", field)
+	case line != "":
+		subject += fmt.Sprintf(" at line %s", line)
+	}
+	return fmt.Sprintf("invalid skaffold config: %s is of type %s where type %s is expected", subject, source, target)
+}
+
+func fieldTypeMismatchSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	line, _, _, field, target := fieldTypeMismatchOf(err)
+	action := fmt.Sprintf("Check the type expected by the field, and fix its value to be of type %s", target)
+	switch {
+	case field != "":
+		action = fmt.Sprintf("Check the type expected by field %q, and fix its value to be of type %s", field, target)
+	case line != "":
+		action = fmt.Sprintf("Check the type expected by the field at line %s, and fix its value to be of type %s", line, target)
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CONFIG_FIX_FIELD_TYPE,
+		Action:         action + ". The type of each field is listed in https://skaffold.dev/docs/references/yaml/",
+	}}
+}
+
+// submatch returns the first group captured by r in the error message, if any.
+func submatch(r *regexp.Regexp, err error) string {
+	if match := r.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
 // re is a shortcut around regexp.MustCompile
 func re(s string) *regexp.Regexp {
 	return regexp.MustCompile(s)
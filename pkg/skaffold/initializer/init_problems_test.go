@@ -28,6 +28,7 @@ import (
 )
 
 func TestInitProblems(t *testing.T) {
+	docs := " The type of each field is listed in https://skaffold.dev/docs/references/yaml/"
 	initTestCases := []struct {
 		description string
 		opts        config.SkaffoldOptions
@@ -64,6 +65,66 @@ func TestInitProblems(t *testing.T) {
 				}},
 			},
 		},
+		{
+			description: "minikube profile not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("getting minikube env: running [minikube docker-env --shell none -p dev]\n - stdout: \"* Profile \\\"dev\\\" not found. Run \\\"minikube profile list\\\" to view all profiles.\\n\"\n - cause: exit status 85"),
+			expected:    "minikube profile \"dev\" does not exist. Try running \"minikube start -p dev\".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND,
+				Message: "getting minikube env: running [minikube docker-env --shell none -p dev]\n - stdout: \"* Profile \\\"dev\\\" not found. Run \\\"minikube profile list\\\" to view all profiles.\\n\"\n - cause: exit status 85",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_START_MINIKUBE,
+					Action:         "Try running \"minikube start -p dev\"",
+				}},
+			},
+		},
+		{
+			description: "default minikube profile not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf(`Profile "minikube" not found. Run "minikube profile list" to view all profiles.`),
+			expected:    "minikube profile \"minikube\" does not exist. Try running \"minikube start\".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND,
+				Message: `Profile "minikube" not found. Run "minikube profile list" to view all profiles.`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_START_MINIKUBE,
+					Action:         "Try running \"minikube start\"",
+				}},
+			},
+		},
+		{
+			description: "kind cluster not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf(`loading images into kind nodes: ERROR: no nodes found for cluster "dev"`),
+			expected:    "kind cluster \"dev\" does not exist. Try running \"kind create cluster --name dev\".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_KIND_CLUSTER_NOT_FOUND,
+				Message: `loading images into kind nodes: ERROR: no nodes found for cluster "dev"`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CREATE_KIND_CLUSTER,
+					Action:         "Try running \"kind create cluster --name dev\"",
+				}},
+			},
+		},
+		{
+			description: "no kind clusters",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("getting kind clusters: no clusters found"),
+			expected:    "no kind cluster exists. Try running \"kind create cluster\".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_KIND_CLUSTER_NOT_FOUND,
+				Message: "getting kind clusters: no clusters found",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CREATE_KIND_CLUSTER,
+					Action:         "Try running \"kind create cluster\"",
+				}},
+			},
+		},
 		{
 			description: "create builder error",
 			context:     &config.ContextConfig{},
@@ -100,6 +161,111 @@ func TestInitProblems(t *testing.T) {
 				Suggestions: sErrors.ReportIssueSuggestion(nil),
 			},
 		},
+		{
+			description: "host not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf(`creating runner: creating builder: getting docker client: error during connect: Get "http://docker.corp.example.com:2375/v1.24/version": dial tcp: lookup docker.corp.example.com: no such host`),
+			expected:    "Could not resolve host \"docker.corp.example.com\". Check that \"docker.corp.example.com\" is spelled correctly and resolves, eg. with `nslookup docker.corp.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if docker.corp.example.com is on a private network.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_HOST_NOT_FOUND,
+				Message: `creating runner: creating builder: getting docker client: error during connect: Get "http://docker.corp.example.com:2375/v1.24/version": dial tcp: lookup docker.corp.example.com: no such host`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_HOST_RESOLUTION,
+					Action:         "Check that \"docker.corp.example.com\" is spelled correctly and resolves, eg. with `nslookup docker.corp.example.com`. If it does, check your DNS settings and /etc/hosts, or connect to the VPN if docker.corp.example.com is on a private network",
+				}},
+			},
+		},
+		{
+			description: "newer api version",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf(`parsing skaffold config: unknown skaffold config API version "skaffold/v9beta1"`),
+			expected:    "skaffold config API version \"skaffold/v9beta1\" is not supported: this version of skaffold supports skaffold/v1alpha1 to skaffold/v2beta19. Upgrade skaffold to a release supporting skaffold/v9beta1, or set the config 'apiVersion' to skaffold/v2beta19.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_CONFIG_UNKNOWN_API_VERSION_ERR,
+				Message: `parsing skaffold config: unknown skaffold config API version "skaffold/v9beta1"`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CONFIG_FIX_API_VERSION,
+					Action:         "Upgrade skaffold to a release supporting skaffold/v9beta1, or set the config 'apiVersion' to skaffold/v2beta19",
+				}},
+			},
+		},
+		{
+			description: "invalid api version",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("unknown apiVersion skaffold/v2betta1"),
+			expected:    "skaffold config API version \"skaffold/v2betta1\" is not supported: this version of skaffold supports skaffold/v1alpha1 to skaffold/v2beta19. Set the config 'apiVersion' to a supported version, then run `skaffold fix --overwrite` to upgrade the config to skaffold/v2beta19.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_CONFIG_UNKNOWN_API_VERSION_ERR,
+				Message: "unknown apiVersion skaffold/v2betta1",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CONFIG_FIX_API_VERSION,
+					Action:         "Set the config 'apiVersion' to a supported version, then run `skaffold fix --overwrite` to upgrade the config to skaffold/v2beta19",
+				}},
+			},
+		},
+		{
+			description: "compose external network not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("running [kompose convert -f docker-compose.yaml]\n - stdout: \"\"\n - stderr: \"Network backend declared as external, but could not be found. Please create the network manually\"\n - cause: exit status 1"),
+			expected:    "network \"backend\" referenced by the docker-compose file does not exist. Try running \"docker network create backend\", or fix the networks of the docker-compose file.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND,
+				Message: "running [kompose convert -f docker-compose.yaml]\n - stdout: \"\"\n - stderr: \"Network backend declared as external, but could not be found. Please create the network manually\"\n - cause: exit status 1",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CREATE_COMPOSE_NETWORK,
+					Action:         "Try running \"docker network create backend\", or fix the networks of the docker-compose file",
+				}},
+			},
+		},
+		{
+			description: "compose network not found",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf(`Error response from daemon: network "my_net" not found`),
+			expected:    "network \"my_net\" referenced by the docker-compose file does not exist. Try running \"docker network create my_net\", or fix the networks of the docker-compose file.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND,
+				Message: `Error response from daemon: network "my_net" not found`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CREATE_COMPOSE_NETWORK,
+					Action:         "Try running \"docker network create my_net\", or fix the networks of the docker-compose file",
+				}},
+			},
+		},
+		{
+			description: "yaml value of the wrong type",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("parsing skaffold config: yaml: unmarshal errors:\n  line 12: cannot unmarshal !!str `eight` into int"),
+			expected:    "invalid skaffold config: the value `eight` at line 12 is of type string where type int is expected. Check the type expected by the field at line 12, and fix its value to be of type int." + docs + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_CONFIG_FIELD_TYPE_ERR,
+				Message: "parsing skaffold config: yaml: unmarshal errors:\n  line 12: cannot unmarshal !!str `eight` into int",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CONFIG_FIX_FIELD_TYPE,
+					Action:         "Check the type expected by the field at line 12, and fix its value to be of type int." + docs,
+				}},
+			},
+		},
+		{
+			description: "json struct field of the wrong type",
+			context:     &config.ContextConfig{},
+			phase:       constants.Init,
+			err:         fmt.Errorf("json: cannot unmarshal string into Go struct field PortForwardResource.portForward.localPort of type int"),
+			expected:    "invalid skaffold config: the value of field \"localPort\" is of type string where type int is expected. Check the type expected by field \"localPort\", and fix its value to be of type int." + docs + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_CONFIG_FIELD_TYPE_ERR,
+				Message: "json: cannot unmarshal string into Go struct field PortForwardResource.portForward.localPort of type int",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CONFIG_FIX_FIELD_TYPE,
+					Action:         "Check the type expected by field \"localPort\", and fix its value to be of type int." + docs,
+				}},
+			},
+		},
 		{
 			description: "init cache error",
 			context:     &config.ContextConfig{},
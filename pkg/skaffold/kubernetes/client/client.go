@@ -31,6 +31,7 @@ import (
 // for tests
 var (
 	Client        = getClientset
+	ContextClient = getClientsetForContext
 	DynamicClient = getDynamicClient
 )
 
@@ -42,6 +43,18 @@ func getClientset() (kubernetes.Interface, error) {
 	return kubernetes.NewForConfig(config)
 }
 
+// getClientsetForContext returns a client of the given kube-context, or of the current one if it's empty.
+func getClientsetForContext(kctx string) (kubernetes.Interface, error) {
+	if kctx == "" {
+		return Client()
+	}
+	config, err := context.GetRestClientConfigForContext(kctx)
+	if err != nil {
+		return nil, fmt.Errorf("getting client config for kube-context %q: %w", kctx, err)
+	}
+	return kubernetes.NewForConfig(config)
+}
+
 func getDynamicClient() (dynamic.Interface, error) {
 	config, err := context.GetRestClientConfig()
 	if err != nil {
@@ -63,6 +63,12 @@ func GetRestClientConfig() (*restclient.Config, error) {
 	return getRestClientConfig(kubeContext, kubeConfigFile)
 }
 
+// GetRestClientConfigForContext returns a REST client config for API calls against the Kubernetes API of the given kube-context,
+// read from the same kubeconfig as GetRestClientConfig.
+func GetRestClientConfigForContext(kctx string) (*restclient.Config, error) {
+	return getRestClientConfig(kctx, kubeConfigFile)
+}
+
 // GetClusterInfo returns the Cluster information for the given kubeContext
 func GetClusterInfo(kctx string) (*clientcmdapi.Cluster, error) {
 	rawConfig, err := getCurrentConfig()
@@ -47,6 +47,14 @@ func (l *ImageList) Add(image string) {
 	l.Unlock()
 }
 
+// Has returns true if the image is in the list.
+func (l *ImageList) Has(image string) bool {
+	l.RLock()
+	defer l.RUnlock()
+
+	return l.names[image]
+}
+
 // Select returns true if one of the pod's images is in the list.
 func (l *ImageList) Select(pod *v1.Pod) bool {
 	l.RLock()
@@ -29,6 +29,12 @@ import (
 // TopLevelOwnerKey returns a key associated with the top level
 // owner of a Kubernetes resource in the form Kind-Name
 func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) string {
+	return TopLevelOwnerKeyInContext(ctx, "", obj, kind)
+}
+
+// TopLevelOwnerKeyInContext returns the key of the top level owner of a Kubernetes resource
+// of the given kube-context, or of the current one if it's empty.
+func TopLevelOwnerKeyInContext(ctx context.Context, kubeContext string, obj metav1.Object, kind string) string {
 	for {
 		or := obj.GetOwnerReferences()
 		if or == nil {
@@ -36,7 +42,7 @@ func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) strin
 		}
 		var err error
 		kind = or[0].Kind
-		obj, err = ownerMetaObject(ctx, obj.GetNamespace(), or[0])
+		obj, err = ownerMetaObject(ctx, kubeContext, obj.GetNamespace(), or[0])
 		if err != nil {
 			logrus.Warnf("unable to get owner from reference: %v", or[0])
 			return ""
@@ -44,8 +50,8 @@ func TopLevelOwnerKey(ctx context.Context, obj metav1.Object, kind string) strin
 	}
 }
 
-func ownerMetaObject(ctx context.Context, ns string, owner metav1.OwnerReference) (metav1.Object, error) {
-	client, err := kubernetesclient.Client()
+func ownerMetaObject(ctx context.Context, kubeContext string, ns string, owner metav1.OwnerReference) (metav1.Object, error) {
+	client, err := kubernetesclient.ContextClient(kubeContext)
 	if err != nil {
 		return nil, err
 	}
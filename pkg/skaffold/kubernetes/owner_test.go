@@ -281,7 +281,7 @@ func TestOwnerMetaObject(t *testing.T) {
 			client := fakekubeclientset.NewSimpleClientset(test.objects...)
 			t.Override(&kubernetesclient.Client, mockClient(client))
 
-			actual, err := ownerMetaObject(context.Background(), "ns", test.or)
+			actual, err := ownerMetaObject(context.Background(), "", "ns", test.or)
 
 			t.CheckNoError(err)
 			t.CheckDeepEqual(test.expected, actual)
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"net"
+)
+
+// networkInterface is a local network interface and its IPv4 addresses.
+type networkInterface struct {
+	name      string
+	up        bool
+	loopback  bool
+	addresses []net.IP
+}
+
+var (
+	// For testing
+	listNetworkInterfaces = localNetworkInterfaces
+)
+
+// resolveBridgeInterface returns the name and IPv4 address of the local network interface designated by bridge,
+// either by name or by one of its addresses. The interface must be up, and can't be a loopback interface.
+func resolveBridgeInterface(bridge string) (string, string, error) {
+	interfaces, err := listNetworkInterfaces()
+	if err != nil {
+		return "", "", fmt.Errorf("listing network interfaces: %w", err)
+	}
+	ip := net.ParseIP(bridge)
+	for _, iface := range interfaces {
+		if ip == nil && iface.name != bridge {
+			continue
+		}
+		for _, address := range iface.addresses {
+			if ip != nil && !ip.Equal(address) {
+				continue
+			}
+			switch {
+			case iface.loopback:
+				return "", "", fmt.Errorf("bridge interface %q is a loopback interface: use `address` instead", bridge)
+			case !iface.up:
+				return "", "", fmt.Errorf("bridge interface %s is down", iface.name)
+			}
+			return iface.name, address.String(), nil
+		}
+		if ip == nil {
+			return "", "", fmt.Errorf("bridge interface %s has no IPv4 address", iface.name)
+		}
+	}
+	if ip != nil {
+		return "", "", fmt.Errorf("no network interface has address %s", bridge)
+	}
+	return "", "", fmt.Errorf("no network interface named %q", bridge)
+}
+
+func localNetworkInterfaces() ([]networkInterface, error) {
+	interfaces, err := net.Interfaces()
+	if err != nil {
+		return nil, err
+	}
+	var result []networkInterface
+	for _, iface := range interfaces {
+		addrs, err := iface.Addrs()
+		if err != nil {
+			return nil, err
+		}
+		ni := networkInterface{
+			name:     iface.Name,
+			up:       iface.Flags&net.FlagUp != 0,
+			loopback: iface.Flags&net.FlagLoopback != 0,
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.To4() != nil {
+				ni.addresses = append(ni.addresses, ipNet.IP.To4())
+			}
+		}
+		result = append(result, ni)
+	}
+	return result, nil
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"net"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestResolveBridgeInterface(t *testing.T) {
+	interfaces := []networkInterface{
+		{name: "lo", up: true, loopback: true, addresses: []net.IP{net.ParseIP("127.0.0.1")}},
+		{name: "docker0", up: true, addresses: []net.IP{net.ParseIP("172.17.0.1")}},
+		{name: "br-1a2b3c", addresses: []net.IP{net.ParseIP("172.18.0.1")}},
+		{name: "br-4d5e6f", up: true},
+	}
+
+	tests := []struct {
+		description     string
+		bridge          string
+		shouldErr       bool
+		expectedIface   string
+		expectedAddress string
+	}{
+		{
+			description:     "by name",
+			bridge:          "docker0",
+			expectedIface:   "docker0",
+			expectedAddress: "172.17.0.1",
+		},
+		{
+			description:     "by address",
+			bridge:          "172.17.0.1",
+			expectedIface:   "docker0",
+			expectedAddress: "172.17.0.1",
+		},
+		{
+			description: "unknown name",
+			bridge:      "docker1",
+			shouldErr:   true,
+		},
+		{
+			description: "unknown address",
+			bridge:      "10.0.0.1",
+			shouldErr:   true,
+		},
+		{
+			description: "interface down",
+			bridge:      "br-1a2b3c",
+			shouldErr:   true,
+		},
+		{
+			description: "no IPv4 address",
+			bridge:      "br-4d5e6f",
+			shouldErr:   true,
+		},
+		{
+			description: "loopback",
+			bridge:      "127.0.0.1",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&listNetworkInterfaces, func() ([]networkInterface, error) { return interfaces, nil })
+
+			iface, address, err := resolveBridgeInterface(test.bridge)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expectedIface, iface)
+			t.CheckDeepEqual(test.expectedAddress, address)
+		})
+	}
+}
+
+func TestGetCurrentEntryBridgeInterface(t *testing.T) {
+	testutil.Run(t, "the local port is bound to the address of the bridge interface", func(t *testutil.T) {
+		t.Override(&listNetworkInterfaces, func() ([]networkInterface, error) {
+			return []networkInterface{{name: "docker0", up: true, addresses: []net.IP{net.ParseIP("172.17.0.1")}}}, nil
+		})
+		var requestedAddress string
+		t.Override(&retrieveAvailablePort, func(address string, req int, _ *util.PortSet) int {
+			requestedAddress = address
+			return req
+		})
+
+		rf := NewUserDefinedForwarder(NewEntryManager(newTestForwarder()), nil)
+		entry, err := rf.getCurrentEntry(latestV1.PortForwardResource{
+			Type:            constants.Service,
+			Name:            "db",
+			Namespace:       "default",
+			Port:            schemautil.FromInt(5432),
+			Address:         util.Loopback,
+			BridgeInterface: "docker0",
+		})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("172.17.0.1", requestedAddress)
+		t.CheckDeepEqual("172.17.0.1", entry.resource.Address)
+		t.CheckDeepEqual(5432, entry.localPort)
+		t.CheckDeepEqual("docker0", entry.snapshot(false).BridgeInterface)
+	})
+
+	testutil.Run(t, "invalid bridge interface", func(t *testutil.T) {
+		t.Override(&listNetworkInterfaces, func() ([]networkInterface, error) { return nil, nil })
+
+		rf := NewUserDefinedForwarder(NewEntryManager(newTestForwarder()), nil)
+		_, err := rf.getCurrentEntry(latestV1.PortForwardResource{
+			Type:            constants.Service,
+			Name:            "db",
+			Port:            schemautil.FromInt(5432),
+			BridgeInterface: "docker0",
+		})
+
+		t.CheckErrorContains(`no network interface named "docker0"`, err)
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/browser"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+)
+
+var (
+	// for testing
+	openURL    = browser.OpenURL
+	hasDisplay = displayAvailable
+
+	// httpPortNames are the names of ports that serve HTTP by convention.
+	httpPortNames = map[string]bool{"http": true, "web": true}
+	// httpPorts are the ports commonly used by web servers and frontend dev servers.
+	httpPorts = map[int]bool{80: true, 3000: true, 4200: true, 5000: true, 8000: true, 8080: true, 8888: true}
+)
+
+// browserEntry identifies a port forward for as long as it's active.
+type browserEntry struct {
+	resourceType latestV1.ResourceType
+	namespace    string
+	name         string
+	localPort    int
+}
+
+// OpenInBrowser opens `http://<address>:<local port>` in the default browser for each port forward
+// that looks like HTTP, once its tunnel is ready, until the returned function is called.
+// Each URL is opened at most once for as long as its port forward is active, even if its tunnel is re-established.
+func (b *EntryManager) OpenInBrowser() (stop func()) {
+	changes := b.Subscribe()
+	go func() {
+		opened := map[browserEntry]bool{}
+		for change := range changes {
+			entry := change.Entry
+			key := browserEntry{entry.Resource.Type, entry.Resource.Namespace, entry.Resource.Name, entry.LocalPort}
+			switch {
+			case change.Type == ForwardRemoved:
+				delete(opened, key)
+			case change.Type == ForwardReady && !opened[key] && isHTTPForward(entry):
+				opened[key] = true
+				url := browserURL(entry)
+				logrus.Debugf("opening %s/%s on %s in the browser", entry.Resource.Type, entry.Resource.Name, url)
+				if err := openURL(url); err != nil {
+					logrus.Warnf("could not open %s in the browser: %v", url, err)
+				}
+			}
+		}
+	}()
+	return func() { b.Unsubscribe(changes) }
+}
+
+// browserURL returns the URL of a port forward, on the address its local port is bound to.
+// Forwards bound to a loopback or an unspecified address are reached through localhost.
+func browserURL(entry PortForwardEntry) string {
+	host := entry.Resource.Address
+	if ip := net.ParseIP(host); host == "" || ip != nil && (ip.IsLoopback() || ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return fmt.Sprintf("http://%s", net.JoinHostPort(host, strconv.Itoa(entry.LocalPort)))
+}
+
+// isHTTPForward guesses whether a port forward serves HTTP, from the name or the number of its port.
+// Port forwards served over WebSocket or within the cluster can't be opened in a browser.
+func isHTTPForward(entry PortForwardEntry) bool {
+	if entry.WebSocketURL != "" || entry.Type != "" {
+		return false
+	}
+	if entry.PortName != "" {
+		return httpPortNames[strings.ToLower(entry.PortName)]
+	}
+	return httpPorts[int(entry.Resource.Port.IntVal)]
+}
+
+// displayAvailable returns false when a browser can't be shown, eg. over SSH or in a container without X11 or Wayland.
+func displayAvailable() bool {
+	switch runtime.GOOS {
+	case constants.Windows, "darwin":
+		return true
+	default:
+		return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestIsHTTPForward(t *testing.T) {
+	tests := []struct {
+		description string
+		entry       PortForwardEntry
+		expected    bool
+	}{
+		{
+			description: "http port name",
+			entry:       PortForwardEntry{PortName: "http", Resource: latestV1.PortForwardResource{Port: schemautil.FromInt(9000)}},
+			expected:    true,
+		},
+		{
+			description: "web port name",
+			entry:       PortForwardEntry{PortName: "Web", Resource: latestV1.PortForwardResource{Port: schemautil.FromInt(9000)}},
+			expected:    true,
+		},
+		{
+			description: "other port name on a common HTTP port",
+			entry:       PortForwardEntry{PortName: "grpc", Resource: latestV1.PortForwardResource{Port: schemautil.FromInt(8080)}},
+		},
+		{
+			description: "unnamed common HTTP port",
+			entry:       PortForwardEntry{Resource: latestV1.PortForwardResource{Port: schemautil.FromInt(3000)}},
+			expected:    true,
+		},
+		{
+			description: "unnamed other port",
+			entry:       PortForwardEntry{Resource: latestV1.PortForwardResource{Port: schemautil.FromInt(5432)}},
+		},
+		{
+			description: "forwarded over WebSocket",
+			entry:       PortForwardEntry{PortName: "http", WebSocketURL: "ws://localhost:9000"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, isHTTPForward(test.entry))
+		})
+	}
+}
+
+func TestBrowserURL(t *testing.T) {
+	tests := []struct {
+		description string
+		address     string
+		expected    string
+	}{
+		{description: "default address", expected: "http://localhost:9000"},
+		{description: "loopback", address: "127.0.0.1", expected: "http://localhost:9000"},
+		{description: "ipv6 loopback", address: "::1", expected: "http://localhost:9000"},
+		{description: "all interfaces", address: "0.0.0.0", expected: "http://localhost:9000"},
+		{description: "ipv6 all interfaces", address: "::", expected: "http://localhost:9000"},
+		{description: "other address", address: "192.168.1.10", expected: "http://192.168.1.10:9000"},
+		{description: "other ipv6 address", address: "fd00::10", expected: "http://[fd00::10]:9000"},
+		{description: "host name", address: "devbox.local", expected: "http://devbox.local:9000"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			entry := PortForwardEntry{LocalPort: 9000, Resource: latestV1.PortForwardResource{Address: test.address}}
+
+			t.CheckDeepEqual(test.expected, browserURL(entry))
+		})
+	}
+}
+
+func TestOpenInBrowser(t *testing.T) {
+	testutil.Run(t, "each HTTP port forward is opened once per lifetime", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		var opened []string
+		var lock sync.Mutex
+		t.Override(&openURL, func(url string) error {
+			lock.Lock()
+			defer lock.Unlock()
+			opened = append(opened, url)
+			return nil
+		})
+		openedURLs := func(count int) []string {
+			wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+				lock.Lock()
+				defer lock.Unlock()
+				return len(opened) >= count, nil
+			})
+			lock.Lock()
+			defer lock.Unlock()
+			return append([]string(nil), opened...)
+		}
+
+		em := NewEntryManager(newTestForwarder())
+		stop := em.OpenInBrowser()
+		defer stop()
+
+		web := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "web-0",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "web-0", "app", "http", "web", 9000, true)
+		db := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "db",
+			Namespace: "default",
+			Port:      schemautil.FromInt(5432),
+		}, "", "", "", "", 9001, false)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, web)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, db)
+		t.CheckDeepEqual([]string{"http://localhost:9000"}, openedURLs(1))
+
+		// the tunnel is re-established
+		em.updateEntryState(web, false)
+		em.updateEntryState(web, true)
+		// the port forward is started again
+		em.Terminate(web)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, web)
+		t.CheckDeepEqual([]string{"http://localhost:9000", "http://localhost:9000"}, openedURLs(2))
+	})
+}
@@ -0,0 +1,148 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// maxCaptureSize is the size past which capture files are rotated.
+const maxCaptureSize = 16 * 1024 * 1024
+
+// trafficCapture records the bytes relayed by a connectionProxy to a file.
+// Each chunk is preceded by a header line with its time, connection number,
+// direction (`->` towards the resource, `<-` back from it) and length, eg.
+//
+//	2021-06-01T10:00:00.000000000Z conn 1 -> 18 bytes
+//	GET / HTTP/1.1
+//
+// Once the file grows past maxSize, it's renamed with a `.1` suffix, replacing
+// the previous one, and a new file is started.
+type trafficCapture struct {
+	path    string
+	maxSize int64
+
+	lock  sync.Mutex
+	file  *os.File
+	size  int64
+	conns int
+}
+
+// newTrafficCapture opens the capture file at the given path, appending to it if it exists.
+func newTrafficCapture(path string, maxSize int64) (*trafficCapture, error) {
+	c := &trafficCapture{path: path, maxSize: maxSize}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return nil, fmt.Errorf("creating capture directory: %w", err)
+	}
+	if err := c.open(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *trafficCapture) open() error {
+	// captures can contain credentials, so they are kept private
+	f, err := os.OpenFile(c.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return fmt.Errorf("opening capture file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("opening capture file: %w", err)
+	}
+	c.file, c.size = f, info.Size()
+	return nil
+}
+
+// newConn returns the number identifying a new connection in the capture.
+func (c *trafficCapture) newConn() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.conns++
+	return c.conns
+}
+
+// record appends a chunk of the given connection's traffic.
+func (c *trafficCapture) record(conn int, direction string, data []byte) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file == nil {
+		return
+	}
+	if c.size > 0 && c.size+int64(len(data)) > c.maxSize {
+		if err := c.rotate(); err != nil {
+			logrus.Warnf("rotating capture file %s: %v", c.path, err)
+			return
+		}
+	}
+	header := fmt.Sprintf("%s conn %d %s %d bytes\n", time.Now().UTC().Format(time.RFC3339Nano), conn, direction, len(data))
+	n, err := c.file.WriteString(header)
+	c.size += int64(n)
+	if err == nil {
+		n, err = c.file.Write(data)
+		c.size += int64(n)
+	}
+	if err == nil {
+		n, err = c.file.WriteString("\n")
+		c.size += int64(n)
+	}
+	if err != nil {
+		logrus.Debugf("writing to capture file %s: %v", c.path, err)
+	}
+}
+
+// rotate must be called with the lock held.
+func (c *trafficCapture) rotate() error {
+	c.file.Close()
+	c.file = nil
+	if err := os.Rename(c.path, c.path+".1"); err != nil {
+		return err
+	}
+	return c.open()
+}
+
+func (c *trafficCapture) close() {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.file != nil {
+		c.file.Close()
+		c.file = nil
+	}
+}
+
+// captureWriter records the bytes written to it as traffic of a connection, in one direction.
+// It never fails, so that capture errors don't interrupt the relayed connection.
+type captureWriter struct {
+	capture   *trafficCapture
+	conn      int
+	direction string
+}
+
+func (w captureWriter) Write(p []byte) (int, error) {
+	w.capture.record(w.conn, w.direction, p)
+	return len(p), nil
+}
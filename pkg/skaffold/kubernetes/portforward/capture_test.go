@@ -0,0 +1,63 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTrafficCapture(t *testing.T) {
+	testutil.Run(t, "chunks are recorded with a header", func(t *testutil.T) {
+		path := filepath.Join(t.NewTempDir().Root(), "captures", "app.cap")
+		capture, err := newTrafficCapture(path, maxCaptureSize)
+		t.CheckNoError(err)
+
+		conn := capture.newConn()
+		captureWriter{capture: capture, conn: conn, direction: "->"}.Write([]byte("ping"))
+		captureWriter{capture: capture, conn: conn, direction: "<-"}.Write([]byte("pong"))
+		capture.close()
+		// writes after the capture is closed are dropped
+		capture.record(conn, "->", []byte("late"))
+
+		content, err := ioutil.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckMatches(`^\S+ conn 1 -> 4 bytes\nping\n\S+ conn 1 <- 4 bytes\npong\n$`, string(content))
+	})
+
+	testutil.Run(t, "large captures are rotated", func(t *testutil.T) {
+		path := filepath.Join(t.NewTempDir().Root(), "app.cap")
+		capture, err := newTrafficCapture(path, 100)
+		t.CheckNoError(err)
+		defer capture.close()
+
+		capture.record(1, "->", []byte("first"))
+		capture.record(1, "->", make([]byte, 80))
+		capture.record(2, "->", []byte("second"))
+
+		// only the previous capture is kept
+		rotated, err := ioutil.ReadFile(path + ".1")
+		t.CheckNoError(err)
+		t.CheckMatches(`^\S+ conn 1 -> 80 bytes\n`, string(rotated))
+		current, err := ioutil.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckMatches(`^\S+ conn 2 -> 6 bytes\nsecond\n$`, string(current))
+	})
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/rest"
+)
+
+// Dialer opens port-forward connections to pods through the API server of a kube-context, or of the current one when empty.
+type Dialer func(kubeContext, namespace, pod string) (httpstream.Connection, error)
+
+// ProxyDialer returns the default Dialer, which goes through the given HTTP CONNECT proxy,
+// or through the one of the proxy environment variables when empty.
+func ProxyDialer(proxy string) Dialer {
+	return func(kubeContext, namespace, pod string) (httpstream.Connection, error) {
+		return dialPortForward(clusterProxy(proxy), kubeContext, namespace, pod)
+	}
+}
+
+// clusterProxy is the HTTP CONNECT proxy the forwarders go through to reach the cluster, whether they run
+// `kubectl port-forward` or dial the API server themselves. When empty, the proxy environment variables are honored.
+type clusterProxy string
+
+// inUse returns the proxy used to reach the cluster, if any.
+func (p clusterProxy) inUse() string {
+	if p != "" {
+		return string(p)
+	}
+	for _, env := range []string{"HTTPS_PROXY", "https_proxy"} {
+		if proxy := os.Getenv(env); proxy != "" {
+			return proxy
+		}
+	}
+	return ""
+}
+
+// env returns the environment of kubectl processes, pointing them to the configured proxy.
+func (p clusterProxy) env() []string {
+	if p == "" {
+		return nil
+	}
+	return append(os.Environ(), "HTTPS_PROXY="+string(p), "https_proxy="+string(p))
+}
+
+// configure points the connections made with a client config to the configured proxy.
+func (p clusterProxy) configure(config *rest.Config) error {
+	if p == "" {
+		return nil
+	}
+	u, err := url.Parse(string(p))
+	if err != nil {
+		return fmt.Errorf("parsing proxy URL %q: %w", redactProxy(string(p)), err)
+	}
+	config.Proxy = http.ProxyURL(u)
+	return nil
+}
+
+// redactProxy hides the password of proxy URLs with credentials.
+func redactProxy(proxy string) string {
+	u, err := url.Parse(proxy)
+	if err != nil {
+		return proxy
+	}
+	return u.Redacted()
+}
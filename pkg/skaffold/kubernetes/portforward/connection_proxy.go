@@ -0,0 +1,263 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// connectionProxy listens on the local port of a port forward entry and relays
+// every accepted connection to the loopback port kubectl is forwarding from.
+// Owning the listener lets Skaffold track active connections, so that they
+// can be drained instead of being cut when port forwarding stops.
+type connectionProxy struct {
+	listener net.Listener
+	options  proxyOptions
+
+	lock       sync.Mutex
+	targetPort int
+	// closed is set once the proxy stops accepting connections,
+	// killed once the remaining connections are forcibly closed.
+	closed bool
+	killed bool
+	// conns holds both ends of every relayed connection.
+	conns map[net.Conn]struct{}
+	// clients is the number of accepted connections being relayed.
+	clients int
+	active  sync.WaitGroup
+}
+
+// proxyOptions configures how a connectionProxy handles relayed connections.
+type proxyOptions struct {
+	// keepAlive is the TCP keepalive period of both ends of relayed connections.
+	// Zero leaves the system defaults untouched.
+	keepAlive time.Duration
+	// maxConnections is the number of connections relayed at the same time
+	// past which new connections are rejected. Zero means no limit.
+	maxConnections int
+	// tls, if set, is the configuration of TLS connections to the target port:
+	// accepted connections are plaintext and relayed over TLS.
+	tls *tls.Config
+	// capture, if set, records the traffic of relayed connections.
+	capture *trafficCapture
+	// listener configures the socket accepting connections.
+	listener ListenerOptions
+}
+
+// newConnectionProxy starts accepting connections on the given address and port.
+func newConnectionProxy(address string, port int, options proxyOptions) (*connectionProxy, error) {
+	l, err := listen(address, port, options.listener)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &connectionProxy{
+		listener: l,
+		options:  options,
+		conns:    map[net.Conn]struct{}{},
+	}
+	go p.serve()
+	return p, nil
+}
+
+// setTarget changes the loopback port new connections are relayed to.
+func (p *connectionProxy) setTarget(port int) {
+	p.lock.Lock()
+	p.targetPort = port
+	p.lock.Unlock()
+}
+
+func (p *connectionProxy) serve() {
+	for {
+		conn, err := p.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		p.lock.Lock()
+		if p.closed {
+			p.lock.Unlock()
+			conn.Close()
+			return
+		}
+		if max := p.options.maxConnections; max > 0 && p.clients >= max {
+			p.lock.Unlock()
+			logrus.Warnf("rejecting connection from %s to %s: limit of %d concurrent connections reached", conn.RemoteAddr(), p.listener.Addr(), max)
+			conn.Close()
+			continue
+		}
+		p.conns[conn] = struct{}{}
+		p.clients++
+		p.active.Add(1)
+		target := p.targetPort
+		p.lock.Unlock()
+
+		go p.relay(conn, target)
+	}
+}
+
+func (p *connectionProxy) relay(conn net.Conn, target int) {
+	defer p.active.Done()
+	defer func() {
+		p.forget(conn)
+		p.lock.Lock()
+		p.clients--
+		p.lock.Unlock()
+	}()
+
+	if target == 0 {
+		logrus.Debugf("dropping connection from %s: port forward is not ready", conn.RemoteAddr())
+		return
+	}
+	p.setKeepAlive(conn)
+
+	dialer := net.Dialer{KeepAlive: p.options.keepAlive}
+	upstream, err := dialer.Dial("tcp", net.JoinHostPort(util.Loopback, strconv.Itoa(target)))
+	if err != nil {
+		logrus.Debugf("relaying connection from %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	if !p.track(upstream) {
+		return
+	}
+	defer p.forget(upstream)
+
+	if p.options.tls != nil {
+		tlsConn := tls.Client(upstream, p.options.tls)
+		if err := tlsConn.Handshake(); err != nil {
+			logrus.Debugf("relaying connection from %s over TLS: %v", conn.RemoteAddr(), err)
+			return
+		}
+		upstream = tlsConn
+	}
+
+	var toUpstream, fromUpstream io.Reader = conn, upstream
+	if capture := p.options.capture; capture != nil {
+		id := capture.newConn()
+		toUpstream = io.TeeReader(conn, captureWriter{capture: capture, conn: id, direction: "->"})
+		fromUpstream = io.TeeReader(upstream, captureWriter{capture: capture, conn: id, direction: "<-"})
+	}
+
+	done := make(chan struct{}, 2)
+	pipe := func(dst net.Conn, src io.Reader) {
+		if _, err := io.Copy(dst, src); err != nil {
+			// One side failed: tear down both directions.
+			conn.Close()
+			upstream.Close()
+		} else if cw, ok := dst.(interface{ CloseWrite() error }); ok {
+			cw.CloseWrite()
+		}
+		done <- struct{}{}
+	}
+	go pipe(upstream, toUpstream)
+	go pipe(conn, fromUpstream)
+	<-done
+	<-done
+}
+
+// setKeepAlive enables TCP keepalive probes on an accepted connection, so that
+// idle connections are not dropped by intermediate timeouts.
+func (p *connectionProxy) setKeepAlive(conn net.Conn) {
+	tcp, ok := conn.(*net.TCPConn)
+	if !ok || p.options.keepAlive <= 0 {
+		return
+	}
+	if err := tcp.SetKeepAlive(true); err != nil {
+		logrus.Debugf("enabling keepalive on connection from %s: %v", conn.RemoteAddr(), err)
+		return
+	}
+	if err := tcp.SetKeepAlivePeriod(p.options.keepAlive); err != nil {
+		logrus.Debugf("setting keepalive period on connection from %s: %v", conn.RemoteAddr(), err)
+	}
+}
+
+// track registers a connection, or closes it if the proxy was killed in the meantime.
+func (p *connectionProxy) track(conn net.Conn) bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.killed {
+		conn.Close()
+		return false
+	}
+	p.conns[conn] = struct{}{}
+	return true
+}
+
+func (p *connectionProxy) forget(conn net.Conn) {
+	conn.Close()
+
+	p.lock.Lock()
+	delete(p.conns, conn)
+	p.lock.Unlock()
+}
+
+// shutdown stops accepting new connections, then gives active ones up to
+// `grace` to finish before closing them.
+func (p *connectionProxy) shutdown(grace time.Duration) {
+	if p.options.capture != nil {
+		defer p.options.capture.close()
+	}
+
+	p.lock.Lock()
+	p.closed = true
+	p.lock.Unlock()
+	p.listener.Close()
+
+	drained := make(chan struct{})
+	go func() {
+		p.active.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		return
+	case <-time.After(grace):
+	}
+
+	p.lock.Lock()
+	p.killed = true
+	if n := len(p.conns); n > 0 {
+		logrus.Debugf("closing %d connections on %s that did not finish in %v", n, p.listener.Addr(), grace)
+	}
+	for conn := range p.conns {
+		conn.Close()
+	}
+	p.lock.Unlock()
+	<-drained
+}
+
+// freeLoopbackPort returns a port on the loopback interface that is currently free.
+func freeLoopbackPort() (int, error) {
+	l, err := net.Listen("tcp", net.JoinHostPort(util.Loopback, "0"))
+	if err != nil {
+		return 0, fmt.Errorf("finding a free port: %w", err)
+	}
+	defer l.Close()
+	return l.Addr().(*net.TCPAddr).Port, nil
+}
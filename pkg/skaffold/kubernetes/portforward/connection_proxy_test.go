@@ -0,0 +1,237 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bufio"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// startEchoServer stands in for the port bound by kubectl.
+func startEchoServer(t *testutil.T) int {
+	l, err := net.Listen("tcp", net.JoinHostPort(util.Loopback, "0"))
+	t.CheckNoError(err)
+	t.Cleanup(func() { l.Close() })
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	return l.Addr().(*net.TCPAddr).Port
+}
+
+func startProxy(t *testutil.T, target int) (*connectionProxy, string) {
+	return startProxyWithOptions(t, target, proxyOptions{})
+}
+
+func startProxyWithOptions(t *testutil.T, target int, options proxyOptions) (*connectionProxy, string) {
+	port, err := freeLoopbackPort()
+	t.CheckNoError(err)
+	proxy, err := newConnectionProxy(util.Loopback, port, options)
+	t.CheckNoError(err)
+	proxy.setTarget(target)
+	return proxy, net.JoinHostPort(util.Loopback, strconv.Itoa(port))
+}
+
+func echo(t *testutil.T, conn net.Conn, msg string) string {
+	_, err := conn.Write([]byte(msg + "\n"))
+	t.CheckNoError(err)
+	line, err := bufio.NewReader(conn).ReadString('\n')
+	t.CheckNoError(err)
+	return line
+}
+
+func TestConnectionProxyRelays(t *testing.T) {
+	testutil.Run(t, "relay to target port", func(t *testutil.T) {
+		proxy, address := startProxy(t, startEchoServer(t))
+		defer proxy.shutdown(0)
+
+		conn, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		defer conn.Close()
+
+		t.CheckDeepEqual("hello\n", echo(t, conn, "hello"))
+	})
+}
+
+func TestConnectionProxyKeepAlive(t *testing.T) {
+	testutil.Run(t, "relay with keepalive", func(t *testutil.T) {
+		proxy, address := startProxyWithOptions(t, startEchoServer(t), proxyOptions{keepAlive: time.Second})
+		defer proxy.shutdown(0)
+
+		conn, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		defer conn.Close()
+
+		t.CheckDeepEqual("hello\n", echo(t, conn, "hello"))
+	})
+}
+
+func TestConnectionProxyConnectionLimit(t *testing.T) {
+	testutil.Run(t, "connections past the limit are rejected", func(t *testutil.T) {
+		proxy, address := startProxyWithOptions(t, startEchoServer(t), proxyOptions{maxConnections: 1})
+		defer proxy.shutdown(0)
+
+		first, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("first\n", echo(t, first, "first"))
+
+		second, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		defer second.Close()
+		second.SetReadDeadline(time.Now().Add(10 * time.Second))
+		_, err = second.Read(make([]byte, 1))
+		t.CheckError(true, err)
+
+		// closing the first connection makes room for a new one
+		first.Close()
+		var third net.Conn
+		for i := 0; i < 100; i++ {
+			if third, err = net.Dial("tcp", address); err != nil {
+				break
+			}
+			third.SetDeadline(time.Now().Add(time.Second))
+			if _, err = third.Write([]byte("third\n")); err == nil {
+				if line, rerr := bufio.NewReader(third).ReadString('\n'); rerr == nil {
+					t.CheckDeepEqual("third\n", line)
+					third.Close()
+					return
+				}
+			}
+			third.Close()
+			time.Sleep(10 * time.Millisecond)
+		}
+		t.Fatal("no connection was accepted after the first one was closed")
+	})
+}
+
+func TestConnectionProxyTLSTermination(t *testing.T) {
+	testutil.Run(t, "plaintext connections are relayed over TLS", func(t *testutil.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			fmt.Fprintf(w, "tls=%t", r.TLS != nil)
+		}))
+		defer server.Close()
+		target := server.Listener.Addr().(*net.TCPAddr).Port
+
+		proxy, address := startProxyWithOptions(t, target, proxyOptions{tls: &tls.Config{InsecureSkipVerify: true}})
+		defer proxy.shutdown(0)
+
+		resp, err := http.Get("http://" + address)
+		t.CheckNoError(err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("tls=true", string(body))
+	})
+}
+
+func TestConnectionProxyCapture(t *testing.T) {
+	testutil.Run(t, "relayed traffic is recorded", func(t *testutil.T) {
+		path := filepath.Join(t.NewTempDir().Root(), "app.cap")
+		capture, err := newTrafficCapture(path, maxCaptureSize)
+		t.CheckNoError(err)
+		proxy, address := startProxyWithOptions(t, startEchoServer(t), proxyOptions{capture: capture})
+
+		conn, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("hello\n", echo(t, conn, "hello"))
+		conn.Close()
+		proxy.shutdown(time.Second)
+
+		content, err := ioutil.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckMatches(`^\S+ conn 1 -> 6 bytes\nhello\n\n\S+ conn 1 <- 6 bytes\nhello\n\n$`, string(content))
+	})
+}
+
+func TestConnectionProxyDrain(t *testing.T) {
+	testutil.Run(t, "active connections finish within the grace period", func(t *testutil.T) {
+		proxy, address := startProxy(t, startEchoServer(t))
+
+		conn, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("before\n", echo(t, conn, "before"))
+
+		stopped := make(chan struct{})
+		go func() {
+			proxy.shutdown(time.Minute)
+			close(stopped)
+		}()
+
+		// new connections are refused while the active one keeps working
+		t.CheckTrue(waitForRefused(address))
+		t.CheckDeepEqual("during\n", echo(t, conn, "during"))
+
+		conn.Close()
+		select {
+		case <-stopped:
+		case <-time.After(10 * time.Second):
+			t.Fatal("shutdown did not return after the connection finished")
+		}
+	})
+
+	testutil.Run(t, "connections are closed after the grace period", func(t *testutil.T) {
+		proxy, address := startProxy(t, startEchoServer(t))
+
+		conn, err := net.Dial("tcp", address)
+		t.CheckNoError(err)
+		defer conn.Close()
+		t.CheckDeepEqual("before\n", echo(t, conn, "before"))
+
+		proxy.shutdown(10 * time.Millisecond)
+
+		conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+		_, err = conn.Read(make([]byte, 1))
+		t.CheckError(true, err)
+		if netErr, ok := err.(net.Error); ok {
+			t.CheckFalse(netErr.Timeout())
+		}
+	})
+}
+
+func waitForRefused(address string) bool {
+	for i := 0; i < 100; i++ {
+		conn, err := net.Dial("tcp", address)
+		if err != nil {
+			return true
+		}
+		conn.Close()
+		time.Sleep(10 * time.Millisecond)
+	}
+	return false
+}
@@ -21,11 +21,17 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"time"
 
+	"github.com/sirupsen/logrus"
+
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	eventV2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/event/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 var (
@@ -51,10 +57,19 @@ var (
 			entry.portName,
 			string(entry.resource.Type),
 			entry.resource.Name,
-			entry.resource.Address)
+			entry.resource.Address,
+			entry.webSocketURL,
+			entry.iteration)
 	}
+	portForwardReadinessEventV2 = eventV2.PortForwardReadinessChanged
+	currentIteration            = eventV2.GetIteration
+	timeNow                     = time.Now
 )
 
+// LocalPortHook computes the local port a resource should be forwarded to,
+// before the usual allocation takes place. Returning 0 leaves the choice to Skaffold.
+type LocalPortHook func(resource latestV1.PortForwardResource) (localPort int)
+
 type forwardedResources struct {
 	resources map[string]*portForwardEntry
 	lock      sync.Mutex
@@ -85,6 +100,17 @@ func (f *forwardedResources) Delete(key string) {
 	f.lock.Unlock()
 }
 
+func (f *forwardedResources) Values() []*portForwardEntry {
+	f.lock.Lock()
+	values := make([]*portForwardEntry, 0, len(f.resources))
+	for _, v := range f.resources {
+		values = append(values, v)
+	}
+	f.lock.Unlock()
+
+	return values
+}
+
 func (f *forwardedResources) Length() int {
 	f.lock.Lock()
 	length := len(f.resources)
@@ -93,6 +119,47 @@ func (f *forwardedResources) Length() int {
 	return length
 }
 
+// disabledEntries is a synchronized set of the keys of the entries disabled at runtime.
+type disabledEntries struct {
+	keys map[string]bool
+	lock sync.Mutex
+}
+
+// set marks the key as disabled or enabled, and returns false if it already was.
+func (d *disabledEntries) set(key string, disabled bool) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if d.keys[key] == disabled {
+		return false
+	}
+	if d.keys == nil {
+		d.keys = map[string]bool{}
+	}
+	if disabled {
+		d.keys[key] = true
+	} else {
+		delete(d.keys, key)
+	}
+	return true
+}
+
+func (d *disabledEntries) has(key string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.keys[key]
+}
+
+// entryReadiness tracks whether each active entry has an established tunnel, and since when.
+type entryReadiness struct {
+	entries map[*portForwardEntry]bool
+	since   map[*portForwardEntry]time.Time
+	// probes stops probing the entries that aren't healthy yet
+	probes map[*portForwardEntry]chan struct{}
+	lock   sync.Mutex
+}
+
 // EntryManager handles forwarding entries and keeping track of
 // forwarded ports and resources.
 type EntryManager struct {
@@ -103,14 +170,163 @@ type EntryManager struct {
 
 	// forwardedResources is a map of portForwardEntry key (string) -> portForwardEntry
 	forwardedResources forwardedResources
+
+	// readiness is used to compute the aggregate "all forwards ready" status
+	readiness entryReadiness
+
+	// localPortHook, if set, is consulted before allocating a local port
+	localPortHook LocalPortHook
+
+	// disabled holds the entries that shouldn't be forwarded until they are enabled again
+	disabled disabledEntries
+
+	// subscriptions are the channels port forward changes are streamed to
+	subscriptions subscriptions
+
+	// hostsAliases, if set, registers aliases of the forwarded named ports of pods in the hosts file
+	hostsAliases *hostsAliases
+
+	// portReservations, if set, remembers the local port of each entry across sessions
+	portReservations *portReservations
+
+	// seededPorts, if set, allocates the local ports that aren't available as requested
+	seededPorts *seededPorts
+
+	// healthEndpoints serves the state of each entry on a local port when set
+	healthEndpoints bool
+
+	// formatter, if set, renders the messages written to the output instead of the colored text
+	formatter     OutputFormatter
+	formatterLock sync.Mutex
 }
 
 // NewEntryManager returns a new port forward entry manager to keep track
 // of forwarded ports and resources
 func NewEntryManager(entryForwarder EntryForwarder) *EntryManager {
-	return &EntryManager{
+	em := &EntryManager{
 		entryForwarder: entryForwarder,
 	}
+	if r, ok := entryForwarder.(entryStateReporter); ok {
+		r.reportEntryState(em.entryStateChanged)
+	}
+	if r, ok := entryForwarder.(messageReporter); ok {
+		r.reportMessages(em.writeEntryMessage)
+	}
+	em.SetListenerOptions(defaultListenerOptions)
+	return em
+}
+
+// SetListenerOptions configures the sockets listening on the local ports of entries forwarded from now on,
+// for entry forwarders that listen on them, rather than having kubectl do it.
+// By default, SO_REUSEADDR is set and the system's backlog is used.
+func (b *EntryManager) SetListenerOptions(options ListenerOptions) {
+	if c, ok := b.entryForwarder.(listenerConfigurer); ok {
+		c.setListenerOptions(options)
+	}
+}
+
+// SetLocalPortHook installs a hook that picks the local port of new forwards.
+// If the hook returns 0 or a port that is already taken, the local port is allocated as usual.
+func (b *EntryManager) SetLocalPortHook(hook LocalPortHook) {
+	b.localPortHook = hook
+}
+
+// EnableHostsAliases registers a `<port name>.<pod name>.local` alias of the address of each pod port forwarded
+// from now on in the given hosts file, eg. HostsFile(), and unregisters it once the forward is terminated.
+// Editing the system's hosts file usually requires administrator privileges: an error is returned if it can't be edited.
+func (b *EntryManager) EnableHostsAliases(path string) error {
+	h, err := newHostsAliases(path)
+	if err != nil {
+		return err
+	}
+	b.hostsAliases = h
+	return nil
+}
+
+// allocateLocalPort reserves a local port for the entry, preferring the one chosen by the local port hook,
+// then the one the entry was forwarded to in a previous session if port reservations are enabled and
+// the entry has no explicit local port, then requestPort, then any available port.
+func (b *EntryManager) allocateLocalPort(entry *portForwardEntry, requestPort int) (int, error) {
+	if b.portReservations == nil {
+		return b.pickLocalPort(entry.resource, requestPort)
+	}
+	if entry.resource.LocalPort == 0 {
+		if port, found := b.portReservations.get(entry.key()); found {
+			requestPort = port
+		}
+	}
+	port, err := b.pickLocalPort(entry.resource, requestPort)
+	if err == nil {
+		b.portReservations.reserve(entry.key(), port)
+	}
+	return port, err
+}
+
+func (b *EntryManager) pickLocalPort(resource latestV1.PortForwardResource, requestPort int) (int, error) {
+	if b.localPortHook != nil {
+		if port := b.localPortHook(resource); port > 0 {
+			if !b.forwardedPorts.LoadOrSet(port) {
+				if isPortFree(resource.Address, port) {
+					return port, nil
+				}
+				b.forwardedPorts.Delete(port)
+			}
+			logrus.Debugf("local port %d chosen for %s/%s is not available", port, resource.Type, resource.Name)
+		}
+	}
+	if port := b.availablePort(resource.Address, requestPort); port > 0 {
+		return port, nil
+	}
+	return 0, noAvailablePortErr(resource)
+}
+
+// availablePort returns the requested port if it's available, or another available port, or -1 if none is.
+func (b *EntryManager) availablePort(address string, requestPort int) int {
+	if b.seededPorts != nil {
+		return b.seededPorts.availablePort(address, requestPort, &b.forwardedPorts)
+	}
+	return retrieveAvailablePort(address, requestPort, &b.forwardedPorts)
+}
+
+// preemptLocalPort terminates the automatic pod forward with the lowest priority below the given one,
+// if any, and reserves its local port for a pod of higher priority.
+func (b *EntryManager) preemptLocalPort(priority int) (int, bool) {
+	var lowest *portForwardEntry
+	for _, entry := range b.forwardedResources.Values() {
+		if !entry.automaticPodForwarding || entry.priority <= priority {
+			continue
+		}
+		if lowest == nil || entry.priority > lowest.priority {
+			lowest = entry
+		}
+	}
+	if lowest == nil {
+		return 0, false
+	}
+
+	logrus.Warnf("no local port available: stopping port forward of %s/%s on local port %d in favor of a higher priority pod", lowest.resource.Type, lowest.resource.Name, lowest.localPort)
+	b.Terminate(lowest)
+	if b.forwardedPorts.LoadOrSet(lowest.localPort) {
+		return 0, false
+	}
+	return lowest.localPort, true
+}
+
+// noAvailablePortErr is returned when all the local ports are taken. The resource isn't forwarded,
+// but the forwards already established are left untouched.
+func noAvailablePortErr(resource latestV1.PortForwardResource) error {
+	address := resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	return sErrors.NewError(fmt.Errorf("no local port available on %s", address), proto.ActionableErr{
+		ErrCode: proto.StatusCode_DEVINIT_NO_AVAILABLE_PORT,
+		Message: fmt.Sprintf("no local port available on %s to forward %s/%s in namespace %s", address, resource.Type, resource.Name, resource.Namespace),
+		Suggestions: []*proto.Suggestion{{
+			SuggestionCode: proto.SuggestionCode_FREE_LOCAL_PORTS,
+			Action:         "Free some local ports, eg. by stopping other port forwards, or forward fewer resources, eg. with `--port-forward=user` or `--port-forward-dev-images-only`",
+		}},
+	})
 }
 
 func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Writer, entry *portForwardEntry) {
@@ -119,20 +335,34 @@ func (b *EntryManager) forwardPortForwardEntry(ctx context.Context, out io.Write
 		return
 	}
 	b.forwardedResources.Store(entry.key(), entry)
+	entry.iteration = currentIteration()
+	if b.disabled.has(entry.key()) {
+		// keep track of the entry, to forward it once it's enabled again
+		return
+	}
+	b.addEntryState(entry)
+	b.startHealthEndpoint(entry)
 
-	if err := b.entryForwarder.Forward(ctx, entry); err == nil {
-		output.Green.Fprintln(
-			out,
-			fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> %s:%d",
+	err := b.entryForwarder.Forward(ctx, entry)
+	if err == nil {
+		b.writeMessage(out, ForwardMessage{
+			Type:  MessageEstablished,
+			Entry: entry.snapshot(false),
+			Text: fmt.Sprintf("Port forwarding %s/%s in namespace %s, remote port %s -> %s:%d",
 				entry.resource.Type,
 				entry.resource.Name,
 				entry.resource.Namespace,
 				entry.resource.Port.String(),
 				entry.resource.Address,
-				entry.localPort))
+				entry.localPort),
+		})
 	} else {
-		output.Red.Fprintln(out, err)
+		b.writeMessage(out, ForwardMessage{Type: MessageError, Entry: entry.snapshot(false), Err: err, Text: err.Error()})
 	}
+	if err == nil {
+		b.addHostAlias(out, entry)
+	}
+	b.entryStateChanged(entry, err == nil)
 	portForwardEvent(entry)
 	portForwardEventV2(entry)
 }
@@ -142,8 +372,12 @@ func (b *EntryManager) Start(out io.Writer) {
 	b.entryForwarder.Start(out)
 }
 
-// Stop terminates all kubectl port-forward commands.
+// Stop terminates all kubectl port-forward commands, first letting active
+// connections drain if the entry forwarder supports it.
 func (b *EntryManager) Stop() {
+	if d, ok := b.entryForwarder.(entryDrainer); ok {
+		d.drain(b.forwardedResources.Values())
+	}
 	for _, pfe := range b.forwardedResources.resources {
 		b.Terminate(pfe)
 	}
@@ -154,4 +388,186 @@ func (b *EntryManager) Terminate(p *portForwardEntry) {
 	b.forwardedResources.Delete(p.key())
 	b.forwardedPorts.Delete(p.localPort)
 	b.entryForwarder.Terminate(p)
+	b.removeHostAlias(p)
+	b.removeEntryState(p)
+	b.stopHealthEndpoint(p)
+}
+
+// Disable terminates the port forward on the given local port. The entry is kept,
+// and isn't forwarded again, even for a new generation of its pod, until it's enabled.
+func (b *EntryManager) Disable(localPort int) error {
+	entry, found := b.entryOnLocalPort(localPort)
+	if !found {
+		return fmt.Errorf("no port forward on local port %d", localPort)
+	}
+	if !b.disabled.set(entry.key(), true) {
+		return fmt.Errorf("port forward on local port %d is already disabled", localPort)
+	}
+
+	b.entryForwarder.Terminate(entry)
+	b.removeHostAlias(entry)
+	b.removeEntryState(entry)
+	b.stopHealthEndpoint(entry)
+	return nil
+}
+
+// Enable forwards again the entry disabled on the given local port.
+func (b *EntryManager) Enable(ctx context.Context, out io.Writer, localPort int) error {
+	entry, found := b.entryOnLocalPort(localPort)
+	if !found {
+		return fmt.Errorf("no port forward on local port %d", localPort)
+	}
+	if !b.disabled.set(entry.key(), false) {
+		return fmt.Errorf("port forward on local port %d is already enabled", localPort)
+	}
+
+	// the disabled entry was terminated so a fresh copy is forwarded in its place
+	b.forwardedResources.Delete(entry.key())
+	b.forwardPortForwardEntry(ctx, out, entry.renewed())
+	return nil
+}
+
+func (b *EntryManager) entryOnLocalPort(localPort int) (*portForwardEntry, bool) {
+	for _, entry := range b.forwardedResources.Values() {
+		if entry.localPort == localPort {
+			return entry, true
+		}
+	}
+	return nil, false
+}
+
+// ActiveEntries returns a snapshot of the port forwards currently managed,
+// ordered by namespace, then pod, then container port.
+// The returned entries are copies and can be read while forwarding goes on.
+func (b *EntryManager) ActiveEntries() []PortForwardEntry {
+	entries := b.forwardedResources.Values()
+
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	active := make([]PortForwardEntry, 0, len(entries))
+	for _, pfe := range entries {
+		entry := pfe.snapshot(b.readiness.entries[pfe])
+		entry.ReadySince = b.readiness.since[pfe]
+		entry.Disabled = b.disabled.has(pfe.key())
+		active = append(active, entry)
+	}
+	sortEntries(active)
+	return active
+}
+
+// Summary writes one line per active port forward, as a quick reference of the forwarded ports.
+func (b *EntryManager) Summary(out io.Writer) {
+	entries := b.ActiveEntries()
+	if len(entries) == 0 {
+		return
+	}
+
+	output.Default.Fprintln(out, "Port forwards:")
+	for _, e := range entries {
+		output.Default.Fprintf(out, " - %s\n", e.summary())
+	}
+}
+
+// addHostAlias registers the alias of a forwarded entry, if hosts aliases are enabled.
+func (b *EntryManager) addHostAlias(out io.Writer, entry *portForwardEntry) {
+	alias := hostAlias(entry)
+	if b.hostsAliases == nil || alias == "" {
+		return
+	}
+	address := entry.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	if err := b.hostsAliases.add(alias, address); err != nil {
+		logrus.Warnf("registering alias %s of port forward %s: %v", alias, entry, err)
+		return
+	}
+	entry.hostAlias = alias
+	b.writeMessage(out, ForwardMessage{
+		Type:  MessageHostAlias,
+		Entry: entry.snapshot(false),
+		Text:  fmt.Sprintf("Port forward of %s/%s port %s is reachable at %s:%d", entry.podName, entry.containerName, entry.portName, alias, entry.localPort),
+	})
+}
+
+// removeHostAlias unregisters the alias of an entry, if any.
+func (b *EntryManager) removeHostAlias(entry *portForwardEntry) {
+	if b.hostsAliases == nil || entry.hostAlias == "" {
+		return
+	}
+	if err := b.hostsAliases.remove(entry.hostAlias); err != nil {
+		logrus.Warnf("unregistering alias %s of port forward %s: %v", entry.hostAlias, entry, err)
+	}
+}
+
+// addEntryState starts tracking a newly forwarded entry as not ready.
+func (b *EntryManager) addEntryState(p *portForwardEntry) {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	if b.readiness.entries == nil {
+		b.readiness.entries = map[*portForwardEntry]bool{}
+	}
+	b.readiness.entries[p] = false
+	b.emitReadiness()
+	b.publish(ForwardAdded, p)
+}
+
+// updateEntryState records whether an entry's tunnel is established.
+// Updates for entries that are no longer tracked are ignored.
+func (b *EntryManager) updateEntryState(p *portForwardEntry, ready bool) {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	b.setEntryState(p, ready)
+}
+
+// setEntryState records the state of an entry. It must be called with the readiness lock held.
+func (b *EntryManager) setEntryState(p *portForwardEntry, ready bool) {
+	if prev, found := b.readiness.entries[p]; !found || prev == ready {
+		return
+	}
+	b.readiness.entries[p] = ready
+	if ready {
+		if b.readiness.since == nil {
+			b.readiness.since = map[*portForwardEntry]time.Time{}
+		}
+		b.readiness.since[p] = timeNow()
+	} else {
+		delete(b.readiness.since, p)
+	}
+	b.emitReadiness()
+	if ready {
+		b.publish(ForwardReady, p)
+	} else {
+		b.publish(ForwardNotReady, p)
+	}
+}
+
+func (b *EntryManager) removeEntryState(p *portForwardEntry) {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	if _, found := b.readiness.entries[p]; !found {
+		return
+	}
+	b.stopProbe(p)
+	delete(b.readiness.entries, p)
+	delete(b.readiness.since, p)
+	b.emitReadiness()
+	b.publish(ForwardRemoved, p)
+}
+
+// emitReadiness sends the aggregate readiness of all tracked entries.
+// It must be called with the readiness lock held so that events are sent in order.
+func (b *EntryManager) emitReadiness() {
+	readyCount := 0
+	for _, ready := range b.readiness.entries {
+		if ready {
+			readyCount++
+		}
+	}
+	total := len(b.readiness.entries)
+	portForwardReadinessEventV2(total > 0 && readyCount == total, readyCount, total)
 }
@@ -17,12 +17,22 @@ limitations under the License.
 package portforward
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
+	"math/rand"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
 )
@@ -56,6 +66,124 @@ func TestStop(t *testing.T) {
 	testutil.CheckDeepEqual(t, 0, fakeForwarder.forwardedPorts.Length())
 }
 
+type readinessEvent struct {
+	ready             bool
+	readyCount, total int
+}
+
+type failingForwarder struct {
+	*testForwarder
+	fail string
+}
+
+func (f *failingForwarder) Forward(ctx context.Context, pfe *portForwardEntry) error {
+	if pfe.resource.Name == f.fail {
+		return errors.New("port forwarding failed")
+	}
+	return f.testForwarder.Forward(ctx, pfe)
+}
+
+func TestForwardReadiness(t *testing.T) {
+	testutil.Run(t, "aggregate readiness follows entry states", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		var events []readinessEvent
+		t.Override(&portForwardReadinessEventV2, func(ready bool, readyCount, total int) {
+			events = append(events, readinessEvent{ready, readyCount, total})
+		})
+
+		pfe1 := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "resource",
+			Namespace: "default",
+		}, "", "", "", "", 9000, false)
+		pfe2 := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "resource2",
+			Namespace: "default",
+		}, "", "", "", "", 9001, false)
+
+		em := NewEntryManager(&failingForwarder{testForwarder: newTestForwarder(), fail: "resource2"})
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe1)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe2)
+		// the forwarder recovers the second entry in the background
+		em.updateEntryState(pfe2, true)
+		// a repeated report doesn't change anything
+		em.updateEntryState(pfe2, true)
+		em.updateEntryState(pfe1, false)
+		em.Terminate(pfe1)
+		em.Terminate(pfe2)
+		// late reports for terminated entries are ignored
+		em.updateEntryState(pfe2, false)
+
+		t.CheckDeepEqual([]readinessEvent{
+			{false, 0, 1},
+			{true, 1, 1},
+			{false, 1, 2},
+			{true, 2, 2},
+			{false, 1, 2},
+			{true, 1, 1},
+			{false, 0, 0},
+		}, events, cmp.AllowUnexported(readinessEvent{}))
+	})
+}
+
+type drainingForwarder struct {
+	*testForwarder
+	drained []*portForwardEntry
+}
+
+func (f *drainingForwarder) drain(entries []*portForwardEntry) {
+	// entries are drained before any of them is terminated
+	if f.forwardedResources.Length() == len(entries) {
+		f.drained = entries
+	}
+}
+
+func TestStopDrainsEntries(t *testing.T) {
+	testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+	pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      "resource",
+		Namespace: "default",
+	}, "", "", "", "", 9000, false)
+
+	fakeForwarder := &drainingForwarder{testForwarder: newTestForwarder()}
+	em := NewEntryManager(fakeForwarder)
+	em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+	em.Stop()
+
+	testutil.CheckDeepEqual(t, 1, len(fakeForwarder.drained))
+	testutil.CheckDeepEqual(t, 0, fakeForwarder.forwardedResources.Length())
+}
+
+func TestForwardIteration(t *testing.T) {
+	testutil.Run(t, "entries record the dev loop iteration they are forwarded in", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		var iterations []int
+		t.Override(&portForwardEventV2, func(entry *portForwardEntry) {
+			iterations = append(iterations, entry.iteration)
+		})
+		iteration := 0
+		t.Override(&currentIteration, func() int { return iteration })
+
+		pfe1 := newPortForwardEntry(0, latestV1.PortForwardResource{Type: constants.Pod, Name: "resource", Namespace: "default"}, "", "", "", "", 9000, false)
+		pfe2 := newPortForwardEntry(0, latestV1.PortForwardResource{Type: constants.Pod, Name: "resource2", Namespace: "default"}, "", "", "", "", 9001, false)
+
+		em := NewEntryManager(newTestForwarder())
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe1)
+		iteration = 2
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe2)
+		// already forwarded entries keep their iteration
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe1)
+
+		t.CheckDeepEqual([]int{0, 2}, iterations)
+		active := em.ActiveEntries()
+		t.CheckDeepEqual(0, active[0].Iteration)
+		t.CheckDeepEqual(2, active[1].Iteration)
+	})
+}
+
 func TestForwardedResources(t *testing.T) {
 	pf := &forwardedResources{}
 
@@ -72,3 +200,322 @@ func TestForwardedResources(t *testing.T) {
 		t.Fatal("loaded resource that doesn't exist")
 	}
 }
+
+func TestActiveEntries(t *testing.T) {
+	testutil.Run(t, "snapshot of forwarded entries", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		readySince := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		t.Override(&timeNow, func() time.Time { return readySince })
+
+		em := NewEntryManager(&failingForwarder{testForwarder: newTestForwarder(), fail: "broken"})
+		t.CheckEmpty(em.ActiveEntries())
+
+		pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "pod",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "pod", "container", "http", "owner", 9000, false)
+		broken := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "broken",
+			Namespace: "default",
+		}, "", "", "", "", 9001, false)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, broken)
+
+		entries := em.ActiveEntries()
+		t.CheckDeepEqual([]PortForwardEntry{
+			{
+				Resource:       pfe.resource,
+				PodName:        "pod",
+				ContainerName:  "container",
+				PortName:       "http",
+				OwnerReference: "owner",
+				LocalPort:      9000,
+				Ready:          true,
+				ReadySince:     readySince,
+			},
+			{
+				Resource:  broken.resource,
+				LocalPort: 9001,
+			},
+		}, entries)
+
+		// the snapshot is not affected by later changes
+		em.Terminate(pfe)
+		t.CheckDeepEqual(2, len(entries))
+		t.CheckDeepEqual(1, len(em.ActiveEntries()))
+	})
+
+	testutil.Run(t, "safe to read while forwarding", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 50; i++ {
+				pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+					Type:      constants.Pod,
+					Name:      fmt.Sprintf("pod-%d", i),
+					Namespace: "default",
+				}, "", "", "", "", 9000+i, false)
+				em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+				if i%2 == 0 {
+					em.Terminate(pfe)
+				}
+			}
+		}()
+		for i := 0; i < 50; i++ {
+			for _, e := range em.ActiveEntries() {
+				t.CheckTrue(e.LocalPort >= 9000)
+			}
+		}
+		wg.Wait()
+		t.CheckDeepEqual(25, len(em.ActiveEntries()))
+	})
+}
+
+func TestActiveEntriesOrder(t *testing.T) {
+	testutil.Run(t, "sorted by namespace, pod and container port", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		pod := func(namespace, name string, port schemautil.IntOrString, localPort int) *portForwardEntry {
+			return newPortForwardEntry(0, latestV1.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      name,
+				Namespace: namespace,
+				Port:      port,
+			}, name, "app", "", "owner-"+name, localPort, true)
+		}
+		service := func(namespace, name string, port int, localPort int) *portForwardEntry {
+			return newPortForwardEntry(0, latestV1.PortForwardResource{
+				Type:      constants.Service,
+				Name:      name,
+				Namespace: namespace,
+				Port:      schemautil.FromInt(port),
+			}, "", "", "", "", localPort, false)
+		}
+		entries := []*portForwardEntry{
+			service("default", "web", 80, 9000),
+			pod("prod", "api-0", schemautil.FromInt(8080), 9001),
+			pod("default", "web-1", schemautil.FromString("http"), 9002),
+			pod("default", "web-1", schemautil.FromInt(9090), 9003),
+			service("default", "db", 5432, 9004),
+			pod("default", "web-1", schemautil.FromInt(8080), 9005),
+			pod("default", "api-0", schemautil.FromInt(8080), 9006),
+		}
+
+		// the order in which entries are forwarded doesn't matter
+		for i := 0; i < 10; i++ {
+			em := NewEntryManager(newTestForwarder())
+			for _, j := range rand.Perm(len(entries)) {
+				em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entries[j])
+			}
+
+			var localPorts []int
+			for _, e := range em.ActiveEntries() {
+				localPorts = append(localPorts, e.LocalPort)
+			}
+			t.CheckDeepEqual([]int{9006, 9005, 9003, 9002, 9004, 9000, 9001}, localPorts)
+		}
+	})
+}
+
+func TestSummary(t *testing.T) {
+	testutil.Run(t, "one line per forward", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		var out bytes.Buffer
+		em.Summary(&out)
+		t.CheckEmpty(out.String())
+
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "leeroy-app",
+			Namespace: "default",
+			Port:      schemautil.FromInt(50051),
+			Address:   "127.0.0.1",
+		}, "", "", "", "", 50051, false))
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "leeroy-web-5b9d",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   "127.0.0.1",
+		}, "leeroy-web-5b9d", "web", "http", "deployment-leeroy-web", 9000, true))
+
+		em.Summary(&out)
+		t.CheckDeepEqual(`Port forwards:
+ - default/leeroy-web-5b9d web:8080 -> 127.0.0.1:9000
+ - default/service/leeroy-app 50051 -> 127.0.0.1:50051
+`, out.String())
+	})
+}
+
+func TestAllocateLocalPort(t *testing.T) {
+	resource := latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      "leeroy-app",
+		Namespace: "default",
+		Port:      schemautil.FromInt(8080),
+		Address:   "127.0.0.1",
+	}
+
+	tests := []struct {
+		description string
+		hook        LocalPortHook
+		taken       []int
+		free        bool
+		expected    int
+	}{
+		{
+			description: "no hook",
+			expected:    8080,
+		},
+		{
+			description: "hook picks the local port",
+			hook:        func(latestV1.PortForwardResource) int { return 18080 },
+			free:        true,
+			expected:    18080,
+		},
+		{
+			description: "hook sees the resource",
+			hook: func(r latestV1.PortForwardResource) int {
+				return 10000 + len(r.Namespace)*100 + int(r.Port.IntVal)%100
+			},
+			free:     true,
+			expected: 10780,
+		},
+		{
+			description: "hook returns 0",
+			hook:        func(latestV1.PortForwardResource) int { return 0 },
+			free:        true,
+			expected:    8080,
+		},
+		{
+			description: "hook returns a port already forwarded",
+			hook:        func(latestV1.PortForwardResource) int { return 18080 },
+			taken:       []int{18080},
+			free:        true,
+			expected:    8080,
+		},
+		{
+			description: "hook returns a port in use on the host",
+			hook:        func(latestV1.PortForwardResource) int { return 18080 },
+			expected:    8080,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&isPortFree, func(string, int) bool { return test.free })
+			t.Override(&retrieveAvailablePort, func(_ string, req int, ps *util.PortSet) int {
+				ps.Set(req)
+				return req
+			})
+
+			em := NewEntryManager(newTestForwarder())
+			for _, port := range test.taken {
+				em.forwardedPorts.Set(port)
+			}
+			em.SetLocalPortHook(test.hook)
+
+			port, err := em.allocateLocalPort(newPortForwardEntry(0, resource, "", "", "", "", 0, false), 8080)
+			t.CheckNoError(err)
+			t.CheckDeepEqual(test.expected, port)
+			t.CheckTrue(em.forwardedPorts.LoadOrSet(test.expected))
+			if test.hook != nil && test.expected == 8080 && len(test.taken) == 0 {
+				// a port rejected by the host is not kept reserved
+				t.CheckFalse(em.forwardedPorts.LoadOrSet(18080))
+			}
+		})
+	}
+}
+
+func TestDisableEnable(t *testing.T) {
+	testutil.Run(t, "disabled entries are not forwarded until enabled", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		podEntry := func(resourceVersion int, podName string) *portForwardEntry {
+			return newPortForwardEntry(resourceVersion, latestV1.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      podName,
+				Namespace: "default",
+				Port:      schemautil.FromInt(8080),
+				Address:   "127.0.0.1",
+			}, podName, "web", "http", "deployment-leeroy-web", 9000, true)
+		}
+
+		fakeForwarder := newTestForwarder()
+		em := NewEntryManager(fakeForwarder)
+		first := podEntry(1, "leeroy-web-5b9d")
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, first)
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+
+		t.CheckErrorContains("no port forward on local port 9001", em.Disable(9001))
+		t.CheckNoError(em.Disable(9000))
+		t.CheckErrorContains("already disabled", em.Disable(9000))
+		t.CheckDeepEqual(0, fakeForwarder.forwardedResources.Length())
+		disabled := first.snapshot(false)
+		disabled.Disabled = true
+		t.CheckDeepEqual([]PortForwardEntry{disabled}, em.ActiveEntries())
+
+		var out bytes.Buffer
+		em.Summary(&out)
+		t.CheckDeepEqual("Port forwards:\n - default/leeroy-web-5b9d web:8080 -> 127.0.0.1:9000 (disabled)\n", out.String())
+
+		// a new generation of the pod is tracked but not forwarded
+		em.Terminate(first)
+		second := podEntry(2, "leeroy-web-7c4f")
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, second)
+		t.CheckDeepEqual(0, fakeForwarder.forwardedResources.Length())
+
+		t.CheckNoError(em.Enable(context.Background(), ioutil.Discard, 9000))
+		t.CheckErrorContains("already enabled", em.Enable(context.Background(), ioutil.Discard, 9000))
+		forwarded, found := fakeForwarder.forwardedResources.Load(second.key())
+		t.CheckTrue(found)
+		t.CheckDeepEqual("leeroy-web-7c4f", forwarded.podName)
+		t.CheckDeepEqual(9000, forwarded.localPort)
+		t.CheckFalse(em.ActiveEntries()[0].Disabled)
+	})
+}
+
+func TestEnableKeepsEntryOptions(t *testing.T) {
+	testutil.Run(t, "an entry enabled again keeps its kube-context and options", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		entry := newPortForwardEntry(1, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "leeroy-web-5b9d",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8443),
+			Address:   "127.0.0.1",
+		}, "leeroy-web-5b9d", "web", "https", "deployment-leeroy-web", 9443, true)
+		entry.kubeContext = "staging"
+		entry.tlsConfig = entry.newTLSConfig()
+		entry.priority = 1
+		entry.podUID = "uid-1"
+		entry.restartCount = 2
+
+		fakeForwarder := newTestForwarder()
+		em := NewEntryManager(fakeForwarder)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry)
+		t.CheckNoError(em.Disable(9443))
+		t.CheckNoError(em.Enable(context.Background(), ioutil.Discard, 9443))
+
+		forwarded, found := fakeForwarder.forwardedResources.Load(entry.key())
+		t.CheckTrue(found)
+		t.CheckFalse(forwarded == entry)
+		t.CheckDeepEqual("staging", forwarded.kubeContext)
+		t.CheckTrue(forwarded.tlsConfig == entry.tlsConfig)
+		t.CheckDeepEqual(1, forwarded.priority)
+		t.CheckDeepEqual("uid-1", forwarded.podUID)
+		t.CheckDeepEqual(int32(2), forwarded.restartCount)
+		t.CheckFalse(forwarded.terminated)
+		_, stored := em.forwardedResources.Load(entry.key())
+		t.CheckTrue(stored)
+	})
+}
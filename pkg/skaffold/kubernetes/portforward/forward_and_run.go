@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// LocalPortEnv is the environment variable holding the local port of the forward that ForwardAndRun runs a command against.
+const LocalPortEnv = "SKAFFOLD_PORT_FORWARD_LOCAL_PORT"
+
+var (
+	// For testing
+	readyPollInterval = 200 * time.Millisecond
+)
+
+// ForwardAndRun forwards a resource, waits until the forward is ready, and runs cmd with the local port in
+// the SKAFFOLD_PORT_FORWARD_LOCAL_PORT environment variable, eg. for smoke tests. The forward is torn down once
+// cmd exits, unless the resource was already forwarded, and the error of cmd is returned, if any.
+// The forward is ready once its tunnel is established and its local port accepts connections.
+// The wait is only bound by ctx.
+func (b *EntryManager) ForwardAndRun(ctx context.Context, out io.Writer, resource latestV1.PortForwardResource, cmd *exec.Cmd) error {
+	entry, found := b.forwardedResources.Load(newPortForwardEntry(0, resource, "", "", "", "", 0, false).key())
+	if !found {
+		var err error
+		if entry, err = NewUserDefinedForwarder(b, nil).getCurrentEntry(resource); err != nil {
+			return err
+		}
+		b.forwardPortForwardEntry(ctx, out, entry)
+		defer b.Terminate(entry)
+	}
+
+	if err := b.waitForEntry(ctx, entry); err != nil {
+		return fmt.Errorf("waiting for port forward of %s/%s to be ready: %w", resource.Type, resource.Name, err)
+	}
+
+	if cmd.Env == nil {
+		cmd.Env = os.Environ()
+	}
+	cmd.Env = append(cmd.Env, fmt.Sprintf("%s=%d", LocalPortEnv, entry.localPort))
+	if err := util.RunCmd(cmd); err != nil {
+		return fmt.Errorf("running command against port forward of %s/%s: %w", resource.Type, resource.Name, err)
+	}
+	return nil
+}
+
+// waitForEntry waits until the entry's tunnel is established and its local port accepts connections.
+func (b *EntryManager) waitForEntry(ctx context.Context, entry *portForwardEntry) error {
+	address := entry.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	target := net.JoinHostPort(address, strconv.Itoa(entry.localPort))
+
+	err := wait.PollImmediateUntil(readyPollInterval, func() (bool, error) {
+		if !b.isEntryReady(entry) {
+			return false, nil
+		}
+		conn, err := net.DialTimeout("tcp", target, readyPollInterval)
+		if err != nil {
+			return false, nil
+		}
+		conn.Close()
+		return true, nil
+	}, ctx.Done())
+	if err != nil && ctx.Err() != nil {
+		return ctx.Err()
+	}
+	return err
+}
+
+func (b *EntryManager) isEntryReady(p *portForwardEntry) bool {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	return b.readiness.entries[p]
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestForwardAndRun(t *testing.T) {
+	resource := latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      "svc",
+		Namespace: "default",
+		Port:      schemautil.FromInt(8080),
+		Address:   util.Loopback,
+	}
+
+	tests := []struct {
+		description      string
+		listening        bool
+		alreadyForwarded bool
+		cmdErr           error
+		shouldErr        bool
+		expectedForwards int
+	}{
+		{
+			description: "runs the command once the forward is ready, then tears it down",
+			listening:   true,
+		},
+		{
+			description: "returns the error of the command",
+			listening:   true,
+			cmdErr:      errors.New("smoke test failed"),
+			shouldErr:   true,
+		},
+		{
+			description: "gives up when the local port never accepts connections",
+			shouldErr:   true,
+		},
+		{
+			description:      "keeps a forward it didn't set up",
+			listening:        true,
+			alreadyForwarded: true,
+			expectedForwards: 1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&readyPollInterval, 10*time.Millisecond)
+
+			// a port that's reserved, but not listened on
+			port := util.GetAvailablePort(util.Loopback, 0, &util.PortSet{})
+			if test.listening {
+				l, err := net.Listen("tcp", fmt.Sprintf("%s:0", util.Loopback))
+				t.CheckNoError(err)
+				defer l.Close()
+				port = l.Addr().(*net.TCPAddr).Port
+			}
+			t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{port}))
+
+			cmd := testutil.CmdRunEnv("smoke-test", []string{fmt.Sprintf("SKAFFOLD_PORT_FORWARD_LOCAL_PORT=%d", port)})
+			if test.cmdErr != nil {
+				cmd = testutil.CmdRunErr("smoke-test", test.cmdErr)
+			}
+			t.Override(&util.DefaultExecCommand, cmd)
+
+			fakeForwarder := newTestForwarder()
+			entryManager := NewEntryManager(fakeForwarder)
+			if test.alreadyForwarded {
+				entryManager.forwardPortForwardEntry(context.Background(), ioutil.Discard, newPortForwardEntry(0, resource, "", "", "", "", port, false))
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+			defer cancel()
+			err := entryManager.ForwardAndRun(ctx, ioutil.Discard, resource, exec.Command("smoke-test"))
+
+			t.CheckError(test.shouldErr, err)
+			if test.cmdErr != nil {
+				t.CheckTrue(errors.Is(err, test.cmdErr))
+			}
+			t.CheckDeepEqual(test.expectedForwards, fakeForwarder.forwardedResources.Length())
+			t.CheckDeepEqual(test.expectedForwards, entryManager.forwardedResources.Length())
+		})
+	}
+}
@@ -19,10 +19,15 @@ package portforward
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"io"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
@@ -32,6 +37,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 )
 
 type Config interface {
@@ -48,10 +54,61 @@ type Forwarder interface {
 	Stop()
 }
 
+// Controller controls the port forwards of an accessor once it's started. The runner and the
+// API server reach it with a type assertion on the accessor, since not all accessors forward ports.
+type Controller interface {
+	// Pause holds off forwarding the resources that change while the dev loop rebuilds and redeploys.
+	Pause()
+
+	// Resume forwards the resources that changed since Pause, and the following ones as they change.
+	Resume()
+
+	// Summary writes a summary of the active port forwards.
+	Summary(io.Writer)
+
+	// EnablePortForward disables the port forward on a local port, or enables it again.
+	EnablePortForward(localPort int, enabled bool) error
+
+	// ForwardPodPort forwards a container port of a running pod, when pods are forwarded on demand, and returns its local port.
+	ForwardPodPort(namespace, podName string, port int) (int, error)
+
+	// ForwardReplicaPort forwards a container port of the replica of a workload picked by a selector, when pods are forwarded on demand,
+	// and returns its local port.
+	ForwardReplicaPort(namespace, owner string, replica ReplicaSelector, port int) (int, error)
+
+	// PortForwards returns a snapshot of the active port forwards.
+	PortForwards() []PortForwardEntry
+}
+
+var _ Controller = (*ForwarderManager)(nil)
+
 // ForwarderManager manages all forwarders
 type ForwarderManager struct {
 	forwarders   []Forwarder
 	entryManager *EntryManager
+	// onDemandPods, if set, tracks the pods whose ports are only forwarded when requested through the API.
+	onDemandPods *WatchingPodForwarder
+	// podSelectors select the pods to forward, by decreasing priority, if pods are forwarded.
+	podSelectors *prioritizedSelectors
+	// multiplexer, if set, multiplexes the ports of each pod over a single connection.
+	multiplexer *MultiplexForwarder
+	// pauseDuringRebuilds holds off forwarding while the dev loop rebuilds and redeploys.
+	pauseDuringRebuilds bool
+
+	// ctx and out are the ones port forwarding was started with,
+	// kept to enable port forwards again at runtime.
+	ctx     context.Context
+	out     io.Writer
+	ctxLock sync.Mutex
+
+	// openBrowser opens the HTTP port forwards in the browser once they're ready.
+	openBrowser bool
+	// stopBrowser stops opening port forwards in the browser.
+	stopBrowser func()
+	// advertiseMDNS advertises the HTTP port forwards over mDNS once they're ready.
+	advertiseMDNS bool
+	// stopMDNS withdraws the advertisements of port forwards.
+	stopMDNS func()
 }
 
 // NewForwarderManager returns a new port manager which handles starting and stopping port forwarding
@@ -60,27 +117,292 @@ func NewForwarderManager(cli *kubectl.CLI, podSelector kubernetes.PodSelector, l
 		return nil
 	}
 
-	entryManager := NewEntryManager(NewKubectlForwarder(cli))
+	// options.Validate() rejects in-cluster forwards that are multiplexed or served as WebSockets
+	var entryForwarder EntryForwarder = NewKubectlForwarder(cli, options)
+	var multiplexer *MultiplexForwarder
+	if options.InCluster {
+		entryForwarder = NewInClusterForwarder()
+	} else {
+		if options.Multiplex {
+			multiplexer = NewMultiplexForwarder(entryForwarder, options)
+			entryForwarder = multiplexer
+		}
+		if options.WebSocket {
+			entryForwarder = NewWebSocketForwarder(entryForwarder)
+		}
+	}
+	entryManager := NewEntryManager(entryForwarder)
+	if options.HostsAliases {
+		if err := entryManager.EnableHostsAliases(HostsFile()); err != nil {
+			logrus.Warnf("not registering aliases of port forwards: %v", err)
+		}
+	}
+	if options.ReservationsFile != "" {
+		if err := entryManager.EnablePortReservations(options.ReservationsFile); err != nil {
+			logrus.Warnf("not reserving local ports across sessions: %v", err)
+		}
+	}
+	if options.HealthEndpoints {
+		entryManager.EnableHealthEndpoints()
+	}
+	if options.PortSeed != 0 {
+		entryManager.SetPortSeed(options.PortSeed)
+	}
+	if formatter, err := OutputFormatterFor(options.OutputFormat); err != nil {
+		logrus.Warnf("writing port forward messages as text: %v", err)
+	} else {
+		entryManager.SetOutputFormatter(formatter)
+	}
+	if len(options.NamespaceOffsets) > 0 {
+		entryManager.SetLocalPortHook(namespacePortOffsets(options.NamespaceOffsets))
+	}
 
 	var forwarders []Forwarder
+	var onDemandPods *WatchingPodForwarder
 	if options.ForwardUser(runMode) {
 		forwarders = append(forwarders, NewUserDefinedForwarder(entryManager, userDefined))
 	}
 	if options.ForwardServices(runMode) {
 		forwarders = append(forwarders, NewServicesForwarder(entryManager, label))
 	}
+	var podSelectors *prioritizedSelectors
+	var containerPorts portSelector
 	if options.ForwardPods(runMode) {
-		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, podSelector, allPorts))
+		containerPorts = allPorts
 	} else if options.ForwardDebug(runMode) {
-		forwarders = append(forwarders, NewWatchingPodForwarder(entryManager, podSelector, debugPorts))
+		containerPorts = debugPorts
+	}
+	if options.ReadinessProbe {
+		containerPorts = withReadinessProbePort(containerPorts)
+	}
+	if containerPorts != nil {
+		podSelectors = newPrioritizedSelectors(podSelector)
+		var images imageSet
+		if _, ok := podSelector.(imageSet); ok {
+			// the images of the pod selectors added later are under development too
+			images = podSelectors
+		}
+		if options.PrimaryContainerOnly {
+			containerPorts = primaryContainerPorts(images, containerPorts)
+		}
+		if images != nil && options.DevImagesOnly {
+			containerPorts = devImagePorts(images, containerPorts)
+		}
+		if len(options.AllowedPorts) > 0 || len(options.DeniedPorts) > 0 {
+			containerPorts = filteredPorts(options.AllowedPorts, options.DeniedPorts, containerPorts)
+		}
+		// options.Validate() rejects invalid selectors when the flags are parsed
+		fieldSelector, err := fields.ParseSelector(options.FieldSelector)
+		if err != nil {
+			logrus.Warnf("not forwarding pods: invalid field selector %q: %v", options.FieldSelector, err)
+		}
+		labelCondition, lerr := labels.Parse(options.LabelCondition)
+		if lerr != nil {
+			logrus.Warnf("not forwarding pods: invalid label condition %q: %v", options.LabelCondition, lerr)
+		}
+		if err == nil && lerr == nil {
+			podForwarder := newWatchingPodForwarder(entryManager, podSelectors, containerPorts, fieldSelector, labelCondition)
+			if options.OnePodPerWorkload {
+				podForwarder.workloads = newWorkloadPods()
+			}
+			podForwarder.deleteGrace = options.DeleteGracePeriod
+			if kubeContexts := otherKubeContexts(cli.KubeContext, options.KubeContexts); len(kubeContexts) > 0 {
+				podForwarder.watchKubeContexts(kubeContexts)
+			}
+			if options.OnDemand {
+				podForwarder.forwardOnRequest()
+				onDemandPods = podForwarder
+			}
+			forwarders = append(forwarders, podForwarder)
+		}
 	}
 
 	return &ForwarderManager{
-		forwarders:   forwarders,
-		entryManager: entryManager,
+		forwarders:          forwarders,
+		entryManager:        entryManager,
+		onDemandPods:        onDemandPods,
+		podSelectors:        podSelectors,
+		multiplexer:         multiplexer,
+		pauseDuringRebuilds: options.PauseDuringRebuilds,
+		openBrowser:         options.OpenBrowser,
+		advertiseMDNS:       options.AdvertiseMDNS,
+	}
+}
+
+// otherKubeContexts returns the given kube-contexts, without the current one and duplicates.
+func otherKubeContexts(current string, kubeContexts []string) []string {
+	seen := map[string]bool{current: true, "": true}
+	var others []string
+	for _, kubeContext := range kubeContexts {
+		if !seen[kubeContext] {
+			seen[kubeContext] = true
+			others = append(others, kubeContext)
+		}
+	}
+	return others
+}
+
+// imageSet is implemented by pod selectors that track the images under development.
+type imageSet interface {
+	Has(image string) bool
+}
+
+// devImagePorts restricts the ports selected by `ports` to the containers running
+// one of the images under development, leaving out stable dependency images.
+func devImagePorts(images imageSet, ports portSelector) portSelector {
+	return func(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
+		if !images.Has(c.Image) {
+			logrus.Debugf("not forwarding pod/%s/%s: image %q is not under development", pod.Name, c.Name, c.Image)
+			return nil
+		}
+		return ports(pod, c)
+	}
+}
+
+// filteredPorts restricts the ports selected by `ports` to the allowed ones, if any, leaving out the denied ones.
+// Filtered out ports are left out before their entry is created, so they don't take a local port.
+func filteredPorts(allowed, denied []int, ports portSelector) portSelector {
+	isAllowed := map[int32]bool{}
+	for _, port := range allowed {
+		isAllowed[int32(port)] = true
+	}
+	isDenied := map[int32]bool{}
+	for _, port := range denied {
+		isDenied[int32(port)] = true
+	}
+	return func(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
+		var selected []v1.ContainerPort
+		for _, port := range ports(pod, c) {
+			switch {
+			case isDenied[port.ContainerPort]:
+				logrus.Debugf("not forwarding pod/%s/%s port %d: it's denied", pod.Name, c.Name, port.ContainerPort)
+			case len(isAllowed) > 0 && !isAllowed[port.ContainerPort]:
+				logrus.Debugf("not forwarding pod/%s/%s port %d: it's not allowed", pod.Name, c.Name, port.ContainerPort)
+			default:
+				selected = append(selected, port)
+			}
+		}
+		return selected
+	}
+}
+
+// namespacePortOffsets forwards the ports of resources to their port shifted by the offset of their namespace,
+// so that the same services deployed to several namespaces don't clash on local ports.
+// Resources with an explicit local port, or in namespaces without an offset, are left to the usual allocation.
+func namespacePortOffsets(offsets map[string]int) LocalPortHook {
+	return func(resource latestV1.PortForwardResource) int {
+		offset, found := offsets[resource.Namespace]
+		if !found || resource.LocalPort != 0 || resource.Port.Type != schemautil.Int {
+			return 0
+		}
+		if port := offset + resource.Port.IntVal; port > 0 && port <= 65535 {
+			return port
+		}
+		logrus.Debugf("not offsetting the local port of %s/%s: %d+%d is not a valid port", resource.Type, resource.Name, offset, resource.Port.IntVal)
+		return 0
+	}
+}
+
+// knownSidecars are the names of containers commonly injected next to the application.
+var knownSidecars = map[string]bool{
+	"istio-proxy":             true,
+	"linkerd-proxy":           true,
+	"envoy":                   true,
+	"cloud-sql-proxy":         true,
+	"cloudsql-proxy":          true,
+	"vault-agent":             true,
+	"daprd":                   true,
+	"opentelemetry-collector": true,
+}
+
+// primaryContainerPorts restricts the ports selected by `ports` to the primary container of
+// pods that have one obvious application container, leaving out sidecars such as injected proxies.
+// Pods without an obvious primary container are forwarded as usual.
+func primaryContainerPorts(images imageSet, ports portSelector) portSelector {
+	return func(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
+		if primary, found := primaryContainer(images, ports, pod); found && primary != c.Name {
+			logrus.Debugf("not forwarding pod/%s/%s: %s is the primary container", pod.Name, c.Name, primary)
+			return nil
+		}
+		return ports(pod, c)
+	}
+}
+
+// primaryContainer returns the only container of a pod, out of the ones with ports to forward
+// that aren't known sidecars, which runs an image under development or, failing that, the only such container.
+func primaryContainer(images imageSet, ports portSelector, pod *v1.Pod) (string, bool) {
+	var candidates, devContainers []string
+	for _, c := range pod.Spec.Containers {
+		if knownSidecars[c.Name] || len(ports(pod, c)) == 0 {
+			continue
+		}
+		candidates = append(candidates, c.Name)
+		if images != nil && images.Has(c.Image) {
+			devContainers = append(devContainers, c.Name)
+		}
+	}
+	switch {
+	case len(devContainers) == 1:
+		return devContainers[0], true
+	case len(candidates) == 1:
+		return candidates[0], true
+	default:
+		return "", false
 	}
 }
 
+// withReadinessProbePort adds the port targeted by a container's readinessProbe to the ports
+// selected by `ports`, even if the container doesn't expose it. `ports` can be nil to only
+// forward readiness probe ports.
+func withReadinessProbePort(ports portSelector) portSelector {
+	return func(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
+		var selected []v1.ContainerPort
+		if ports != nil {
+			selected = ports(pod, c)
+		}
+		probePort, found := readinessProbePort(pod, c)
+		if !found {
+			return selected
+		}
+		for _, port := range selected {
+			if port.ContainerPort == probePort.ContainerPort {
+				return selected
+			}
+		}
+		return append(selected, probePort)
+	}
+}
+
+// readinessProbePort returns the port targeted by the httpGet or tcpSocket readinessProbe
+// of a container. Named ports are resolved against the ports declared by the container.
+func readinessProbePort(pod *v1.Pod, c v1.Container) (v1.ContainerPort, bool) {
+	probe := c.ReadinessProbe
+	if probe == nil {
+		return v1.ContainerPort{}, false
+	}
+
+	var target intstr.IntOrString
+	switch {
+	case probe.HTTPGet != nil:
+		target = probe.HTTPGet.Port
+	case probe.TCPSocket != nil:
+		target = probe.TCPSocket.Port
+	default:
+		return v1.ContainerPort{}, false
+	}
+
+	for _, port := range c.Ports {
+		if (target.Type == intstr.String && port.Name == target.StrVal) || (target.Type == intstr.Int && port.ContainerPort == target.IntVal) {
+			return port, true
+		}
+	}
+	if target.Type == intstr.String {
+		logrus.Debugf("not forwarding readiness probe of pod/%s/%s: no port named %q", pod.Name, c.Name, target.StrVal)
+		return v1.ContainerPort{}, false
+	}
+	return v1.ContainerPort{ContainerPort: target.IntVal, Protocol: v1.ProtocolTCP}, true
+}
+
 func allPorts(pod *v1.Pod, c v1.Container) []v1.ContainerPort {
 	return c.Ports
 }
@@ -120,11 +442,25 @@ func (p *ForwarderManager) Start(ctx context.Context, out io.Writer, namespaces
 		return nil
 	}
 
+	p.ctxLock.Lock()
+	p.ctx, p.out = ctx, out
+	p.ctxLock.Unlock()
+
 	eventV2.TaskInProgress(constants.PortForward, "Port forward URLs")
 	ctx, endTrace := instrumentation.StartTrace(ctx, "Start")
 	defer endTrace()
 
 	p.entryManager.Start(out)
+	if p.openBrowser && p.stopBrowser == nil {
+		if hasDisplay() {
+			p.stopBrowser = p.entryManager.OpenInBrowser()
+		} else {
+			logrus.Infof("not opening port forwards in the browser: no display is available")
+		}
+	}
+	if p.advertiseMDNS && p.stopMDNS == nil {
+		p.stopMDNS = p.entryManager.AdvertiseOverMDNS()
+	}
 	for _, f := range p.forwarders {
 		if err := f.Start(ctx, out, namespaces); err != nil {
 			eventV2.TaskFailed(constants.PortForward, err)
@@ -147,6 +483,160 @@ func (p *ForwarderManager) Stop() {
 	for _, f := range p.forwarders {
 		f.Stop()
 	}
+	if p.stopBrowser != nil {
+		p.stopBrowser()
+		p.stopBrowser = nil
+	}
+	if p.stopMDNS != nil {
+		p.stopMDNS()
+		p.stopMDNS = nil
+	}
+}
+
+// AddPodSelector forwards the pods selected by another selector, eg. the images of another deployer,
+// with a lower priority than the pods selected so far: once local ports run out, the pods of the
+// selectors added first take over the local ports of pods only selected by the ones added later.
+func (p *ForwarderManager) AddPodSelector(podSelector kubernetes.PodSelector) {
+	// Port forwarding is not enabled, or pods are not forwarded.
+	if p == nil || p.podSelectors == nil {
+		return
+	}
+
+	p.podSelectors.add(podSelector)
+}
+
+// SetVetoHook installs a hook consulted before forwarding each container port of the pods, eg. to enforce a policy
+// on the local ports or namespaces that can be forwarded. It must be called before Start.
+func (p *ForwarderManager) SetVetoHook(hook VetoHook) {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return
+	}
+
+	for _, f := range p.forwarders {
+		if pf, ok := f.(*WatchingPodForwarder); ok {
+			pf.SetVetoHook(hook)
+		}
+	}
+}
+
+// SetDialer replaces the dialer of the connections to the pods whose ports are multiplexed, which goes through
+// the configured proxy by default. Ports forwarded by `kubectl port-forward` aren't dialed by Skaffold.
+// It must be called before Start.
+func (p *ForwarderManager) SetDialer(dialer Dialer) {
+	// Port forwarding is not enabled.
+	if p == nil || p.multiplexer == nil {
+		return
+	}
+
+	p.multiplexer.SetDialer(dialer)
+}
+
+// Pause holds off forwarding the resources that change while the dev loop rebuilds and redeploys,
+// when forwarding is paused during rebuilds.
+func (p *ForwarderManager) Pause() {
+	// Port forwarding is not enabled.
+	if p == nil || !p.pauseDuringRebuilds {
+		return
+	}
+
+	for _, f := range p.forwarders {
+		if pf, ok := f.(pausableForwarder); ok {
+			pf.pause()
+		}
+	}
+}
+
+// Resume forwards the resources that changed since Pause, and the following ones as they change.
+// It returns once the resources that changed are forwarded.
+func (p *ForwarderManager) Resume() {
+	// Port forwarding is not enabled.
+	if p == nil || !p.pauseDuringRebuilds {
+		return
+	}
+
+	for _, f := range p.forwarders {
+		if pf, ok := f.(pausableForwarder); ok {
+			pf.resume()
+		}
+	}
+}
+
+// Summary lists the active port forwards
+func (p *ForwarderManager) Summary(out io.Writer) {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return
+	}
+
+	p.entryManager.Summary(out)
+}
+
+// EnablePortForward disables the port forward on a local port, or enables it again.
+func (p *ForwarderManager) EnablePortForward(localPort int, enabled bool) error {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return errors.New("port forwarding is not enabled")
+	}
+
+	if !enabled {
+		return p.entryManager.Disable(localPort)
+	}
+	p.ctxLock.Lock()
+	ctx, out := p.ctx, p.out
+	p.ctxLock.Unlock()
+	return p.entryManager.Enable(ctx, out, localPort)
+}
+
+// ForwardPodPort forwards a container port of a running pod, when pods are forwarded on demand, and returns its local port.
+func (p *ForwarderManager) ForwardPodPort(namespace, podName string, port int) (int, error) {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return 0, errors.New("port forwarding is not enabled")
+	}
+	if p.onDemandPods == nil {
+		return 0, errors.New("pods are not forwarded on demand, run with `--port-forward-on-demand` to forward the ports of given pods or replicas")
+	}
+
+	p.ctxLock.Lock()
+	ctx := p.ctx
+	p.ctxLock.Unlock()
+	if ctx == nil {
+		return 0, errors.New("port forwarding is not started")
+	}
+	return p.onDemandPods.requestForward(ctx, namespace, podName, port)
+}
+
+// ForwardReplicaPort forwards a container port of the replica of a workload, given as "<kind>/<name>", that the selector picks,
+// when pods are forwarded on demand, and returns its local port. The port keeps being forwarded from the replica picked by
+// the same selector when replicas are replaced. Otherwise, the pods of a workload share its forwards, so a given replica
+// can't be forwarded.
+func (p *ForwarderManager) ForwardReplicaPort(namespace, owner string, replica ReplicaSelector, port int) (int, error) {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return 0, errors.New("port forwarding is not enabled")
+	}
+	if p.onDemandPods == nil {
+		return 0, errors.New("pods are not forwarded on demand, run with `--port-forward-on-demand` to forward the ports of given pods or replicas")
+	}
+
+	p.ctxLock.Lock()
+	ctx := p.ctx
+	p.ctxLock.Unlock()
+	if ctx == nil {
+		return 0, errors.New("port forwarding is not started")
+	}
+	return p.onDemandPods.requestReplicaForward(ctx, namespace, owner, replica, port)
+}
+
+// PortForwards returns a snapshot of the active port forwards
+func (p *ForwarderManager) PortForwards() []PortForwardEntry {
+	// Port forwarding is not enabled.
+	if p == nil {
+		return nil
+	}
+
+	return p.entryManager.ActiveEntries()
 }
 
 func (p *ForwarderManager) Name() string {
@@ -18,15 +18,20 @@ package portforward
 
 import (
 	"context"
+	"errors"
 	"io/ioutil"
 	"testing"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -71,6 +76,100 @@ func TestNewForwarderManager(t *testing.T) {
 	}
 }
 
+func TestForwarderManagerAddPodSelector(t *testing.T) {
+	podOf := func(image string) *v1.Pod {
+		return &v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "app", Image: image}}}}
+	}
+
+	testutil.Run(t, "the pods of the deployers registered first win", func(t *testutil.T) {
+		primary := kubernetes.NewImageList()
+		primary.Add("app")
+		secondary := kubernetes.NewImageList()
+		secondary.Add("db")
+		options := config.PortForwardOptions{}
+		options.Set("pods")
+		fm := NewForwarderManager(&kubectl.CLI{}, primary, "", "", options, nil)
+
+		fm.AddPodSelector(secondary)
+		fm.AddPodSelector(secondary)
+
+		t.CheckDeepEqual(1, len(fm.forwarders))
+		podForwarder := fm.forwarders[0].(*WatchingPodForwarder)
+		t.CheckTrue(podForwarder.podSelectors.Select(podOf("db")))
+		t.CheckFalse(podForwarder.podSelectors.Select(podOf("redis")))
+		t.CheckDeepEqual(0, podForwarder.podSelectors.priority(podOf("app")))
+		t.CheckDeepEqual(1, podForwarder.podSelectors.priority(podOf("db")))
+		t.CheckTrue(podForwarder.podSelectors.Has("db"))
+	})
+
+	testutil.Run(t, "pods are not forwarded", func(t *testutil.T) {
+		options := config.PortForwardOptions{}
+		options.Set("user")
+		fm := NewForwarderManager(&kubectl.CLI{}, kubernetes.NewImageList(), "", "", options, nil)
+
+		fm.AddPodSelector(kubernetes.NewImageList())
+
+		var nilManager *ForwarderManager
+		nilManager.AddPodSelector(kubernetes.NewImageList())
+	})
+}
+
+func TestForwarderManagerForwardReplicaPort(t *testing.T) {
+	testutil.Run(t, "replicas are only forwarded on demand", func(t *testutil.T) {
+		options := config.PortForwardOptions{}
+		options.Set("pods")
+		fm := NewForwarderManager(&kubectl.CLI{}, kubernetes.NewImageList(), "", "", options, nil)
+
+		_, err := fm.ForwardReplicaPort("default", "deployment/app", ReplicaSelector{Index: 1}, 8080)
+		t.CheckErrorContains("--port-forward-on-demand", err)
+		_, err = fm.ForwardPodPort("default", "app-0", 8080)
+		t.CheckErrorContains("--port-forward-on-demand", err)
+	})
+}
+
+func TestForwarderManagerSetVetoHook(t *testing.T) {
+	testutil.Run(t, "the hook is installed on the pod forwarders", func(t *testutil.T) {
+		options := config.PortForwardOptions{}
+		options.Set("pods,user")
+		fm := NewForwarderManager(&kubectl.CLI{}, kubernetes.NewImageList(), "", "", options, nil)
+		vetoed := errors.New("vetoed")
+
+		fm.SetVetoHook(func(PortForwardEntry) error { return vetoed })
+
+		var hooked int
+		for _, f := range fm.forwarders {
+			if pf, ok := f.(*WatchingPodForwarder); ok {
+				hooked++
+				t.CheckTrue(errors.Is(pf.veto(PortForwardEntry{}), vetoed))
+			}
+		}
+		t.CheckDeepEqual(1, hooked)
+	})
+}
+
+func TestForwarderManagerSetDialer(t *testing.T) {
+	testutil.Run(t, "the dialer is installed on the multiplexing forwarder", func(t *testutil.T) {
+		options := config.PortForwardOptions{Multiplex: true}
+		options.Set("pods")
+		fm := NewForwarderManager(&kubectl.CLI{}, kubernetes.NewImageList(), "", "", options, nil)
+		dialed := errors.New("dialed")
+
+		fm.SetDialer(func(string, string, string) (httpstream.Connection, error) { return nil, dialed })
+
+		_, err := fm.multiplexer.dialer("", "default", "app")
+		t.CheckTrue(errors.Is(err, dialed))
+	})
+
+	testutil.Run(t, "ports aren't multiplexed", func(t *testutil.T) {
+		options := config.PortForwardOptions{}
+		options.Set("pods")
+		fm := NewForwarderManager(&kubectl.CLI{}, kubernetes.NewImageList(), "", "", options, nil)
+
+		// Should not raise a nil dereference
+		fm.SetDialer(func(string, string, string) (httpstream.Connection, error) { return nil, nil })
+	})
+}
+
 func TestForwarderManagerZeroValue(t *testing.T) {
 	var m *ForwarderManager
 
@@ -89,6 +188,211 @@ func TestAllPorts(t *testing.T) {
 	testutil.CheckDeepEqual(t, ports, allPorts(&pod, container))
 }
 
+func TestDevImagePorts(t *testing.T) {
+	images := kubernetes.NewImageList()
+	images.Add("gcr.io/project/app:dev")
+
+	ports := []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+	app := v1.Container{Name: "app", Image: "gcr.io/project/app:dev", Ports: ports}
+	redis := v1.Container{Name: "redis", Image: "redis:6", Ports: []v1.ContainerPort{{Name: "redis", ContainerPort: 6379}}}
+	pod := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{app, redis}}}
+
+	selector := devImagePorts(images, allPorts)
+	testutil.CheckDeepEqual(t, ports, selector(&pod, app))
+	testutil.CheckDeepEqual(t, []v1.ContainerPort(nil), selector(&pod, redis))
+}
+
+func TestFilteredPorts(t *testing.T) {
+	ssh := v1.ContainerPort{Name: "ssh", ContainerPort: 22}
+	http := v1.ContainerPort{Name: "http", ContainerPort: 8080}
+	debug := v1.ContainerPort{Name: "debug", ContainerPort: 9229}
+	c := v1.Container{Name: "app", Ports: []v1.ContainerPort{ssh, http, debug}}
+	pod := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{c}}}
+
+	tests := []struct {
+		description string
+		allowed     []int
+		denied      []int
+		expected    []v1.ContainerPort
+	}{
+		{
+			description: "denied ports",
+			denied:      []int{22, 2375, 10250},
+			expected:    []v1.ContainerPort{http, debug},
+		},
+		{
+			description: "allowed ports",
+			allowed:     []int{8080, 9229},
+			expected:    []v1.ContainerPort{http, debug},
+		},
+		{
+			description: "denied ports take precedence",
+			allowed:     []int{8080, 9229},
+			denied:      []int{9229},
+			expected:    []v1.ContainerPort{http},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			selector := filteredPorts(test.allowed, test.denied, allPorts)
+			t.CheckDeepEqual(test.expected, selector(&pod, c))
+		})
+	}
+}
+
+func TestNamespacePortOffsets(t *testing.T) {
+	hook := namespacePortOffsets(map[string]int{"dev": 10000, "staging": 20000, "prod": 60000})
+
+	tests := []struct {
+		description string
+		resource    latestV1.PortForwardResource
+		expected    int
+	}{
+		{
+			description: "dev namespace",
+			resource:    latestV1.PortForwardResource{Namespace: "dev", Port: schemautil.FromInt(8080)},
+			expected:    18080,
+		},
+		{
+			description: "staging namespace",
+			resource:    latestV1.PortForwardResource{Namespace: "staging", Port: schemautil.FromInt(8080)},
+			expected:    28080,
+		},
+		{
+			description: "namespace without offset",
+			resource:    latestV1.PortForwardResource{Namespace: "default", Port: schemautil.FromInt(8080)},
+		},
+		{
+			description: "explicit local port",
+			resource:    latestV1.PortForwardResource{Namespace: "dev", Port: schemautil.FromInt(8080), LocalPort: 9000},
+		},
+		{
+			description: "named port",
+			resource:    latestV1.PortForwardResource{Namespace: "dev", Port: schemautil.FromString("http")},
+		},
+		{
+			description: "offset port out of range",
+			resource:    latestV1.PortForwardResource{Namespace: "prod", Port: schemautil.FromInt(8080)},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, hook(test.resource))
+		})
+	}
+}
+
+func TestOtherKubeContexts(t *testing.T) {
+	testutil.CheckDeepEqual(t, []string{"cluster-b", "cluster-c"}, otherKubeContexts("cluster-a", []string{"cluster-b", "cluster-a", "", "cluster-c", "cluster-b"}))
+	testutil.CheckDeepEqual(t, []string(nil), otherKubeContexts("cluster-a", nil))
+}
+
+func TestPrimaryContainerPorts(t *testing.T) {
+	images := kubernetes.NewImageList()
+	images.Add("gcr.io/project/app:dev")
+
+	http := []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}
+	app := v1.Container{Name: "app", Image: "gcr.io/project/app:dev", Ports: http}
+	web := v1.Container{Name: "web", Image: "nginx", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 80}}}
+	proxy := v1.Container{Name: "istio-proxy", Image: "istio/proxyv2", Ports: []v1.ContainerPort{{Name: "http-envoy-prom", ContainerPort: 15090}}}
+	redis := v1.Container{Name: "redis", Image: "redis:6", Ports: []v1.ContainerPort{{Name: "redis", ContainerPort: 6379}}}
+
+	tests := []struct {
+		description string
+		images      imageSet
+		containers  []v1.Container
+		expected    map[string][]v1.ContainerPort
+	}{
+		{
+			description: "dev image next to a dependency",
+			images:      images,
+			containers:  []v1.Container{app, redis},
+			expected:    map[string][]v1.ContainerPort{"app": http, "redis": nil},
+		},
+		{
+			description: "sole container besides a known sidecar",
+			containers:  []v1.Container{web, proxy},
+			expected:    map[string][]v1.ContainerPort{"web": web.Ports, "istio-proxy": nil},
+		},
+		{
+			description: "no obvious primary container",
+			containers:  []v1.Container{web, redis},
+			expected:    map[string][]v1.ContainerPort{"web": web.Ports, "redis": redis.Ports},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := v1.Pod{Spec: v1.PodSpec{Containers: test.containers}}
+
+			selector := primaryContainerPorts(test.images, allPorts)
+			for _, c := range test.containers {
+				t.CheckDeepEqual(test.expected[c.Name], selector(&pod, c))
+			}
+		})
+	}
+}
+
+func TestWithReadinessProbePort(t *testing.T) {
+	httpGet := func(port intstr.IntOrString) *v1.Probe {
+		probe := &v1.Probe{}
+		probe.HTTPGet = &v1.HTTPGetAction{Port: port}
+		return probe
+	}
+	tcpSocket := func(port intstr.IntOrString) *v1.Probe {
+		probe := &v1.Probe{}
+		probe.TCPSocket = &v1.TCPSocketAction{Port: port}
+		return probe
+	}
+	http := v1.ContainerPort{Name: "http", ContainerPort: 8080}
+	health := v1.ContainerPort{Name: "health", ContainerPort: 8081}
+
+	tests := []struct {
+		description string
+		ports       portSelector
+		container   v1.Container
+		expected    []v1.ContainerPort
+	}{
+		{
+			description: "no probe",
+			ports:       allPorts,
+			container:   v1.Container{Ports: []v1.ContainerPort{http}},
+			expected:    []v1.ContainerPort{http},
+		},
+		{
+			description: "httpGet on an unexposed port",
+			ports:       allPorts,
+			container:   v1.Container{Ports: []v1.ContainerPort{http}, ReadinessProbe: httpGet(intstr.FromInt(9090))},
+			expected:    []v1.ContainerPort{http, {ContainerPort: 9090, Protocol: v1.ProtocolTCP}},
+		},
+		{
+			description: "httpGet on an exposed port",
+			ports:       allPorts,
+			container:   v1.Container{Ports: []v1.ContainerPort{http}, ReadinessProbe: httpGet(intstr.FromInt(8080))},
+			expected:    []v1.ContainerPort{http},
+		},
+		{
+			description: "tcpSocket on a named port",
+			container:   v1.Container{Ports: []v1.ContainerPort{http, health}, ReadinessProbe: tcpSocket(intstr.FromString("health"))},
+			expected:    []v1.ContainerPort{health},
+		},
+		{
+			description: "unknown named port",
+			container:   v1.Container{Ports: []v1.ContainerPort{http}, ReadinessProbe: tcpSocket(intstr.FromString("health"))},
+		},
+		{
+			description: "exec probe",
+			container:   v1.Container{Ports: []v1.ContainerPort{http}, ReadinessProbe: &v1.Probe{}},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{test.container}}}
+
+			t.CheckDeepEqual(test.expected, withReadinessProbePort(test.ports)(&pod, test.container))
+		})
+	}
+}
+
 func TestDebugPorts(t *testing.T) {
 	ports := []v1.ContainerPort{
 		{Name: "dlv", ContainerPort: 56268},
@@ -0,0 +1,145 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// HealthActive is the state of an entry whose tunnel is established.
+	HealthActive = "active"
+	// HealthDegraded is the state of an entry whose tunnel is being established, or was lost.
+	HealthDegraded = "degraded"
+	// HealthTerminated is the state of an entry that is no longer forwarded.
+	HealthTerminated = "terminated"
+)
+
+// healthEndpoint serves the state of an entry over HTTP on a local port.
+type healthEndpoint struct {
+	localPort int
+	server    *http.Server
+}
+
+func (h *healthEndpoint) port() int {
+	if h == nil {
+		return 0
+	}
+	return h.localPort
+}
+
+// entryHealth is the JSON document served by health endpoints.
+type entryHealth struct {
+	State         string `json:"state"`
+	ResourceType  string `json:"resourceType"`
+	ResourceName  string `json:"resourceName"`
+	Namespace     string `json:"namespace"`
+	PodName       string `json:"podName,omitempty"`
+	ContainerName string `json:"containerName,omitempty"`
+	Port          string `json:"port"`
+	LocalPort     int    `json:"localPort"`
+}
+
+// EnableHealthEndpoints serves the state of each entry forwarded from now on as JSON,
+// on `http://localhost:<health port>/`, where the health port is allocated like local ports
+// and reported with the entry. The endpoint answers 200 while the entry is active and 503
+// while it's degraded or terminated, and is shut down once the entry is terminated.
+func (b *EntryManager) EnableHealthEndpoints() {
+	b.healthEndpoints = true
+}
+
+// startHealthEndpoint serves the state of an entry, if health endpoints are enabled.
+// Failing to serve it only leaves the entry without a health endpoint, so it's only logged.
+func (b *EntryManager) startHealthEndpoint(entry *portForwardEntry) {
+	if !b.healthEndpoints {
+		return
+	}
+	port := b.availablePort(util.Loopback, 0)
+	if port <= 0 {
+		logrus.Warnf("no local port available for the health endpoint of port forward %s", entry)
+		return
+	}
+	l, err := net.Listen("tcp", fmt.Sprintf("%s:%d", util.Loopback, port))
+	if err != nil {
+		b.forwardedPorts.Delete(port)
+		logrus.Warnf("serving the health endpoint of port forward %s: %v", entry, err)
+		return
+	}
+
+	server := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		b.serveHealth(w, entry)
+	})}
+	entry.health = &healthEndpoint{localPort: port, server: server}
+	go func() {
+		if err := server.Serve(l); err != nil && err != http.ErrServerClosed {
+			logrus.Debugf("health endpoint of port forward %s: %v", entry, err)
+		}
+	}()
+	logrus.Debugf("serving the health of port forward %s on http://%s:%d", entry, util.Loopback, port)
+}
+
+// stopHealthEndpoint shuts down the health endpoint of an entry, if any.
+func (b *EntryManager) stopHealthEndpoint(entry *portForwardEntry) {
+	if entry.health == nil {
+		return
+	}
+	entry.health.server.Close()
+	b.forwardedPorts.Delete(entry.health.localPort)
+	entry.health = nil
+}
+
+func (b *EntryManager) serveHealth(w http.ResponseWriter, entry *portForwardEntry) {
+	health := entryHealth{
+		State:         b.healthOf(entry),
+		ResourceType:  string(entry.resource.Type),
+		ResourceName:  entry.resource.Name,
+		Namespace:     entry.resource.Namespace,
+		PodName:       entry.podName,
+		ContainerName: entry.containerName,
+		Port:          entry.resource.Port.String(),
+		LocalPort:     entry.localPort,
+	}
+	w.Header().Set("Content-Type", "application/json")
+	if health.State != HealthActive {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(health)
+}
+
+// healthOf is the state of an entry: active once its tunnel is established, degraded until then
+// and while it's re-established, and terminated once it's no longer tracked.
+func (b *EntryManager) healthOf(entry *portForwardEntry) string {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	ready, tracked := b.readiness.entries[entry]
+	switch {
+	case !tracked:
+		return HealthTerminated
+	case ready:
+		return HealthActive
+	default:
+		return HealthDegraded
+	}
+}
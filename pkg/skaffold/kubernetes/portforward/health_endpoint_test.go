@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestHealthEndpoint(t *testing.T) {
+	getHealth := func(t *testutil.T, port int) (int, entryHealth) {
+		resp, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", port))
+		t.CheckNoError(err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		t.CheckNoError(err)
+		var health entryHealth
+		t.CheckNoError(json.Unmarshal(body, &health))
+		return resp.StatusCode, health
+	}
+
+	testutil.Run(t, "serves the state of the entry until it's terminated", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		em.EnableHealthEndpoints()
+		entry := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "web",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   "127.0.0.1",
+		}, "", "", "", "", 9000, false)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry)
+
+		healthPort := em.ActiveEntries()[0].HealthPort
+		t.CheckTrue(healthPort > 0)
+
+		code, health := getHealth(t, healthPort)
+		t.CheckDeepEqual(http.StatusOK, code)
+		t.CheckDeepEqual(entryHealth{
+			State:        HealthActive,
+			ResourceType: "service",
+			ResourceName: "web",
+			Namespace:    "default",
+			Port:         "8080",
+			LocalPort:    9000,
+		}, health)
+
+		em.updateEntryState(entry, false)
+		code, health = getHealth(t, healthPort)
+		t.CheckDeepEqual(http.StatusServiceUnavailable, code)
+		t.CheckDeepEqual(HealthDegraded, health.State)
+
+		em.Terminate(entry)
+		_, err := http.Get(fmt.Sprintf("http://127.0.0.1:%d/", healthPort))
+		t.CheckError(true, err)
+	})
+
+	testutil.Run(t, "disabled by default", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "web",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "", "", "", "", 9000, false))
+
+		t.CheckDeepEqual(0, em.ActiveEntries()[0].HealthPort)
+	})
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+)
+
+const (
+	hostsAliasesBegin = "# BEGIN skaffold port forwards"
+	hostsAliasesEnd   = "# END skaffold port forwards"
+)
+
+// HostsFile returns the path of the system's hosts file.
+func HostsFile() string {
+	if runtime.GOOS == constants.Windows {
+		return filepath.Join(os.Getenv("SystemRoot"), "System32", "drivers", "etc", "hosts")
+	}
+	return "/etc/hosts"
+}
+
+// hostsAliasesBackup is the suffix of the copy of the hosts file saved before it's first edited.
+const hostsAliasesBackup = ".skaffold.bak"
+
+// hostsAliases registers `<port name>.<pod name>.local` aliases of port forwards in a hosts file.
+// The aliases are kept between markers, so that they can be told apart from the user's own entries
+// and removed even if a previous run didn't clean them up.
+type hostsAliases struct {
+	path     string
+	aliases  map[string]string
+	backedUp bool
+	lock     sync.Mutex
+}
+
+// newHostsAliases checks that the hosts file can be edited, and removes the aliases left over by a previous run.
+func newHostsAliases(path string) (*hostsAliases, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, hostsFileErr(path, err)
+	}
+	// The file is replaced when it's edited, which requires creating files next to it.
+	f, err := ioutil.TempFile(filepath.Dir(path), ".hosts-skaffold-")
+	if err != nil {
+		return nil, hostsFileErr(path, err)
+	}
+	f.Close()
+	os.Remove(f.Name())
+
+	h := &hostsAliases{
+		path:    path,
+		aliases: map[string]string{},
+	}
+	if err := h.write(); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+func hostsFileErr(path string, err error) error {
+	if os.IsPermission(err) {
+		return fmt.Errorf("editing %s requires administrator privileges: %w", path, err)
+	}
+	return fmt.Errorf("editing %s: %w", path, err)
+}
+
+// hostAlias returns the alias of an entry, or "" for entries that aren't named ports of pods.
+func hostAlias(entry *portForwardEntry) string {
+	if entry.portName == "" || entry.podName == "" {
+		return ""
+	}
+	return strings.ToLower(fmt.Sprintf("%s.%s.local", entry.portName, entry.podName))
+}
+
+// add registers an alias of the given address.
+func (h *hostsAliases) add(alias, address string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.aliases[alias] = address
+	return h.write()
+}
+
+// remove unregisters an alias.
+func (h *hostsAliases) remove(alias string) error {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	if _, found := h.aliases[alias]; !found {
+		return nil
+	}
+	delete(h.aliases, alias)
+	return h.write()
+}
+
+// write replaces the aliases in the hosts file with the registered ones.
+// The original file is saved next to it before it's first edited.
+func (h *hostsAliases) write() error {
+	info, err := os.Stat(h.path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", h.path, err)
+	}
+	content, err := ioutil.ReadFile(h.path)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", h.path, err)
+	}
+
+	var lines []string
+	if len(content) > 0 {
+		inBlock := false
+		for _, line := range strings.Split(strings.TrimSuffix(string(content), "\n"), "\n") {
+			switch {
+			case line == hostsAliasesBegin:
+				inBlock = true
+			case line == hostsAliasesEnd:
+				inBlock = false
+			case !inBlock:
+				lines = append(lines, line)
+			}
+		}
+	}
+
+	if len(h.aliases) > 0 {
+		var aliases []string
+		for alias := range h.aliases {
+			aliases = append(aliases, alias)
+		}
+		sort.Strings(aliases)
+
+		lines = append(lines, hostsAliasesBegin)
+		for _, alias := range aliases {
+			lines = append(lines, fmt.Sprintf("%s\t%s", h.aliases[alias], alias))
+		}
+		lines = append(lines, hostsAliasesEnd)
+	}
+
+	var updated string
+	if len(lines) > 0 {
+		updated = strings.Join(lines, "\n") + "\n"
+	}
+	if updated == string(content) {
+		return nil
+	}
+
+	if !h.backedUp {
+		if err := replaceFile(h.path+hostsAliasesBackup, content, info.Mode()); err != nil {
+			return hostsFileErr(h.path, err)
+		}
+		h.backedUp = true
+	}
+	if err := replaceFile(h.path, []byte(updated), info.Mode()); err != nil {
+		return hostsFileErr(h.path, err)
+	}
+	return nil
+}
+
+// replaceFile atomically replaces the content of a file, so that it's never seen half written,
+// by writing a temp file in the same directory and renaming it over the file.
+func replaceFile(path string, content []byte, mode os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), ".hosts-skaffold-")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(content); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(f.Name(), mode.Perm()); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
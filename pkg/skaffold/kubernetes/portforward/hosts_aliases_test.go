@@ -0,0 +1,144 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+const hosts = "127.0.0.1\tlocalhost\n::1\tlocalhost\n"
+
+func TestHostsAliases(t *testing.T) {
+	testutil.Run(t, "aliases are added and removed", func(t *testutil.T) {
+		path := t.NewTempDir().Write("hosts", hosts).Path("hosts")
+
+		h, err := newHostsAliases(path)
+		t.CheckNoError(err)
+
+		t.CheckNoError(h.add("http.web-0.local", "127.0.0.1"))
+		t.CheckNoError(h.add("debug.api-7d9f.local", "127.0.0.1"))
+		checkHostsFile(t, hosts+
+			"# BEGIN skaffold port forwards\n"+
+			"127.0.0.1\tdebug.api-7d9f.local\n"+
+			"127.0.0.1\thttp.web-0.local\n"+
+			"# END skaffold port forwards\n", path)
+
+		t.CheckNoError(h.remove("debug.api-7d9f.local"))
+		t.CheckNoError(h.remove("http.web-0.local"))
+		checkHostsFile(t, hosts, path)
+	})
+
+	testutil.Run(t, "aliases left over by a previous run are removed", func(t *testutil.T) {
+		path := t.NewTempDir().Write("hosts", hosts+
+			"# BEGIN skaffold port forwards\n"+
+			"127.0.0.1\thttp.web-0.local\n"+
+			"# END skaffold port forwards\n"+
+			"10.0.0.1\tdb.internal\n").Path("hosts")
+
+		_, err := newHostsAliases(path)
+
+		t.CheckNoError(err)
+		checkHostsFile(t, hosts+"10.0.0.1\tdb.internal\n", path)
+	})
+
+	testutil.Run(t, "the original hosts file is backed up and replaced", func(t *testutil.T) {
+		tmpDir := t.NewTempDir().Write("hosts", hosts)
+		path := tmpDir.Path("hosts")
+		t.CheckNoError(os.Chmod(path, 0644))
+
+		h, err := newHostsAliases(path)
+		t.CheckNoError(err)
+		t.CheckNoError(h.add("http.web-0.local", "127.0.0.1"))
+		t.CheckNoError(h.add("debug.api-7d9f.local", "127.0.0.1"))
+
+		checkHostsFile(t, hosts, path+".skaffold.bak")
+		info, err := os.Stat(path)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(os.FileMode(0644), info.Mode().Perm())
+		files, err := ioutil.ReadDir(tmpDir.Root())
+		t.CheckNoError(err)
+		t.CheckDeepEqual(2, len(files))
+	})
+
+	testutil.Run(t, "unchanged hosts file isn't backed up", func(t *testutil.T) {
+		path := t.NewTempDir().Write("hosts", hosts).Path("hosts")
+
+		_, err := newHostsAliases(path)
+
+		t.CheckNoError(err)
+		_, err = os.Stat(path + ".skaffold.bak")
+		t.CheckTrue(os.IsNotExist(err))
+	})
+
+	testutil.Run(t, "missing hosts file", func(t *testutil.T) {
+		_, err := newHostsAliases(t.NewTempDir().Path("hosts"))
+
+		t.CheckErrorContains("editing", err)
+	})
+}
+
+func TestForwardWithHostsAliases(t *testing.T) {
+	testutil.Run(t, "the alias of a pod port is registered while it's forwarded", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		path := t.NewTempDir().Write("hosts", hosts).Path("hosts")
+
+		em := NewEntryManager(newTestForwarder())
+		t.CheckNoError(em.EnableHostsAliases(path))
+
+		pod := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "Web-0",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   util.Loopback,
+		}, "Web-0", "app", "http", "web", 9000, true)
+		unnamed := newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "web",
+			Namespace: "default",
+			Port:      schemautil.FromInt(80),
+		}, "", "", "", "", 9001, false)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pod)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, unnamed)
+
+		checkHostsFile(t, hosts+
+			"# BEGIN skaffold port forwards\n"+
+			"127.0.0.1\thttp.web-0.local\n"+
+			"# END skaffold port forwards\n", path)
+		t.CheckDeepEqual("http.web-0.local", em.ActiveEntries()[0].HostAlias)
+
+		em.Stop()
+		checkHostsFile(t, hosts, path)
+	})
+}
+
+func checkHostsFile(t *testutil.T, expected, path string) {
+	t.Helper()
+	content, err := ioutil.ReadFile(path)
+	t.CheckNoError(err)
+	t.CheckDeepEqual(expected, string(content))
+}
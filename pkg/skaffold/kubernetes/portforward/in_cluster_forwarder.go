@@ -0,0 +1,254 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	typedcorev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+)
+
+const (
+	// InClusterForwardType is the type of the entries forwarded by the InClusterForwarder.
+	InClusterForwardType = "in-cluster"
+
+	// inClusterPortName is the name of the port of the Services created for in-cluster forwards.
+	inClusterPortName = "forward"
+)
+
+// invalidServiceNameChars matches the characters not allowed in Service names.
+var invalidServiceNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+// InClusterForwarder forwards entries from within the cluster, for Skaffold running in a pod
+// where loopback ports can't be reached by users. Instead of binding a local port, it creates
+// a Service that exposes the entry's local port: for pods, with Endpoints pointing at the
+// forwarded pod, and for services, with the selector of the forwarded service, so that it
+// keeps reaching its pods as they're replaced.
+type InClusterForwarder struct{}
+
+// NewInClusterForwarder returns a new InClusterForwarder
+func NewInClusterForwarder() *InClusterForwarder {
+	return &InClusterForwarder{}
+}
+
+func (f *InClusterForwarder) Start(io.Writer) {}
+
+// Forward creates or updates the Service, and Endpoints for pods, reaching the entry in the cluster of its kube-context.
+func (f *InClusterForwarder) Forward(ctx context.Context, pfe *portForwardEntry) error {
+	client, err := kubernetesclient.ContextClient(pfe.kubeContext)
+	if err != nil {
+		return fmt.Errorf("forwarding %v in cluster: %w", pfe, err)
+	}
+
+	target, err := inClusterTargetOf(ctx, client.CoreV1(), pfe)
+	if err != nil {
+		return fmt.Errorf("forwarding %v in cluster: %w", pfe, err)
+	}
+
+	ns := pfe.resource.Namespace
+	name := inClusterServiceName(pfe)
+	meta := metav1.ObjectMeta{
+		Name:      name,
+		Namespace: ns,
+		Labels: map[string]string{
+			"app.kubernetes.io/managed-by": "skaffold",
+			"skaffold.dev/forward-type":    InClusterForwardType,
+		},
+	}
+	svc := &corev1.Service{
+		ObjectMeta: meta,
+		Spec: corev1.ServiceSpec{
+			// without selector, the Endpoints below point at the forwarded pod only
+			Selector: target.selector,
+			Ports: []corev1.ServicePort{{
+				Name:       inClusterPortName,
+				Port:       int32(pfe.localPort),
+				TargetPort: target.port,
+			}},
+		},
+	}
+
+	services := client.CoreV1().Services(ns)
+	if existing, err := services.Get(ctx, name, metav1.GetOptions{}); err == nil {
+		existing.Spec.Selector = svc.Spec.Selector
+		existing.Spec.Ports = svc.Spec.Ports
+		_, err = services.Update(ctx, existing, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating service %s/%s: %w", ns, name, err)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("getting service %s/%s: %w", ns, name, err)
+	} else if _, err := services.Create(ctx, svc, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating service %s/%s: %w", ns, name, err)
+	}
+
+	if target.selector == nil {
+		if err := updateInClusterEndpoints(ctx, client.CoreV1(), meta, target); err != nil {
+			return err
+		}
+	}
+
+	pfe.forwardType = InClusterForwardType
+	pfe.inClusterAddress = fmt.Sprintf("%s.%s.svc:%d", name, ns, pfe.localPort)
+	return nil
+}
+
+// updateInClusterEndpoints creates or updates the Endpoints of a Service without selector, pointing at the forwarded pod.
+func updateInClusterEndpoints(ctx context.Context, client typedcorev1.CoreV1Interface, meta metav1.ObjectMeta, target inClusterTarget) error {
+	ns, name := meta.Namespace, meta.Name
+	endpoints := &corev1.Endpoints{
+		ObjectMeta: meta,
+		Subsets: []corev1.EndpointSubset{{
+			Addresses: []corev1.EndpointAddress{{IP: target.podIP}},
+			Ports:     []corev1.EndpointPort{{Name: inClusterPortName, Port: target.port.IntVal}},
+		}},
+	}
+
+	allEndpoints := client.Endpoints(ns)
+	if existing, err := allEndpoints.Get(ctx, name, metav1.GetOptions{}); err == nil {
+		existing.Subsets = endpoints.Subsets
+		_, err = allEndpoints.Update(ctx, existing, metav1.UpdateOptions{})
+		if err != nil {
+			return fmt.Errorf("updating endpoints %s/%s: %w", ns, name, err)
+		}
+	} else if !apierrors.IsNotFound(err) {
+		return fmt.Errorf("getting endpoints %s/%s: %w", ns, name, err)
+	} else if _, err := allEndpoints.Create(ctx, endpoints, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("creating endpoints %s/%s: %w", ns, name, err)
+	}
+	return nil
+}
+
+// inClusterTarget is what the Service of an in-cluster forward points at: either the pods
+// matching a selector, or a single pod, by IP, through Endpoints.
+type inClusterTarget struct {
+	selector map[string]string
+	podIP    string
+	port     intstr.IntOrString
+}
+
+// inClusterTargetOf returns what the Service of the entry points at.
+func inClusterTargetOf(ctx context.Context, client typedcorev1.CoreV1Interface, pfe *portForwardEntry) (inClusterTarget, error) {
+	switch strings.ToLower(string(pfe.resource.Type)) {
+	case strings.ToLower(string(constants.Pod)):
+		return inClusterPodTarget(ctx, client, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
+	case strings.ToLower(string(constants.Service)):
+		return inClusterServiceTarget(ctx, client, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
+	default:
+		return inClusterTarget{}, fmt.Errorf("only pods and services can be forwarded in cluster, not %s", pfe.resource.Type)
+	}
+}
+
+// inClusterPodTarget returns the IP and port of a pod.
+func inClusterPodTarget(ctx context.Context, client typedcorev1.CoreV1Interface, ns, podName string, port schemautil.IntOrString) (inClusterTarget, error) {
+	pod, err := client.Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return inClusterTarget{}, fmt.Errorf("getting pod %s/%s: %w", ns, podName, err)
+	}
+	if pod.Status.PodIP == "" {
+		return inClusterTarget{}, fmt.Errorf("pod %s/%s has no IP yet", ns, podName)
+	}
+
+	if port.Type == schemautil.Int {
+		return inClusterTarget{podIP: pod.Status.PodIP, port: intstr.FromInt(port.IntVal)}, nil
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return inClusterTarget{podIP: pod.Status.PodIP, port: intstr.FromInt(int(p.ContainerPort))}, nil
+			}
+		}
+	}
+	return inClusterTarget{}, fmt.Errorf("pod %s/%s has no port named %q", ns, podName, port.StrVal)
+}
+
+// inClusterServiceTarget returns the selector and target port of a service port.
+func inClusterServiceTarget(ctx context.Context, client typedcorev1.CoreV1Interface, ns, svcName string, port schemautil.IntOrString) (inClusterTarget, error) {
+	svc, err := client.Services(ns).Get(ctx, svcName, metav1.GetOptions{})
+	if err != nil {
+		return inClusterTarget{}, fmt.Errorf("getting service %s/%s: %w", ns, svcName, err)
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return inClusterTarget{}, fmt.Errorf("service %s/%s has no selector", ns, svcName)
+	}
+
+	for _, p := range svc.Spec.Ports {
+		if (port.Type == schemautil.Int && int(p.Port) == port.IntVal) || (port.Type == schemautil.String && p.Name == port.StrVal) {
+			targetPort := p.TargetPort
+			if targetPort.Type == intstr.Int && targetPort.IntVal == 0 {
+				// the target port defaults to the port
+				targetPort = intstr.FromInt(int(p.Port))
+			}
+			return inClusterTarget{selector: svc.Spec.Selector, port: targetPort}, nil
+		}
+	}
+	return inClusterTarget{}, fmt.Errorf("service %s/%s has no port %s", ns, svcName, port.String())
+}
+
+// probe doesn't check anything: in-cluster entries have no local port, and are ready once their Service is.
+func (f *InClusterForwarder) probe(*portForwardEntry) error {
+	return nil
+}
+
+// Terminate deletes the Service and Endpoints of the entry.
+func (f *InClusterForwarder) Terminate(pfe *portForwardEntry) {
+	client, err := kubernetesclient.ContextClient(pfe.kubeContext)
+	if err != nil {
+		logrus.Debugf("terminating in-cluster forward %v: %v", pfe, err)
+		return
+	}
+
+	ctx := context.Background()
+	ns, name := pfe.resource.Namespace, inClusterServiceName(pfe)
+	if err := client.CoreV1().Services(ns).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		logrus.Warnf("deleting service %s/%s: %v", ns, name, err)
+	}
+	if err := client.CoreV1().Endpoints(ns).Delete(ctx, name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		logrus.Debugf("deleting endpoints %s/%s: %v", ns, name, err)
+	}
+}
+
+// inClusterServiceName returns a valid Service name, stable for the entry across pod restarts.
+func inClusterServiceName(pfe *portForwardEntry) string {
+	h := fnv.New32a()
+	h.Write([]byte(pfe.key()))
+
+	base := pfe.resource.Name
+	if pfe.automaticPodForwarding && pfe.ownerReference != "" {
+		// pod names change when pods are replaced, their owner doesn't
+		base = pfe.ownerReference
+	}
+	name := invalidServiceNameChars.ReplaceAllString(strings.ToLower(base), "-")
+	if len(name) > 40 {
+		name = name[:40]
+	}
+	return fmt.Sprintf("skaffold-%s-%08x", strings.Trim(name, "-"), h.Sum32())
+}
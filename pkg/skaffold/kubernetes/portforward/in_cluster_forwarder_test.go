@@ -0,0 +1,185 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes"
+	fakekubeclientset "k8s.io/client-go/kubernetes/fake"
+
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestInClusterForwarder(t *testing.T) {
+	testutil.Run(t, "create, update and delete the Service of an entry", func(t *testutil.T) {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app-7d4f", Namespace: "default"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "app",
+				Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{PodIP: "10.0.0.12"},
+		}
+		client := fakekubeclientset.NewSimpleClientset(pod)
+		t.Override(&kubernetesclient.Client, mockClient(client))
+
+		resource := latestV1.PortForwardResource{Type: "pod", Name: "app-7d4f", Namespace: "default", Port: schemautil.FromString("http")}
+		pfe := newPortForwardEntry(0, resource, "app-7d4f", "app", "http", "deployment-app", 9000, true)
+		forwarder := NewInClusterForwarder()
+		name := inClusterServiceName(pfe)
+
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		// Forwarding again, eg. after a restart, updates the existing objects
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+
+		svc, err := client.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]v1.ServicePort{{Name: "forward", Port: 9000, TargetPort: intstr.FromInt(8080)}}, svc.Spec.Ports)
+		t.CheckDeepEqual(map[string]string(nil), svc.Spec.Selector)
+		endpoints, err := client.CoreV1().Endpoints("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]v1.EndpointSubset{{
+			Addresses: []v1.EndpointAddress{{IP: "10.0.0.12"}},
+			Ports:     []v1.EndpointPort{{Name: "forward", Port: 8080}},
+		}}, endpoints.Subsets)
+
+		snapshot := pfe.snapshot(true)
+		t.CheckDeepEqual(InClusterForwardType, snapshot.Type)
+		t.CheckDeepEqual(name+".default.svc:9000", snapshot.InClusterAddress)
+
+		forwarder.Terminate(pfe)
+		_, err = client.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckError(true, err)
+		_, err = client.CoreV1().Endpoints("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckError(true, err)
+		// Terminating twice is harmless
+		forwarder.Terminate(pfe)
+	})
+
+	testutil.Run(t, "services are forwarded with their selector", func(t *testutil.T) {
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default"},
+			Spec: v1.ServiceSpec{
+				Selector: map[string]string{"app": "web"},
+				Ports: []v1.ServicePort{
+					{Name: "http", Port: 80, TargetPort: intstr.FromString("http")},
+					{Name: "metrics", Port: 9090},
+				},
+			},
+		}
+		client := fakekubeclientset.NewSimpleClientset(svc)
+		t.Override(&kubernetesclient.Client, mockClient(client))
+
+		for port, expected := range map[int]intstr.IntOrString{80: intstr.FromString("http"), 9090: intstr.FromInt(9090)} {
+			resource := latestV1.PortForwardResource{Type: "service", Name: "web", Namespace: "default", Port: schemautil.FromInt(port)}
+			pfe := newPortForwardEntry(0, resource, "", "", "", "", 9000+port, false)
+			name := inClusterServiceName(pfe)
+
+			t.CheckNoError(NewInClusterForwarder().Forward(context.Background(), pfe))
+
+			forwarded, err := client.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+			t.CheckNoError(err)
+			t.CheckDeepEqual(map[string]string{"app": "web"}, forwarded.Spec.Selector)
+			t.CheckDeepEqual([]v1.ServicePort{{Name: "forward", Port: int32(9000 + port), TargetPort: expected}}, forwarded.Spec.Ports)
+			// the Endpoints are managed by the cluster, and follow the pods of the service
+			_, err = client.CoreV1().Endpoints("default").Get(context.Background(), name, metav1.GetOptions{})
+			t.CheckError(true, err)
+		}
+	})
+
+	testutil.Run(t, "objects are created in the cluster of the entry's kube-context", func(t *testutil.T) {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			Status:     v1.PodStatus{PodIP: "10.0.0.12"},
+		}
+		current := fakekubeclientset.NewSimpleClientset()
+		staging := fakekubeclientset.NewSimpleClientset(pod)
+		t.Override(&kubernetesclient.ContextClient, func(kubeContext string) (kubernetes.Interface, error) {
+			if kubeContext == "staging" {
+				return staging, nil
+			}
+			return current, nil
+		})
+
+		resource := latestV1.PortForwardResource{Type: "pod", Name: "app", Namespace: "default", Port: schemautil.FromInt(8080)}
+		pfe := newPortForwardEntry(0, resource, "app", "app", "", "", 9000, false)
+		pfe.kubeContext = "staging"
+		name := inClusterServiceName(pfe)
+
+		t.CheckNoError(NewInClusterForwarder().Forward(context.Background(), pfe))
+		_, err := staging.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckNoError(err)
+		_, err = current.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckError(true, err)
+
+		NewInClusterForwarder().Terminate(pfe)
+		_, err = staging.CoreV1().Services("default").Get(context.Background(), name, metav1.GetOptions{})
+		t.CheckError(true, err)
+	})
+
+	testutil.Run(t, "service without selector", func(t *testutil.T) {
+		svc := &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "external", Namespace: "default"},
+			Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Port: 80}}},
+		}
+		t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset(svc)))
+
+		resource := latestV1.PortForwardResource{Type: "service", Name: "external", Namespace: "default", Port: schemautil.FromInt(80)}
+		pfe := newPortForwardEntry(0, resource, "", "", "", "", 9000, false)
+
+		err := NewInClusterForwarder().Forward(context.Background(), pfe)
+		t.CheckErrorContains("has no selector", err)
+	})
+
+	testutil.Run(t, "pod without IP", func(t *testutil.T) {
+		pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"}}
+		t.Override(&kubernetesclient.Client, mockClient(fakekubeclientset.NewSimpleClientset(pod)))
+
+		resource := latestV1.PortForwardResource{Type: "pod", Name: "app", Namespace: "default", Port: schemautil.FromInt(8080)}
+		pfe := newPortForwardEntry(0, resource, "app", "app", "", "", 9000, false)
+
+		err := NewInClusterForwarder().Forward(context.Background(), pfe)
+		t.CheckErrorContains("has no IP yet", err)
+	})
+}
+
+func TestInClusterServiceName(t *testing.T) {
+	resource := latestV1.PortForwardResource{Type: "pod", Name: "Very.Long_Pod.Name-that-does-not-fit-in-a-service-name", Namespace: "default", Port: schemautil.FromInt(8080)}
+	pfe := newPortForwardEntry(0, resource, "", "", "", "", 9000, false)
+	name := inClusterServiceName(pfe)
+
+	testutil.CheckDeepEqual(t, true, len(name) <= 63)
+	testutil.CheckDeepEqual(t, true, strings.HasPrefix(name, "skaffold-very-long-pod-name-that-does-not-fit-in-"))
+	testutil.CheckDeepEqual(t, name, inClusterServiceName(pfe))
+
+	// Pods replaced by their owner keep the same Service
+	resource.Name = "app-5f6c"
+	restarted := newPortForwardEntry(0, resource, "", "", "", "deployment-app", 9000, true)
+	resource.Name = "app-9b2a"
+	replacement := newPortForwardEntry(0, resource, "", "", "", "deployment-app", 9000, true)
+	testutil.CheckDeepEqual(t, true, strings.HasPrefix(inClusterServiceName(restarted), "skaffold-deployment-app-"))
+	testutil.CheckDeepEqual(t, inClusterServiceName(restarted), inClusterServiceName(replacement))
+}
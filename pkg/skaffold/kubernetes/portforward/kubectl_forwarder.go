@@ -20,12 +20,16 @@ import (
 	"bufio"
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
 	"sort"
 	"strings"
+	"sync"
 	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -34,9 +38,9 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/intstr"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
 )
@@ -47,16 +51,42 @@ type EntryForwarder interface {
 	Terminate(p *portForwardEntry)
 }
 
+// entryStateReporter is implemented by forwarders that keep an entry alive in the background
+// and can therefore report its tunnel coming up or going down after Forward has returned.
+type entryStateReporter interface {
+	reportEntryState(func(pfe *portForwardEntry, ready bool))
+}
+
+// entryDrainer is implemented by forwarders that can let in-flight connections
+// finish before entries are terminated.
+type entryDrainer interface {
+	drain(entries []*portForwardEntry)
+}
+
 type KubectlForwarder struct {
-	started int32
-	out     io.Writer
-	kubectl *kubectl.CLI
+	started       int32
+	out           io.Writer
+	kubectl       *kubectl.CLI
+	onStateChange func(pfe *portForwardEntry, ready bool)
+	writeMessage  func(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string)
+
+	// drainTimeout, when set, makes Skaffold own the local port through a connectionProxy
+	// so that active connections get this long to finish when port forwarding stops.
+	drainTimeout time.Duration
+
+	// proxy is the HTTP CONNECT proxy kubectl goes through to reach the cluster.
+	proxy clusterProxy
+
+	// listenerOptions configures the local ports listened on by connection proxies.
+	listenerOptions ListenerOptions
 }
 
 // NewKubectlForwarder returns a new KubectlForwarder
-func NewKubectlForwarder(cli *kubectl.CLI) *KubectlForwarder {
+func NewKubectlForwarder(cli *kubectl.CLI, options config.PortForwardOptions) *KubectlForwarder {
 	return &KubectlForwarder{
-		kubectl: cli,
+		kubectl:      cli,
+		drainTimeout: options.DrainTimeout,
+		proxy:        clusterProxy(options.Proxy),
 	}
 }
 
@@ -69,9 +99,36 @@ var (
 	waitErrorLogs       = 1 * time.Second
 )
 
+func (k *KubectlForwarder) reportEntryState(onStateChange func(pfe *portForwardEntry, ready bool)) {
+	k.onStateChange = onStateChange
+}
+
+func (k *KubectlForwarder) reportMessages(writeMessage func(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string)) {
+	k.writeMessage = writeMessage
+}
+
+// message writes a message about an entry, as formatted by the entry manager if any.
+func (k *KubectlForwarder) message(msgType ForwardMessageType, pfe *portForwardEntry, text string) {
+	if k.writeMessage != nil {
+		k.writeMessage(k.out, pfe, msgType, text)
+		return
+	}
+	messageColor(msgType).Fprintln(k.out, text)
+}
+
+func (k *KubectlForwarder) stateChanged(pfe *portForwardEntry, ready bool) {
+	if k.onStateChange != nil {
+		k.onStateChange(pfe, ready)
+	}
+}
+
 func (k *KubectlForwarder) Start(out io.Writer) {
 	atomic.StoreInt32(&k.started, 1)
 	k.out = out
+
+	if proxy := k.proxy.inUse(); proxy != "" {
+		logrus.Infof("Port forwarding through proxy %s", redactProxy(proxy))
+	}
 }
 
 // Forward port-forwards a pod using kubectl port-forward in the background
@@ -100,30 +157,60 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 			errChan <- nil
 			return
 		}
+		proxyErr := k.ensureProxy(pfe)
 		pfe.terminationLock.Unlock()
 
-		if !isPortFree(util.Loopback, pfe.localPort) {
+		if proxyErr != nil && !isAddressInUse(proxyErr) {
+			// waiting won't help, eg. the capture file can't be created
+			logrus.Debugf("port forwarding %v failed: %v", pfe, proxyErr)
+			k.stateChanged(pfe, false)
+			select {
+			case errChan <- fmt.Errorf("port forwarding %v failed: %w", pfe, proxyErr):
+			default:
+			}
+			return
+		}
+		if proxyErr != nil || (pfe.proxy == nil && !isPortFree(util.Loopback, pfe.localPort)) {
 			// Assuming that Skaffold brokered ports don't overlap, this has to be an external process that started
 			// since the dev loop kicked off. We are notifying the user in the hope that they can fix it
-			output.Red.Fprintf(k.out, "failed to port forward %v, port %d is taken, retrying...\n", pfe, pfe.localPort)
+			text := fmt.Sprintf("failed to port forward %v, port %d is taken, retrying...", pfe, pfe.localPort)
+			if proxyErr != nil {
+				text = fmt.Sprintf("failed to port forward %v: %v, retrying...", pfe, proxyErr)
+			}
+			k.message(MessageRetrying, pfe, text)
+			k.stateChanged(pfe, false)
 			notifiedUser = true
 			time.Sleep(waitPortNotFree)
 			continue
 		}
 
 		if notifiedUser {
-			output.Green.Fprintf(k.out, "port forwarding %v recovered on port %d\n", pfe, pfe.localPort)
+			k.message(MessageRecovered, pfe, fmt.Sprintf("port forwarding %v recovered on port %d", pfe, pfe.localPort))
 			notifiedUser = false
 		}
 
+		localPort, address := pfe.localPort, pfe.resource.Address
+		if pfe.proxy != nil {
+			// kubectl binds an internal port and the proxy relays connections to it
+			port, err := freeLoopbackPort()
+			if err != nil {
+				logrus.Debugf("port forwarding %v: %v", pfe, err)
+				time.Sleep(500 * time.Millisecond)
+				continue
+			}
+			pfe.proxy.setTarget(port)
+			localPort, address = port, util.Loopback
+		}
+
 		ctx, cancel := context.WithCancel(parentCtx)
 		pfe.cancel = cancel
 
-		args := portForwardArgs(ctx, pfe)
+		args := portForwardArgs(ctx, pfe, localPort, address)
 		var buf bytes.Buffer
 		cmd := k.kubectl.CommandWithStrictCancellation(ctx, "port-forward", args...)
 		cmd.Stdout = &buf
 		cmd.Stderr = &buf
+		cmd.Env = k.proxy.env()
 
 		logrus.Debugf("Running command: %s", cmd.Args)
 		if err := cmd.Start(); err != nil {
@@ -146,6 +233,7 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 			}
 			// To make sure that the log monitor gets cleared up
 			cancel()
+			k.stateChanged(pfe, false)
 
 			s := buf.String()
 			logrus.Debugf("port forwarding %v got terminated: %s, output: %s", pfe, err, s)
@@ -160,27 +248,112 @@ func (k *KubectlForwarder) forward(parentCtx context.Context, pfe *portForwardEn
 	}
 }
 
-func portForwardArgs(ctx context.Context, pfe *portForwardEntry) []string {
+// isAddressInUse returns true if listening failed because the address is already bound.
+func isAddressInUse(err error) bool {
+	return errors.Is(err, syscall.EADDRINUSE) || strings.Contains(err.Error(), "address already in use")
+}
+
+// ensureProxy starts listening on the entry's local port when connections are proxied.
+// It must be called with the entry's terminationLock held.
+func (k *KubectlForwarder) ensureProxy(pfe *portForwardEntry) error {
+	if !k.proxied(pfe) || pfe.proxy != nil {
+		return nil
+	}
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	if pfe.resource.TerminateTLS && pfe.tlsConfig == nil {
+		logrus.Warnf("Terminating TLS for %v on local port %d: the certificate of the resource is not verified", pfe, pfe.localPort)
+		pfe.tlsConfig = pfe.newTLSConfig()
+	}
+	var capture *trafficCapture
+	if pfe.resource.CaptureFile != "" {
+		path, err := filepath.Abs(pfe.resource.CaptureFile)
+		if err != nil {
+			return err
+		}
+		if capture, err = newTrafficCapture(path, maxCaptureSize); err != nil {
+			return err
+		}
+		logrus.Infof("Recording the traffic of %v to %s", pfe, path)
+		pfe.capturePath = path
+	}
+	proxy, err := newConnectionProxy(address, pfe.localPort, proxyOptions{
+		keepAlive:      time.Duration(pfe.resource.KeepAliveSeconds) * time.Second,
+		maxConnections: pfe.resource.MaxConnections,
+		tls:            pfe.tlsConfig,
+		capture:        capture,
+		listener:       k.listenerOptions,
+	})
+	if err != nil {
+		if capture != nil {
+			capture.close()
+		}
+		return err
+	}
+	pfe.proxy = proxy
+	return nil
+}
+
+func (k *KubectlForwarder) setListenerOptions(options ListenerOptions) {
+	k.listenerOptions = options
+}
+
+// proxied returns true if the entry's connections go through a connectionProxy
+// rather than straight to the port bound by kubectl.
+func (k *KubectlForwarder) proxied(pfe *portForwardEntry) bool {
+	return k.drainTimeout > 0 || pfe.resource.KeepAliveSeconds > 0 || pfe.resource.MaxConnections > 0 || pfe.resource.TerminateTLS ||
+		pfe.resource.CaptureFile != ""
+}
+
+// drain stops accepting connections on the entries' local ports and waits
+// up to the drain timeout for active connections to finish.
+func (k *KubectlForwarder) drain(entries []*portForwardEntry) {
+	var wg sync.WaitGroup
+	for _, pfe := range entries {
+		pfe.terminationLock.Lock()
+		proxy := pfe.proxy
+		pfe.terminationLock.Unlock()
+		if proxy == nil {
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			proxy.shutdown(k.drainTimeout)
+		}()
+	}
+	wg.Wait()
+}
+
+// portForwardArgs returns the arguments for kubectl to forward the entry from the given local port and address.
+func portForwardArgs(ctx context.Context, pfe *portForwardEntry, localPort int, address string) []string {
 	args := []string{"--pod-running-timeout", "1s", "--namespace", pfe.resource.Namespace}
+	if pfe.kubeContext != "" {
+		// overrides the current kube-context, given earlier on the command line
+		args = append(args, "--context", pfe.kubeContext)
+	}
 
 	_, disableServiceForwarding := os.LookupEnv("SKAFFOLD_DISABLE_SERVICE_FORWARDING")
 	switch {
 	case pfe.resource.Type == "service" && !disableServiceForwarding:
 		// Services need special handling: https://github.com/GoogleContainerTools/skaffold/issues/4522
-		podName, remotePort, err := findNewestPodForSvc(ctx, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
+		podName, remotePort, err := findNewestPodForSvc(ctx, pfe.kubeContext, pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port)
 		if err == nil {
-			args = append(args, fmt.Sprintf("pod/%s", podName), fmt.Sprintf("%d:%d", pfe.localPort, remotePort))
+			args = append(args, fmt.Sprintf("pod/%s", podName), fmt.Sprintf("%d:%d", localPort, remotePort))
 			break
 		}
 		logrus.Warnf("could not map pods to service %s/%s/%s: %v", pfe.resource.Namespace, pfe.resource.Name, pfe.resource.Port.String(), err)
 		fallthrough // and let kubectl try to handle it
 
 	default:
-		args = append(args, fmt.Sprintf("%s/%s", pfe.resource.Type, pfe.resource.Name), fmt.Sprintf("%d:%s", pfe.localPort, pfe.resource.Port.String()))
+		args = append(args, fmt.Sprintf("%s/%s", pfe.resource.Type, pfe.resource.Name), fmt.Sprintf("%d:%s", localPort, pfe.resource.Port.String()))
 	}
 
-	if pfe.resource.Address != "" && pfe.resource.Address != util.Loopback {
-		args = append(args, []string{"--address", pfe.resource.Address}...)
+	if address != "" && address != util.Loopback {
+		args = append(args, []string{"--address", address}...)
 	}
 	return args
 }
@@ -195,13 +368,16 @@ func (*KubectlForwarder) Terminate(p *portForwardEntry) {
 	if p.cancel != nil {
 		p.cancel()
 	}
+	if p.proxy != nil {
+		p.proxy.shutdown(0)
+	}
 	p.terminated = true
 }
 
 // Monitor monitors the logs for a kubectl port forward command
 // If it sees an error, it calls back to the EntryManager to
 // retry the entire port forward operation.
-func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *kubectl.Cmd, p *portForwardEntry, err chan error) {
+func (k *KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *kubectl.Cmd, p *portForwardEntry, err chan error) {
 	ticker := time.NewTicker(waitErrorLogs)
 	defer ticker.Stop()
 
@@ -226,12 +402,14 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 				if err := cmd.Terminate(); err != nil {
 					logrus.Tracef("failed to kill port forwarding %v, err: %s", p, err)
 				}
+				k.stateChanged(p, false)
 				select {
 				case err <- fmt.Errorf("port forwarding %v got terminated: output: %s", p, s):
 				default:
 				}
 				return
 			} else if strings.Contains(s, "Forwarding from") {
+				k.stateChanged(p, true)
 				select {
 				case err <- nil:
 				default:
@@ -244,8 +422,9 @@ func (*KubectlForwarder) monitorLogs(ctx context.Context, logs io.Reader, cmd *k
 // findNewestPodForService queries the cluster to find a pod that fulfills the given service, giving
 // preference to pods that were most recently created.  This is in contrast to the selection algorithm
 // used by kubectl (see https://github.com/GoogleContainerTools/skaffold/issues/4522 for details).
-func findNewestPodForService(ctx context.Context, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
-	client, err := kubernetesclient.Client()
+// The service is looked up in the given kube-context, "" being the current one.
+func findNewestPodForService(ctx context.Context, kubeContext, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
+	client, err := kubernetesclient.ContextClient(kubeContext)
 	if err != nil {
 		return "", -1, fmt.Errorf("getting Kubernetes client: %w", err)
 	}
@@ -21,10 +21,14 @@ import (
 	"context"
 	"errors"
 	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
 	"runtime"
 	"sort"
 	"strings"
 	"sync"
+	"syscall"
 	"testing"
 	"time"
 
@@ -87,6 +91,41 @@ func TestUnavailablePort(t *testing.T) {
 	})
 }
 
+func TestForwardFailsOnProxyError(t *testing.T) {
+	testutil.Run(t, "the capture file can't be created", func(t *testutil.T) {
+		t.Override(&waitPortNotFree, 10*time.Millisecond)
+		blocker := filepath.Join(t.TempDir(), "blocker")
+		t.CheckNoError(ioutil.WriteFile(blocker, nil, 0644))
+
+		var buf bytes.Buffer
+		k := KubectlForwarder{out: &buf}
+		pfe := newPortForwardEntry(0, latestV1.PortForwardResource{CaptureFile: filepath.Join(blocker, "traffic.pcap")}, "", "", "", "", 8080, false)
+
+		k.Start(&buf)
+		err := k.Forward(context.Background(), pfe)
+
+		t.CheckErrorContains("creating capture directory", err)
+		t.CheckFalse(strings.Contains(buf.String(), "retrying"))
+	})
+}
+
+func TestIsAddressInUse(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    bool
+	}{
+		{description: "listen error", err: &net.OpError{Op: "listen", Err: os.NewSyscallError("bind", syscall.EADDRINUSE)}, expected: true},
+		{description: "message", err: errors.New("listen tcp 127.0.0.1:8080: bind: address already in use"), expected: true},
+		{description: "other error", err: errors.New("opening capture file: permission denied")},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, isAddressInUse(test.err))
+		})
+	}
+}
+
 func TestTerminate(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 
@@ -103,6 +142,52 @@ func TestTerminate(t *testing.T) {
 	}
 }
 
+func TestProxied(t *testing.T) {
+	tests := []struct {
+		description  string
+		drainTimeout time.Duration
+		resource     latestV1.PortForwardResource
+		expected     bool
+	}{
+		{
+			description: "direct by default",
+		},
+		{
+			description:  "drain timeout",
+			drainTimeout: time.Second,
+			expected:     true,
+		},
+		{
+			description: "keepalive",
+			resource:    latestV1.PortForwardResource{KeepAliveSeconds: 30},
+			expected:    true,
+		},
+		{
+			description: "connection limit",
+			resource:    latestV1.PortForwardResource{MaxConnections: 10},
+			expected:    true,
+		},
+		{
+			description: "TLS termination",
+			resource:    latestV1.PortForwardResource{TerminateTLS: true},
+			expected:    true,
+		},
+		{
+			description: "traffic capture",
+			resource:    latestV1.PortForwardResource{CaptureFile: "app.cap"},
+			expected:    true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			k := &KubectlForwarder{drainTimeout: test.drainTimeout}
+			pfe := newPortForwardEntry(0, test.resource, "", "", "", "", 8080, false)
+
+			t.CheckDeepEqual(test.expected, k.proxied(pfe))
+		})
+	}
+}
+
 func TestMonitorErrorLogs(t *testing.T) {
 	if runtime.GOOS == "windows" {
 		t.Skip("skip flaky test until it's fixed")
@@ -215,6 +300,15 @@ func TestPortForwardArgs(t *testing.T) {
 			input:       newPortForwardEntry(0, latestV1.PortForwardResource{Type: "pod", Name: "p", Namespace: "ns", Port: schemautil.FromInt(9)}, "", "", "", "", 8080, false),
 			result:      []string{"--pod-running-timeout", "1s", "--namespace", "ns", "pod/p", "8080:9"},
 		},
+		{
+			description: "pod of another kube-context",
+			input: func() *portForwardEntry {
+				pfe := newPortForwardEntry(0, latestV1.PortForwardResource{Type: "pod", Name: "p", Namespace: "ns", Port: schemautil.FromInt(9)}, "", "", "", "", 8080, true)
+				pfe.kubeContext = "cluster-b"
+				return pfe
+			}(),
+			result: []string{"--pod-running-timeout", "1s", "--namespace", "ns", "--context", "cluster-b", "pod/p", "8080:9"},
+		},
 		{
 			description: "service to pod",
 			input:       newPortForwardEntry(0, latestV1.PortForwardResource{Type: "service", Name: "svc", Namespace: "ns", Port: schemautil.FromInt(9)}, "", "", "", "", 8080, false),
@@ -235,11 +329,11 @@ func TestPortForwardArgs(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 			defer cancel()
 
-			t.Override(&findNewestPodForSvc, func(ctx context.Context, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
+			t.Override(&findNewestPodForSvc, func(ctx context.Context, kubeContext, ns, serviceName string, servicePort schemautil.IntOrString) (string, int, error) {
 				return test.servicePod, test.servicePort, test.serviceErr
 			})
 
-			args := portForwardArgs(ctx, test.input)
+			args := portForwardArgs(ctx, test.input, test.input.localPort, test.input.resource.Address)
 			t.CheckDeepEqual(test.result, args)
 		})
 	}
@@ -420,7 +514,7 @@ func TestFindNewestPodForService(t *testing.T) {
 				return fake.NewSimpleClientset(test.clientResources...), test.clientErr
 			})
 
-			pod, port, err := findNewestPodForService(ctx, "", test.serviceName, schemautil.FromInt(test.servicePort))
+			pod, port, err := findNewestPodForService(ctx, "", "", test.serviceName, schemautil.FromInt(test.servicePort))
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPod, pod)
 			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.chosenPort, port)
 		})
@@ -0,0 +1,64 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+	"syscall"
+)
+
+// ListenerOptions configures the sockets Skaffold listens on for the local ports of port forwards.
+type ListenerOptions struct {
+	// ReuseAddr sets SO_REUSEADDR, so that a local port can be bound again right after a restart,
+	// while the connections of the previous listener linger in TIME_WAIT. It's ignored on Windows,
+	// where it would let other sockets bind the same port.
+	ReuseAddr bool
+	// Backlog is the maximum number of connections waiting to be accepted.
+	// Zero leaves the system default.
+	Backlog int
+}
+
+// defaultListenerOptions are the options of port forward listeners, unless set with EntryManager.SetListenerOptions.
+var defaultListenerOptions = ListenerOptions{ReuseAddr: true}
+
+// listenerConfigurer is implemented by forwarders that listen on the local ports of entries themselves.
+type listenerConfigurer interface {
+	setListenerOptions(ListenerOptions)
+}
+
+// listen starts listening on the given address and port with the given options.
+func listen(address string, port int, options ListenerOptions) (net.Listener, error) {
+	lc := net.ListenConfig{
+		Control: func(_, _ string, c syscall.RawConn) error {
+			return setReuseAddr(c, options.ReuseAddr)
+		},
+	}
+	l, err := lc.Listen(context.Background(), "tcp", net.JoinHostPort(address, strconv.Itoa(port)))
+	if err != nil {
+		return nil, err
+	}
+	if options.Backlog > 0 {
+		if err := setBacklog(l, options.Backlog); err != nil {
+			l.Close()
+			return nil, fmt.Errorf("setting the backlog of %s: %w", l.Addr(), err)
+		}
+	}
+	return l, nil
+}
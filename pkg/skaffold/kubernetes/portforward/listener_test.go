@@ -0,0 +1,73 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"io"
+	"net"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// closedPortInTimeWait returns a port whose listener was closed after closing a connection
+// from its own end, which leaves the connection in TIME_WAIT.
+func closedPortInTimeWait(t *testutil.T) int {
+	l, err := listen(util.Loopback, 0, defaultListenerOptions)
+	t.CheckNoError(err)
+	port := l.Addr().(*net.TCPAddr).Port
+
+	client, err := net.Dial("tcp", l.Addr().String())
+	t.CheckNoError(err)
+	server, err := l.Accept()
+	t.CheckNoError(err)
+	server.Close()
+	_, err = client.Read(make([]byte, 1))
+	t.CheckTrue(err == io.EOF)
+	client.Close()
+	l.Close()
+	// let the server end receive the client's FIN
+	time.Sleep(50 * time.Millisecond)
+	return port
+}
+
+func TestListenRecentlyClosedPort(t *testing.T) {
+	testutil.Run(t, "rebinding with SO_REUSEADDR", func(t *testutil.T) {
+		port := closedPortInTimeWait(t)
+
+		l, err := listen(util.Loopback, port, ListenerOptions{ReuseAddr: true, Backlog: 16})
+		t.CheckNoError(err)
+		defer l.Close()
+
+		conn, err := net.Dial("tcp", l.Addr().String())
+		t.CheckNoError(err)
+		conn.Close()
+	})
+
+	testutil.Run(t, "rebinding without SO_REUSEADDR", func(t *testutil.T) {
+		if runtime.GOOS != "linux" {
+			t.Skip("only Linux refuses to bind ports with connections in TIME_WAIT")
+		}
+		port := closedPortInTimeWait(t)
+
+		_, err := listen(util.Loopback, port, ListenerOptions{})
+		t.CheckErrorContains("address already in use", err)
+	})
+}
@@ -0,0 +1,57 @@
+// +build !windows
+
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"net"
+	"syscall"
+)
+
+func setReuseAddr(c syscall.RawConn, reuse bool) error {
+	value := 0
+	if reuse {
+		value = 1
+	}
+	var serr error
+	if err := c.Control(func(fd uintptr) {
+		serr = syscall.SetsockoptInt(int(fd), syscall.SOL_SOCKET, syscall.SO_REUSEADDR, value)
+	}); err != nil {
+		return err
+	}
+	return serr
+}
+
+// setBacklog calls listen(2) again on the listening socket, which updates its backlog.
+func setBacklog(l net.Listener, backlog int) error {
+	tl, ok := l.(*net.TCPListener)
+	if !ok {
+		return nil
+	}
+	c, err := tl.SyscallConn()
+	if err != nil {
+		return err
+	}
+	var serr error
+	if err := c.Control(func(fd uintptr) {
+		serr = syscall.Listen(int(fd), backlog)
+	}); err != nil {
+		return err
+	}
+	return serr
+}
@@ -0,0 +1,32 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"net"
+	"syscall"
+)
+
+// setReuseAddr never sets SO_REUSEADDR: on Windows, it lets other sockets bind the port while it's listened on.
+func setReuseAddr(syscall.RawConn, bool) error {
+	return nil
+}
+
+// setBacklog leaves the backlog untouched: listening again on a listening socket doesn't change it on Windows.
+func setBacklog(net.Listener, int) error {
+	return nil
+}
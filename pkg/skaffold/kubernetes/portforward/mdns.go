@@ -0,0 +1,199 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/mdns"
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// mdnsServiceType is the DNS-SD service type of the advertised port forwards.
+const mdnsServiceType = "_http._tcp"
+
+var (
+	// for testing
+	newMDNSServer = func(zone mdns.Zone) (mdnsServer, error) {
+		return mdns.NewServer(&mdns.Config{Zone: zone})
+	}
+	interfaceAddrs = net.InterfaceAddrs
+)
+
+// mdnsServer answers the mDNS queries about a zone.
+type mdnsServer interface {
+	Shutdown() error
+}
+
+// mdnsZone is the zone of the advertised port forwards, each served as `<instance>._http._tcp.local.`
+// by `<instance>.local.` on the port forward's address and local port.
+type mdnsZone struct {
+	services map[browserEntry]*mdns.MDNSService
+	lock     sync.Mutex
+}
+
+// AdvertiseOverMDNS advertises each port forward that looks like HTTP as an `_http._tcp` service over mDNS,
+// once its tunnel is ready, so that it shows up in the service discovery tools of the local network, until the
+// returned function is called. Advertisements are withdrawn when port forwards are removed, and when it's stopped.
+// Nothing is advertised when mDNS isn't available, eg. when the mDNS port can't be bound.
+// Port forwards bound to the loopback interface, like they are by default, are not advertised,
+// since other hosts can't reach them.
+func (b *EntryManager) AdvertiseOverMDNS() (stop func()) {
+	zone := &mdnsZone{services: map[browserEntry]*mdns.MDNSService{}}
+	server, err := newMDNSServer(zone)
+	if err != nil {
+		logrus.Warnf("not advertising port forwards over mDNS: %v", err)
+		return func() {}
+	}
+
+	changes := b.Subscribe()
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for change := range changes {
+			entry := change.Entry
+			key := browserEntry{entry.Resource.Type, entry.Resource.Namespace, entry.Resource.Name, entry.LocalPort}
+			switch {
+			case change.Type == ForwardRemoved:
+				zone.remove(key)
+			case change.Type == ForwardReady && isHTTPForward(entry):
+				zone.add(key, entry)
+			}
+		}
+		server.Shutdown()
+	}()
+	return func() {
+		b.Unsubscribe(changes)
+		<-done
+	}
+}
+
+func newMDNSService(entry PortForwardEntry) (*mdns.MDNSService, error) {
+	ip, err := advertisedIP(entry.Resource.Address)
+	if err != nil {
+		return nil, err
+	}
+	label := mdnsLabel(fmt.Sprintf("%s-%s-%s", entry.Resource.Name, entry.Resource.Namespace, entry.Resource.Port.String()))
+	return mdns.NewMDNSService(label, mdnsServiceType, "local.", label+".local.", entry.LocalPort, []net.IP{ip}, []string{
+		"path=/",
+		"namespace=" + entry.Resource.Namespace,
+		fmt.Sprintf("resource=%s/%s", strings.ToLower(string(entry.Resource.Type)), entry.Resource.Name),
+	})
+}
+
+// advertisedIP returns the IPv4 address that other hosts reach a port forward bound to the given address on.
+// Port forwards bound to all interfaces are advertised on the address of the first interface other hosts can reach.
+func advertisedIP(address string) (net.IP, error) {
+	if address == "" {
+		address = util.Loopback
+	}
+	ip := net.ParseIP(address)
+	switch {
+	case ip == nil:
+		return nil, fmt.Errorf("%q is not an IP address", address)
+	case ip.IsLoopback():
+		return nil, fmt.Errorf("other hosts can't reach loopback address %s", ip)
+	case ip.IsUnspecified():
+		return reachableInterfaceIP()
+	case ip.To4() == nil:
+		return nil, fmt.Errorf("%s is not an IPv4 address", ip)
+	}
+	return ip.To4(), nil
+}
+
+// reachableInterfaceIP returns the IPv4 address of the first network interface that isn't a loopback.
+func reachableInterfaceIP() (net.IP, error) {
+	addrs, err := interfaceAddrs()
+	if err != nil {
+		return nil, fmt.Errorf("listing the addresses of network interfaces: %w", err)
+	}
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok {
+			continue
+		}
+		if ip := ipNet.IP.To4(); ip != nil && !ip.IsLoopback() && !ip.IsUnspecified() {
+			return ip, nil
+		}
+	}
+	return nil, errors.New("no network interface has an IPv4 address other hosts can reach")
+}
+
+// mdnsLabel turns a name into a DNS label of at most 63 letters, digits and hyphens.
+func mdnsLabel(name string) string {
+	label := strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' {
+			return r
+		}
+		return '-'
+	}, name)
+	if len(label) > 63 {
+		label = label[:63]
+	}
+	return label
+}
+
+// add advertises a port forward, unless it already is, eg. when its tunnel is re-established.
+func (z *mdnsZone) add(key browserEntry, entry PortForwardEntry) {
+	z.lock.Lock()
+	defer z.lock.Unlock()
+
+	if _, found := z.services[key]; found {
+		return
+	}
+	s, err := newMDNSService(entry)
+	if err != nil {
+		logrus.Debugf("not advertising port forward %s over mDNS: %v", entry.summary(), err)
+		return
+	}
+	z.services[key] = s
+	logrus.Debugf("advertising port forward %s over mDNS as %s.%s.%s", entry.summary(), s.Instance, s.Service, s.Domain)
+}
+
+// remove withdraws the advertisement of a port forward, if any.
+func (z *mdnsZone) remove(key browserEntry) {
+	z.lock.Lock()
+	defer z.lock.Unlock()
+
+	delete(z.services, key)
+}
+
+// Records returns the records of the advertised port forwards that answer a question.
+// The records shared by port forwards, eg. the enumeration of the service type, are returned once.
+func (z *mdnsZone) Records(q dns.Question) []dns.RR {
+	z.lock.Lock()
+	defer z.lock.Unlock()
+
+	var records []dns.RR
+	seen := map[string]bool{}
+	for _, s := range z.services {
+		for _, record := range s.Records(q) {
+			if !seen[record.String()] {
+				seen[record.String()] = true
+				records = append(records, record)
+			}
+		}
+	}
+	return records
+}
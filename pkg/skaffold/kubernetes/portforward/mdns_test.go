@@ -0,0 +1,255 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/mdns"
+	"github.com/miekg/dns"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+// fakeMDNSServer records the zone it serves, and whether it was shut down.
+type fakeMDNSServer struct {
+	zone     mdns.Zone
+	shutdown bool
+	lock     sync.Mutex
+}
+
+func (s *fakeMDNSServer) Shutdown() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.shutdown = true
+	return nil
+}
+
+func (s *fakeMDNSServer) isShutdown() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.shutdown
+}
+
+// browse returns the records answering a query for the HTTP services, once there are `count` of them.
+func (s *fakeMDNSServer) browse(count int) []string {
+	question := dns.Question{Name: "_http._tcp.local.", Qtype: dns.TypePTR, Qclass: dns.ClassINET}
+	wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+		return len(s.zone.Records(question)) == count, nil
+	})
+	return describe(s.zone.Records(question))
+}
+
+// describe summarizes records as `name type ttl value`, with the last TXT string as value.
+func describe(records []dns.RR) []string {
+	var described []string
+	for _, r := range records {
+		var value string
+		switch record := r.(type) {
+		case *dns.PTR:
+			value = record.Ptr
+		case *dns.SRV:
+			value = fmt.Sprintf("%s:%d", record.Target, record.Port)
+		case *dns.TXT:
+			value = record.Txt[len(record.Txt)-1]
+		case *dns.A:
+			value = record.A.String()
+		}
+		described = append(described, fmt.Sprintf("%s %s %d %s", r.Header().Name, dns.TypeToString[r.Header().Rrtype], r.Header().Ttl, value))
+	}
+	sort.Strings(described)
+	return described
+}
+
+func TestAdvertiseOverMDNS(t *testing.T) {
+	web := func(address string) *portForwardEntry {
+		return newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "web-0",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   address,
+		}, "web-0", "app", "http", "web", 9000, true)
+	}
+	db := newPortForwardEntry(0, latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      "db",
+		Namespace: "default",
+		Port:      schemautil.FromInt(5432),
+		Address:   "192.168.1.20",
+	}, "", "", "", "", 9001, false)
+
+	testutil.Run(t, "HTTP port forwards are advertised while they're forwarded", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		server := &fakeMDNSServer{}
+		t.Override(&newMDNSServer, func(zone mdns.Zone) (mdnsServer, error) {
+			server.zone = zone
+			return server, nil
+		})
+
+		em := NewEntryManager(newTestForwarder())
+		stop := em.AdvertiseOverMDNS()
+		entry := web("192.168.1.20")
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, db)
+
+		t.CheckDeepEqual([]string{
+			"_http._tcp.local. PTR 120 web-0-default-8080._http._tcp.local.",
+			"web-0-default-8080._http._tcp.local. SRV 120 web-0-default-8080.local.:9000",
+			"web-0-default-8080._http._tcp.local. TXT 120 resource=pod/web-0",
+			"web-0-default-8080.local. A 120 192.168.1.20",
+		}, server.browse(4))
+
+		em.Terminate(entry)
+		t.CheckDeepEqual([]string(nil), server.browse(0))
+
+		stop()
+		t.CheckTrue(server.isShutdown())
+	})
+
+	testutil.Run(t, "port forwards bound to the default loopback address aren't advertised", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		server := &fakeMDNSServer{}
+		t.Override(&newMDNSServer, func(zone mdns.Zone) (mdnsServer, error) {
+			server.zone = zone
+			return server, nil
+		})
+
+		t.Override(&interfaceAddrs, func() ([]net.Addr, error) {
+			return []net.Addr{&net.IPNet{IP: net.ParseIP("192.168.1.20"), Mask: net.CIDRMask(24, 32)}}, nil
+		})
+
+		em := NewEntryManager(newTestForwarder())
+		stop := em.AdvertiseOverMDNS()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, web(""))
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "web-1",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   "0.0.0.0",
+		}, "web-1", "app", "http", "api", 9001, true))
+
+		t.CheckDeepEqual([]string{
+			"_http._tcp.local. PTR 120 web-1-default-8080._http._tcp.local.",
+			"web-1-default-8080._http._tcp.local. SRV 120 web-1-default-8080.local.:9001",
+			"web-1-default-8080._http._tcp.local. TXT 120 resource=pod/web-1",
+			"web-1-default-8080.local. A 120 192.168.1.20",
+		}, server.browse(4))
+		stop()
+	})
+
+	testutil.Run(t, "the server is shut down when stopped", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		server := &fakeMDNSServer{}
+		t.Override(&newMDNSServer, func(zone mdns.Zone) (mdnsServer, error) {
+			server.zone = zone
+			return server, nil
+		})
+
+		em := NewEntryManager(newTestForwarder())
+		stop := em.AdvertiseOverMDNS()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, web("192.168.1.20"))
+		server.browse(4)
+
+		stop()
+		t.CheckTrue(server.isShutdown())
+	})
+
+	testutil.Run(t, "mDNS not available", func(t *testutil.T) {
+		t.Override(&newMDNSServer, func(mdns.Zone) (mdnsServer, error) { return nil, errors.New("no multicast listeners") })
+
+		stop := NewEntryManager(newTestForwarder()).AdvertiseOverMDNS()
+		stop()
+	})
+}
+
+func TestMDNSZone(t *testing.T) {
+	testutil.Run(t, "records shared by port forwards are answered once", func(t *testutil.T) {
+		zone := &mdnsZone{services: map[browserEntry]*mdns.MDNSService{}}
+		for i, name := range []string{"web-0", "web-1"} {
+			entry := PortForwardEntry{LocalPort: 9000 + i, Resource: latestV1.PortForwardResource{
+				Type:      constants.Pod,
+				Name:      name,
+				Namespace: "default",
+				Port:      schemautil.FromInt(8080),
+				Address:   "192.168.1.20",
+			}}
+			zone.add(browserEntry{entry.Resource.Type, entry.Resource.Namespace, entry.Resource.Name, entry.LocalPort}, entry)
+		}
+
+		records := zone.Records(dns.Question{Name: "_services._dns-sd._udp.local.", Qtype: dns.TypePTR, Qclass: dns.ClassINET})
+
+		t.CheckDeepEqual([]string{"_services._dns-sd._udp.local. PTR 120 _http._tcp.local."}, describe(records))
+	})
+}
+
+func TestAdvertisedIP(t *testing.T) {
+	lan := []net.Addr{
+		&net.IPNet{IP: net.ParseIP("127.0.0.1"), Mask: net.CIDRMask(8, 32)},
+		&net.IPNet{IP: net.ParseIP("fe80::1"), Mask: net.CIDRMask(64, 128)},
+		&net.IPNet{IP: net.ParseIP("192.168.1.20"), Mask: net.CIDRMask(24, 32)},
+	}
+	loopbackOnly := lan[:1]
+	tests := []struct {
+		description string
+		address     string
+		interfaces  []net.Addr
+		expected    string
+		shouldErr   bool
+	}{
+		{description: "interface address", address: "192.168.1.30", expected: "192.168.1.30"},
+		{description: "all interfaces", address: "0.0.0.0", interfaces: lan, expected: "192.168.1.20"},
+		{description: "all interfaces, IPv6", address: "::", interfaces: lan, expected: "192.168.1.20"},
+		{description: "all interfaces, only loopback", address: "0.0.0.0", interfaces: loopbackOnly, shouldErr: true},
+		{description: "loopback", address: "127.0.0.1", interfaces: lan, shouldErr: true},
+		{description: "default address", interfaces: lan, shouldErr: true},
+		{description: "IPv6 loopback", address: "::1", interfaces: lan, shouldErr: true},
+		{description: "IPv6 address", address: "2001:db8::1", interfaces: lan, shouldErr: true},
+		{description: "not an address", address: "localhost", interfaces: lan, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&interfaceAddrs, func() ([]net.Addr, error) { return test.interfaces, nil })
+
+			ip, err := advertisedIP(test.address)
+
+			t.CheckError(test.shouldErr, err)
+			if !test.shouldErr {
+				t.CheckDeepEqual(test.expected, ip.String())
+			}
+		})
+	}
+}
+
+func TestMDNSLabel(t *testing.T) {
+	testutil.CheckDeepEqual(t, "web-0-default-http", mdnsLabel("web-0.default/http"))
+	testutil.CheckDeepEqual(t, 63, len(mdnsLabel("a-very-long-deployment-name-0123456789-abcdefghij-klmnopqrstuvwxyz-default-8080")))
+}
@@ -0,0 +1,530 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/portforward"
+	"k8s.io/client-go/transport/spdy"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	kubernetesclient "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	kubectx "github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/context"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// For testing
+var (
+	dialPortForward = dialPodPortForward
+	multiplexTarget = podAndPortOf
+
+	// maxForwardsPerConnection is how many local connections are forwarded over a connection to a pod that can't
+	// remove the streams of the forwarded connections, before it's replaced. It keeps track of them until it's closed.
+	maxForwardsPerConnection = 500
+)
+
+// streamRemover is implemented by the connections that can stop tracking the streams of a forwarded connection once it's done.
+type streamRemover interface {
+	RemoveStreams(streams ...httpstream.Stream)
+}
+
+// MultiplexForwarder forwards all the ports of a pod over a single port-forward connection to the API server,
+// opening a stream on it for each local connection, where `kubectl port-forward` opens a connection per port.
+// Entries that can't be multiplexed, eg. deployments or entries whose connections are proxied by Skaffold,
+// are forwarded by the wrapped forwarder.
+type MultiplexForwarder struct {
+	forwarder       EntryForwarder
+	listenerOptions ListenerOptions
+	// dialer opens the connections to the pods.
+	dialer Dialer
+
+	lock sync.Mutex
+	// pods are the connections to the pods of multiplexed entries, by pod.
+	pods map[string]*podConnection
+	// entries are the multiplexed entries. The others are forwarded by the wrapped forwarder.
+	entries map[*portForwardEntry]*multiplexedEntry
+}
+
+// podConnection is the port-forward connection shared by the entries of a pod.
+type podConnection struct {
+	key         string
+	kubeContext string
+	namespace   string
+	pod         string
+	dialer      Dialer
+
+	lock          sync.Mutex
+	conn          httpstream.Connection
+	entries       int
+	nextRequestID int
+	// forwards is the number of local connections forwarded over conn so far.
+	forwards int
+	// inFlight are the local connections being forwarded, by connection to the pod.
+	// The replaced connections are closed once they're not forwarding any.
+	inFlight map[httpstream.Connection]int
+}
+
+// multiplexedEntry listens on the local port of an entry and forwards its connections to a port of the pod.
+type multiplexedEntry struct {
+	forwarder *MultiplexForwarder
+	ctx       context.Context
+	pfe       *portForwardEntry
+	listener  net.Listener
+
+	// lock guards the pod and port forwarded, which change when the pod backing a Service is replaced.
+	lock       sync.Mutex
+	pod        *podConnection
+	remotePort int
+	terminated bool
+}
+
+// NewMultiplexForwarder returns a forwarder multiplexing the ports of each pod over a single connection,
+// which falls back to `forwarder` for the entries it can't multiplex.
+func NewMultiplexForwarder(forwarder EntryForwarder, options config.PortForwardOptions) *MultiplexForwarder {
+	return &MultiplexForwarder{
+		forwarder: forwarder,
+		dialer:    ProxyDialer(options.Proxy),
+		pods:      map[string]*podConnection{},
+		entries:   map[*portForwardEntry]*multiplexedEntry{},
+	}
+}
+
+// SetDialer replaces the dialer of the connections to the pods, which goes through the configured proxy by default.
+// It must be called before Start.
+func (m *MultiplexForwarder) SetDialer(dialer Dialer) {
+	m.dialer = dialer
+}
+
+func (m *MultiplexForwarder) Start(out io.Writer) {
+	m.forwarder.Start(out)
+}
+
+// Forward listens on the entry's local port and forwards its connections over the connection to the entry's pod.
+func (m *MultiplexForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	if !multiplexable(pfe) {
+		return m.forwarder.Forward(parentCtx, pfe)
+	}
+
+	podName, remotePort, err := multiplexTarget(parentCtx, pfe)
+	if err != nil {
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	l, err := listen(address, pfe.localPort, m.listenerOptions)
+	if err != nil {
+		return fmt.Errorf("port forwarding %v: %w", pfe, err)
+	}
+
+	entry := &multiplexedEntry{
+		forwarder:  m,
+		ctx:        parentCtx,
+		pfe:        pfe,
+		listener:   l,
+		pod:        m.acquire(pfe.kubeContext, pfe.resource.Namespace, podName),
+		remotePort: remotePort,
+	}
+	m.lock.Lock()
+	m.entries[pfe] = entry
+	m.lock.Unlock()
+
+	go entry.serve()
+	return nil
+}
+
+// multiplexable returns true if the entry targets a single pod and its connections
+// don't need any of the features of the connection proxy.
+func multiplexable(pfe *portForwardEntry) bool {
+	switch strings.ToLower(string(pfe.resource.Type)) {
+	case strings.ToLower(string(constants.Pod)), strings.ToLower(string(constants.Service)):
+	default:
+		return false
+	}
+	return pfe.resource.KeepAliveSeconds == 0 && pfe.resource.MaxConnections == 0 && !pfe.resource.TerminateTLS &&
+		pfe.resource.CaptureFile == ""
+}
+
+// Terminate stops listening on the entry's local port and closes the connection to
+// its pod if no other entry forwards the pod.
+func (m *MultiplexForwarder) Terminate(pfe *portForwardEntry) {
+	m.lock.Lock()
+	entry, found := m.entries[pfe]
+	delete(m.entries, pfe)
+	m.lock.Unlock()
+	if !found {
+		m.forwarder.Terminate(pfe)
+		return
+	}
+
+	logrus.Debugf("Terminating port-forward %v", pfe)
+	entry.listener.Close()
+	entry.lock.Lock()
+	entry.terminated = true
+	pod := entry.pod
+	entry.lock.Unlock()
+	m.release(pod)
+}
+
+// acquire returns the connection to the given pod, shared with the other entries of the pod.
+func (m *MultiplexForwarder) acquire(kubeContext, namespace, pod string) *podConnection {
+	key := fmt.Sprintf("%s/%s/%s", kubeContext, namespace, pod)
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p, found := m.pods[key]
+	if !found {
+		p = &podConnection{key: key, kubeContext: kubeContext, namespace: namespace, pod: pod, dialer: m.dialer}
+		m.pods[key] = p
+	}
+	p.lock.Lock()
+	p.entries++
+	p.lock.Unlock()
+	return p
+}
+
+// release closes the connection to the pod once its last entry is terminated.
+func (m *MultiplexForwarder) release(p *podConnection) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.entries--
+	if p.entries > 0 {
+		return
+	}
+	delete(m.pods, p.key)
+	if p.conn != nil {
+		p.conn.Close()
+		p.conn = nil
+	}
+}
+
+// connections returns the number of open connections to the API server, for testing.
+func (m *MultiplexForwarder) connections() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	count := 0
+	for _, p := range m.pods {
+		p.lock.Lock()
+		if p.conn != nil {
+			count++
+		}
+		p.lock.Unlock()
+	}
+	return count
+}
+
+func (m *MultiplexForwarder) setListenerOptions(options ListenerOptions) {
+	m.listenerOptions = options
+	if c, ok := m.forwarder.(listenerConfigurer); ok {
+		c.setListenerOptions(options)
+	}
+}
+
+// reportEntryState relays the state changes of the entries forwarded by the wrapped forwarder.
+// Multiplexed entries are ready as soon as they are forwarded.
+func (m *MultiplexForwarder) reportEntryState(onStateChange func(pfe *portForwardEntry, ready bool)) {
+	if r, ok := m.forwarder.(entryStateReporter); ok {
+		r.reportEntryState(onStateChange)
+	}
+}
+
+func (m *MultiplexForwarder) reportMessages(writeMessage func(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string)) {
+	if r, ok := m.forwarder.(messageReporter); ok {
+		r.reportMessages(writeMessage)
+	}
+}
+
+func (m *MultiplexForwarder) drain(entries []*portForwardEntry) {
+	if d, ok := m.forwarder.(entryDrainer); ok {
+		d.drain(entries)
+	}
+}
+
+// serve forwards the connections accepted on the local port until it's closed.
+func (e *multiplexedEntry) serve() {
+	for {
+		conn, err := e.listener.Accept()
+		if err != nil {
+			return
+		}
+		go func() {
+			pod, remotePort := e.target()
+			pod.forward(conn, remotePort)
+		}()
+	}
+}
+
+// target returns the connection to the pod the entry forwards to, and the port of the pod.
+// The pod backing a Service is looked up again each time the connection has to be dialed,
+// as the pod forwarded so far may have been replaced.
+func (e *multiplexedEntry) target() (*podConnection, int) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	if e.terminated || !strings.EqualFold(string(e.pfe.resource.Type), string(constants.Service)) || e.pod.connected() {
+		return e.pod, e.remotePort
+	}
+	podName, remotePort, err := multiplexTarget(e.ctx, e.pfe)
+	if err != nil {
+		logrus.Debugf("looking up the pod of %v again: %v", e.pfe, err)
+		return e.pod, e.remotePort
+	}
+	if podName != e.pod.pod {
+		logrus.Debugf("port forwarding %v to pod %s/%s", e.pfe, e.pfe.resource.Namespace, podName)
+		previous := e.pod
+		e.pod = e.forwarder.acquire(e.pfe.kubeContext, e.pfe.resource.Namespace, podName)
+		e.forwarder.release(previous)
+	}
+	e.remotePort = remotePort
+	return e.pod, e.remotePort
+}
+
+// connected returns true if the connection to the pod is open.
+func (p *podConnection) connected() bool {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.conn == nil {
+		return false
+	}
+	select {
+	case <-p.conn.CloseChan():
+		return false
+	default:
+		return true
+	}
+}
+
+// connection returns the connection to the pod, dialing it again if it was lost.
+func (p *podConnection) connection() (httpstream.Connection, int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.entries == 0 {
+		return nil, 0, fmt.Errorf("port forwarding pod %s/%s was terminated", p.namespace, p.pod)
+	}
+	if p.conn != nil {
+		select {
+		case <-p.conn.CloseChan():
+			logrus.Debugf("port-forward connection to pod %s/%s was lost, reconnecting", p.namespace, p.pod)
+			p.conn = nil
+		default:
+		}
+	}
+	if p.conn != nil && p.forwards >= maxForwardsPerConnection {
+		if _, ok := p.conn.(streamRemover); !ok {
+			logrus.Debugf("replacing port-forward connection to pod %s/%s after %d connections", p.namespace, p.pod, p.forwards)
+			if p.inFlight[p.conn] == 0 {
+				p.conn.Close()
+			}
+			p.conn = nil
+		}
+	}
+	if p.conn == nil {
+		conn, err := p.dialer(p.kubeContext, p.namespace, p.pod)
+		if err != nil {
+			return nil, 0, err
+		}
+		p.conn = conn
+		p.forwards = 0
+	}
+	if p.inFlight == nil {
+		p.inFlight = map[httpstream.Connection]int{}
+	}
+	p.forwards++
+	p.inFlight[p.conn]++
+	p.nextRequestID++
+	return p.conn, p.nextRequestID, nil
+}
+
+// done records that a local connection isn't forwarded over a connection to the pod anymore,
+// and closes that connection if it was replaced and it's not forwarding any other.
+func (p *podConnection) done(conn httpstream.Connection) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.inFlight[conn]--
+	if p.inFlight[conn] > 0 {
+		return
+	}
+	delete(p.inFlight, conn)
+	if conn != p.conn {
+		conn.Close()
+	}
+}
+
+// removeStream stops tracking a stream of a forwarded connection once it's done, if the connection to the pod can.
+// Otherwise, the connection keeps track of it until it's closed.
+func removeStream(conn httpstream.Connection, stream httpstream.Stream) {
+	if r, ok := conn.(streamRemover); ok {
+		r.RemoveStreams(stream)
+	}
+}
+
+// forward copies data between a local connection and a new stream to the given port of the pod.
+func (p *podConnection) forward(conn net.Conn, remotePort int) {
+	defer conn.Close()
+
+	streamConn, requestID, err := p.connection()
+	if err != nil {
+		logrus.Debugf("connecting to pod %s/%s: %v", p.namespace, p.pod, err)
+		return
+	}
+	defer p.done(streamConn)
+
+	headers := http.Header{}
+	headers.Set(corev1.StreamType, corev1.StreamTypeError)
+	headers.Set(corev1.PortHeader, strconv.Itoa(remotePort))
+	headers.Set(corev1.PortForwardRequestIDHeader, strconv.Itoa(requestID))
+	errorStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		logrus.Debugf("creating error stream to port %d of pod %s/%s: %v", remotePort, p.namespace, p.pod, err)
+		return
+	}
+	// nothing is written to the error stream
+	errorStream.Close()
+	defer removeStream(streamConn, errorStream)
+
+	errChan := make(chan error, 1)
+	go func() {
+		message, err := ioutil.ReadAll(errorStream)
+		switch {
+		case err != nil:
+			errChan <- fmt.Errorf("reading error stream: %w", err)
+		case len(message) > 0:
+			errChan <- fmt.Errorf("%s", message)
+		}
+		close(errChan)
+	}()
+
+	headers.Set(corev1.StreamType, corev1.StreamTypeData)
+	dataStream, err := streamConn.CreateStream(headers)
+	if err != nil {
+		logrus.Debugf("creating data stream to port %d of pod %s/%s: %v", remotePort, p.namespace, p.pod, err)
+		return
+	}
+	defer removeStream(streamConn, dataStream)
+
+	remoteDone := make(chan struct{})
+	localDone := make(chan struct{})
+	go func() {
+		io.Copy(conn, dataStream)
+		close(remoteDone)
+	}()
+	go func() {
+		// tell the pod nothing more is sent once the local connection is done
+		defer dataStream.Close()
+		if _, err := io.Copy(dataStream, conn); err != nil {
+			close(localDone)
+		}
+	}()
+
+	select {
+	case <-remoteDone:
+	case <-localDone:
+	}
+	if err := <-errChan; err != nil {
+		logrus.Debugf("forwarding to port %d of pod %s/%s: %v", remotePort, p.namespace, p.pod, err)
+	}
+}
+
+// dialPodPortForward opens a port-forward connection to a pod through the API server, going through the proxy if any.
+func dialPodPortForward(proxy clusterProxy, kubeContext, namespace, pod string) (httpstream.Connection, error) {
+	config, err := kubectx.GetRestClientConfig()
+	if kubeContext != "" {
+		config, err = kubectx.GetRestClientConfigForContext(kubeContext)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting client config: %w", err)
+	}
+	if err := proxy.configure(config); err != nil {
+		return nil, err
+	}
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+	transport, upgrader, err := spdy.RoundTripperFor(config)
+	if err != nil {
+		return nil, err
+	}
+
+	url := client.CoreV1().RESTClient().Post().Resource("pods").Namespace(namespace).Name(pod).SubResource("portforward").URL()
+	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, http.MethodPost, url)
+	conn, _, err := dialer.Dial(portforward.PortForwardProtocolV1Name)
+	if err != nil {
+		return nil, fmt.Errorf("dialing port-forward connection to pod %s/%s: %w", namespace, pod, err)
+	}
+	return conn, nil
+}
+
+// podAndPortOf returns the pod and the port of the pod that the entry forwards to.
+func podAndPortOf(ctx context.Context, pfe *portForwardEntry) (string, int, error) {
+	ns := pfe.resource.Namespace
+	podName, port := pfe.resource.Name, pfe.resource.Port
+	if strings.EqualFold(string(pfe.resource.Type), string(constants.Service)) {
+		name, targetPort, err := findNewestPodForSvc(ctx, pfe.kubeContext, ns, pfe.resource.Name, pfe.resource.Port)
+		if err != nil {
+			return "", 0, err
+		}
+		podName, port = name, schemautil.FromInt(targetPort)
+	}
+	if port.Type == schemautil.Int {
+		return podName, port.IntVal, nil
+	}
+
+	client, err := kubernetesclient.ContextClient(pfe.kubeContext)
+	if err != nil {
+		return "", 0, err
+	}
+	pod, err := client.CoreV1().Pods(ns).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return "", 0, fmt.Errorf("getting pod %s/%s: %w", ns, podName, err)
+	}
+	for _, c := range pod.Spec.Containers {
+		for _, p := range c.Ports {
+			if p.Name == port.StrVal {
+				return podName, int(p.ContainerPort), nil
+			}
+		}
+	}
+	return "", 0, fmt.Errorf("pod %s/%s has no port named %q", ns, podName, port.StrVal)
+}
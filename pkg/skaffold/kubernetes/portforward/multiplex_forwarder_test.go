@@ -0,0 +1,495 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// fakePodConnection stands in for the API server: the data streams greet with the
+// forwarded port, then echo what they receive.
+type fakePodConnection struct {
+	lock   sync.Mutex
+	closed chan bool
+	// tracked is the number of streams created and not removed.
+	tracked int
+	// failDataStreams fails creating data streams.
+	failDataStreams bool
+}
+
+func (c *fakePodConnection) CreateStream(headers http.Header) (httpstream.Stream, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	select {
+	case <-c.closed:
+		return nil, fmt.Errorf("connection closed")
+	default:
+	}
+	if headers.Get(corev1.StreamType) == corev1.StreamTypeError {
+		client, server := net.Pipe()
+		server.Close()
+		c.tracked++
+		return &fakeStream{Conn: client, headers: headers}, nil
+	}
+	if c.failDataStreams {
+		return nil, fmt.Errorf("stream creation timed out")
+	}
+
+	c.tracked++
+	client, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		fmt.Fprintf(server, "%s\n", headers.Get(corev1.PortHeader))
+		io.Copy(server, server)
+	}()
+	return &fakeStream{Conn: client, headers: headers}, nil
+}
+
+func (c *fakePodConnection) Close() error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	select {
+	case <-c.closed:
+	default:
+		close(c.closed)
+	}
+	return nil
+}
+
+func (c *fakePodConnection) CloseChan() <-chan bool { return c.closed }
+
+func (c *fakePodConnection) isClosed() bool {
+	select {
+	case <-c.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func (c *fakePodConnection) trackedStreams() int {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	return c.tracked
+}
+
+// removingPodConnection is a fake connection that stops tracking the streams it's told to remove.
+type removingPodConnection struct {
+	*fakePodConnection
+}
+
+func (c *removingPodConnection) RemoveStreams(streams ...httpstream.Stream) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.tracked -= len(streams)
+}
+
+func (c *fakePodConnection) SetIdleTimeout(time.Duration) {}
+
+type fakeStream struct {
+	net.Conn
+	headers http.Header
+}
+
+func (s *fakeStream) Reset() error { return s.Close() }
+
+func (s *fakeStream) Headers() http.Header { return s.headers }
+
+func (s *fakeStream) Identifier() uint32 { return 0 }
+
+// fakeDialer records the port-forward connections dialed, by pod, and the proxy they go through.
+type fakeDialer struct {
+	lock  sync.Mutex
+	dials map[string]int
+	conns []*fakePodConnection
+	proxy clusterProxy
+	// removeStreams dials connections that can remove streams.
+	removeStreams bool
+	// failDataStreams dials connections that fail creating data streams.
+	failDataStreams bool
+}
+
+func (d *fakeDialer) dial(proxy clusterProxy, kubeContext, namespace, pod string) (httpstream.Connection, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.dials[namespace+"/"+pod]++
+	d.proxy = proxy
+	conn := &fakePodConnection{closed: make(chan bool), failDataStreams: d.failDataStreams}
+	d.conns = append(d.conns, conn)
+	if d.removeStreams {
+		return &removingPodConnection{conn}, nil
+	}
+	return conn, nil
+}
+
+func (d *fakeDialer) conn(i int) *fakePodConnection {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	return d.conns[i]
+}
+
+func (d *fakeDialer) count() int {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	count := 0
+	for _, n := range d.dials {
+		count += n
+	}
+	return count
+}
+
+func multiplexedEntryForPort(t testing.TB, pod string, port int) *portForwardEntry {
+	localPort, err := freeLoopbackPort()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resource := latestV1.PortForwardResource{Type: "pod", Name: pod, Namespace: "default", Port: schemautil.FromInt(port), Address: util.Loopback}
+	return newPortForwardEntry(0, resource, pod, "app", "", "", localPort, true)
+}
+
+// roundTrip opens a connection to the local port of the entry, reads the greeting and checks the echo.
+func roundTrip(pfe *portForwardEntry) (string, error) {
+	conn, err := net.Dial("tcp", net.JoinHostPort(util.Loopback, strconv.Itoa(pfe.localPort)))
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	greeting, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	fmt.Fprintln(conn, "ping")
+	echo, err := r.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	if echo != "ping\n" {
+		return "", fmt.Errorf("expected echo of ping, got %q", echo)
+	}
+	return greeting[:len(greeting)-1], nil
+}
+
+func TestMultiplexForwarder(t *testing.T) {
+	testutil.Run(t, "share a connection between the ports of a pod", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		t.Override(&dialPortForward, dialer.dial)
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{Proxy: "http://proxy.corp:3128"})
+
+		var entries []*portForwardEntry
+		for _, port := range []int{8080, 9090, 5005} {
+			pfe := multiplexedEntryForPort(t, "app", port)
+			t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+			entries = append(entries, pfe)
+		}
+		other := multiplexedEntryForPort(t, "db", 5432)
+		t.CheckNoError(forwarder.Forward(context.Background(), other))
+
+		for i, port := range []string{"8080", "9090", "5005"} {
+			for j := 0; j < 2; j++ {
+				greeting, err := roundTrip(entries[i])
+				t.CheckNoError(err)
+				t.CheckDeepEqual(port, greeting)
+			}
+		}
+		greeting, err := roundTrip(other)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("5432", greeting)
+
+		t.CheckDeepEqual(map[string]int{"default/app": 1, "default/db": 1}, dialer.dials)
+		t.CheckDeepEqual(clusterProxy("http://proxy.corp:3128"), dialer.proxy)
+		t.CheckDeepEqual(2, forwarder.connections())
+
+		// the connection to a pod is closed with the last of its entries
+		forwarder.Terminate(entries[0])
+		forwarder.Terminate(entries[1])
+		t.CheckDeepEqual(2, forwarder.connections())
+		forwarder.Terminate(entries[2])
+		t.CheckDeepEqual(1, forwarder.connections())
+		forwarder.Terminate(other)
+		t.CheckDeepEqual(0, forwarder.connections())
+		for _, conn := range dialer.conns {
+			select {
+			case <-conn.CloseChan():
+			default:
+				t.Errorf("connection wasn't closed")
+			}
+		}
+
+		_, err = roundTrip(entries[0])
+		t.CheckError(true, err)
+	})
+
+	testutil.Run(t, "connect with the injected dialer", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+		forwarder.SetDialer(func(kubeContext, namespace, pod string) (httpstream.Connection, error) {
+			return dialer.dial("", kubeContext, namespace, pod)
+		})
+
+		pfe := multiplexedEntryForPort(t, "app", 8080)
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		greeting, err := roundTrip(pfe)
+		forwarder.Terminate(pfe)
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual("8080", greeting)
+		t.CheckDeepEqual(map[string]int{"default/app": 1}, dialer.dials)
+	})
+
+	testutil.Run(t, "reconnect once the connection is lost", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		t.Override(&dialPortForward, dialer.dial)
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+
+		pfe := multiplexedEntryForPort(t, "app", 8080)
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		defer forwarder.Terminate(pfe)
+
+		_, err := roundTrip(pfe)
+		t.CheckNoError(err)
+		dialer.conns[0].Close()
+
+		greeting, err := roundTrip(pfe)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("8080", greeting)
+		t.CheckDeepEqual(2, dialer.count())
+	})
+
+	testutil.Run(t, "look up the pod backing a service again on reconnect", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		t.Override(&dialPortForward, dialer.dial)
+		var lock sync.Mutex
+		backingPod := "app-1"
+		var kubeContexts []string
+		t.Override(&findNewestPodForSvc, func(_ context.Context, kubeContext, _, _ string, _ schemautil.IntOrString) (string, int, error) {
+			lock.Lock()
+			defer lock.Unlock()
+			kubeContexts = append(kubeContexts, kubeContext)
+			return backingPod, 8080, nil
+		})
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+
+		pfe := multiplexedEntryForPort(t, "app", 80)
+		pfe.resource.Type = "service"
+		pfe.kubeContext = "staging"
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		defer forwarder.Terminate(pfe)
+
+		greeting, err := roundTrip(pfe)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("8080", greeting)
+
+		lock.Lock()
+		backingPod = "app-2"
+		lock.Unlock()
+		_, err = roundTrip(pfe)
+		t.CheckNoError(err)
+		// the connection to the first pod is still open
+		t.CheckDeepEqual(map[string]int{"default/app-1": 1}, dialer.dials)
+
+		dialer.conns[0].Close()
+		greeting, err = roundTrip(pfe)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("8080", greeting)
+		t.CheckDeepEqual(map[string]int{"default/app-1": 1, "default/app-2": 1}, dialer.dials)
+		t.CheckDeepEqual(1, forwarder.connections())
+		// once when forwarded, then each time the connection is dialed
+		t.CheckDeepEqual(3, len(kubeContexts))
+		for _, kubeContext := range kubeContexts {
+			t.CheckDeepEqual("staging", kubeContext)
+		}
+	})
+
+	testutil.Run(t, "remove the streams of forwarded connections", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}, removeStreams: true}
+		t.Override(&dialPortForward, dialer.dial)
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+
+		pfe := multiplexedEntryForPort(t, "app", 8080)
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		defer forwarder.Terminate(pfe)
+
+		for i := 0; i < 3; i++ {
+			_, err := roundTrip(pfe)
+			t.CheckNoError(err)
+		}
+		t.CheckNoError(wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return dialer.conn(0).trackedStreams() == 0, nil
+		}))
+		t.CheckDeepEqual(1, dialer.count())
+	})
+
+	testutil.Run(t, "remove the error stream when the data stream can't be created", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}, removeStreams: true, failDataStreams: true}
+		t.Override(&dialPortForward, dialer.dial)
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+
+		pfe := multiplexedEntryForPort(t, "app", 8080)
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		defer forwarder.Terminate(pfe)
+
+		_, err := roundTrip(pfe)
+		t.CheckError(true, err)
+		t.CheckNoError(wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return dialer.conn(0).trackedStreams() == 0, nil
+		}))
+	})
+
+	testutil.Run(t, "replace connections that can't remove streams", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		t.Override(&dialPortForward, dialer.dial)
+		t.Override(&maxForwardsPerConnection, 2)
+		forwarder := NewMultiplexForwarder(&echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}, config.PortForwardOptions{})
+
+		pfe := multiplexedEntryForPort(t, "app", 8080)
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		defer forwarder.Terminate(pfe)
+
+		for i := 0; i < 5; i++ {
+			greeting, err := roundTrip(pfe)
+			t.CheckNoError(err)
+			t.CheckDeepEqual("8080", greeting)
+		}
+		t.CheckDeepEqual(3, dialer.count())
+		// the replaced connections are closed once they're done forwarding
+		t.CheckNoError(wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return dialer.conn(0).isClosed() && dialer.conn(1).isClosed(), nil
+		}))
+		t.CheckFalse(dialer.conn(2).isClosed())
+		t.CheckDeepEqual(1, forwarder.connections())
+	})
+
+	testutil.Run(t, "fall back to the wrapped forwarder", func(t *testutil.T) {
+		dialer := &fakeDialer{dials: map[string]int{}}
+		t.Override(&dialPortForward, dialer.dial)
+		inner := &echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}
+		forwarder := NewMultiplexForwarder(inner, config.PortForwardOptions{})
+
+		deployment := multiplexedEntryForPort(t, "app", 8080)
+		deployment.resource.Type = "deployment"
+		captured := multiplexedEntryForPort(t, "app", 9090)
+		captured.resource.CaptureFile = "traffic.pcap"
+
+		for _, pfe := range []*portForwardEntry{deployment, captured} {
+			t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+			t.CheckDeepEqual(1, len(inner.listeners))
+			forwarder.Terminate(pfe)
+			t.CheckDeepEqual(0, len(inner.listeners))
+		}
+		t.CheckDeepEqual(0, dialer.count())
+	})
+}
+
+func TestMultiplexable(t *testing.T) {
+	tests := []struct {
+		description string
+		resource    latestV1.PortForwardResource
+		expected    bool
+	}{
+		{description: "pod", resource: latestV1.PortForwardResource{Type: "pod"}, expected: true},
+		{description: "service", resource: latestV1.PortForwardResource{Type: "Service"}, expected: true},
+		{description: "deployment", resource: latestV1.PortForwardResource{Type: "deployment"}},
+		{description: "keep-alive", resource: latestV1.PortForwardResource{Type: "pod", KeepAliveSeconds: 30}},
+		{description: "max connections", resource: latestV1.PortForwardResource{Type: "pod", MaxConnections: 2}},
+		{description: "TLS termination", resource: latestV1.PortForwardResource{Type: "pod", TerminateTLS: true}},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pfe := newPortForwardEntry(0, test.resource, "", "", "", "", 0, false)
+			t.CheckDeepEqual(test.expected, multiplexable(pfe))
+		})
+	}
+}
+
+// BenchmarkForwardPodPorts compares forwarding the ports of a pod over a connection each,
+// like one `kubectl port-forward` per port does, and over a single multiplexed connection.
+func BenchmarkForwardPodPorts(b *testing.B) {
+	const ports = 8
+
+	for _, bench := range []struct {
+		description string
+		multiplexed bool
+	}{
+		{description: "connection per port"},
+		{description: "multiplexed", multiplexed: true},
+	} {
+		b.Run(bench.description, func(b *testing.B) {
+			var dials int64
+			defer func(dial func(clusterProxy, string, string, string) (httpstream.Connection, error)) {
+				dialPortForward = dial
+			}(dialPortForward)
+			dialPortForward = func(clusterProxy, string, string, string) (httpstream.Connection, error) {
+				atomic.AddInt64(&dials, 1)
+				return &fakePodConnection{closed: make(chan bool)}, nil
+			}
+
+			for i := 0; i < b.N; i++ {
+				shared := NewMultiplexForwarder(nil, config.PortForwardOptions{})
+				entries := map[*portForwardEntry]*MultiplexForwarder{}
+				for port := 0; port < ports; port++ {
+					forwarder := shared
+					if !bench.multiplexed {
+						forwarder = NewMultiplexForwarder(nil, config.PortForwardOptions{})
+					}
+					pfe := multiplexedEntryForPort(b, "app", 8000+port)
+					if err := forwarder.Forward(context.Background(), pfe); err != nil {
+						b.Fatal(err)
+					}
+					entries[pfe] = forwarder
+				}
+				for pfe := range entries {
+					if _, err := roundTrip(pfe); err != nil {
+						b.Fatal(err)
+					}
+				}
+				for pfe, forwarder := range entries {
+					forwarder.Terminate(pfe)
+				}
+			}
+			b.ReportMetric(float64(atomic.LoadInt64(&dials))/float64(b.N), "connections/op")
+		})
+	}
+}
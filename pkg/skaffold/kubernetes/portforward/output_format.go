@@ -0,0 +1,168 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
+)
+
+// ForwardMessageType is the kind of a line written by port forwarding to its output.
+type ForwardMessageType string
+
+const (
+	// MessageEstablished is written once an entry is forwarded.
+	MessageEstablished ForwardMessageType = "established"
+	// MessageError is written when an entry can't be forwarded.
+	MessageError ForwardMessageType = "error"
+	// MessageTerminated is written when the forwards of a pod that's gone are stopped.
+	MessageTerminated ForwardMessageType = "terminated"
+	// MessageRetrying is written while the local port of an entry is taken by another process.
+	MessageRetrying ForwardMessageType = "retrying"
+	// MessageRecovered is written once an entry whose local port was taken is forwarded again.
+	MessageRecovered ForwardMessageType = "recovered"
+	// MessageLocalPort is written when a container port is forwarded to another local port.
+	MessageLocalPort ForwardMessageType = "localPort"
+	// MessageHostAlias is written when the alias of an entry is registered in the hosts file.
+	MessageHostAlias ForwardMessageType = "hostAlias"
+)
+
+// ForwardMessage is a line written by port forwarding to its output.
+type ForwardMessage struct {
+	Type ForwardMessageType
+	// Entry is the port forward the message is about.
+	Entry PortForwardEntry
+	// Err is why the entry can't be forwarded, for MessageError.
+	Err error
+	// Text is the human-readable message.
+	Text string
+}
+
+// OutputFormatter renders the messages of port forwarding, eg. for structured logging.
+// It returns the line to write, without its trailing newline, or "" to leave the message out.
+type OutputFormatter func(ForwardMessage) string
+
+// TextOutputFormatter renders the human-readable text of messages, without colors.
+func TextOutputFormatter(msg ForwardMessage) string {
+	return msg.Text
+}
+
+// jsonMessage is the JSON rendering of a message.
+type jsonMessage struct {
+	Type          ForwardMessageType `json:"type"`
+	ResourceType  string             `json:"resourceType"`
+	ResourceName  string             `json:"resourceName"`
+	Namespace     string             `json:"namespace"`
+	PodName       string             `json:"podName,omitempty"`
+	ContainerName string             `json:"containerName,omitempty"`
+	Port          string             `json:"port"`
+	Address       string             `json:"address,omitempty"`
+	LocalPort     int                `json:"localPort"`
+	HostAlias     string             `json:"hostAlias,omitempty"`
+	KubeContext   string             `json:"kubeContext,omitempty"`
+	HealthPort    int                `json:"healthPort,omitempty"`
+	Error         string             `json:"error,omitempty"`
+	Message       string             `json:"message"`
+}
+
+// JSONOutputFormatter renders each message as a single line JSON object.
+func JSONOutputFormatter(msg ForwardMessage) string {
+	m := jsonMessage{
+		Type:          msg.Type,
+		ResourceType:  string(msg.Entry.Resource.Type),
+		ResourceName:  msg.Entry.Resource.Name,
+		Namespace:     msg.Entry.Resource.Namespace,
+		PodName:       msg.Entry.PodName,
+		ContainerName: msg.Entry.ContainerName,
+		Port:          msg.Entry.Resource.Port.String(),
+		Address:       msg.Entry.Resource.Address,
+		LocalPort:     msg.Entry.LocalPort,
+		HostAlias:     msg.Entry.HostAlias,
+		KubeContext:   msg.Entry.KubeContext,
+		HealthPort:    msg.Entry.HealthPort,
+		Message:       msg.Text,
+	}
+	if msg.Err != nil {
+		m.Error = msg.Err.Error()
+	}
+	line, err := json.Marshal(m)
+	if err != nil {
+		return msg.Text
+	}
+	return string(line)
+}
+
+// OutputFormatterFor returns the formatter of the given output format: "text", the default, or "json".
+func OutputFormatterFor(format string) (OutputFormatter, error) {
+	switch format {
+	case "", "text":
+		return nil, nil
+	case "json":
+		return JSONOutputFormatter, nil
+	default:
+		return nil, fmt.Errorf("unknown port forward output format %q: expected \"text\" or \"json\"", format)
+	}
+}
+
+// messageReporter is implemented by forwarders that write messages about entries in the background.
+type messageReporter interface {
+	reportMessages(func(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string))
+}
+
+// SetOutputFormatter changes how the messages of port forwarding are rendered. By default, or when
+// the formatter is nil, the human-readable text of messages is written in color.
+func (b *EntryManager) SetOutputFormatter(formatter OutputFormatter) {
+	b.formatterLock.Lock()
+	defer b.formatterLock.Unlock()
+
+	b.formatter = formatter
+}
+
+// writeMessage writes a message to the output, as rendered by the output formatter.
+func (b *EntryManager) writeMessage(out io.Writer, msg ForwardMessage) {
+	b.formatterLock.Lock()
+	formatter := b.formatter
+	b.formatterLock.Unlock()
+
+	if formatter == nil {
+		messageColor(msg.Type).Fprintln(out, msg.Text)
+		return
+	}
+	if line := formatter(msg); line != "" {
+		fmt.Fprintln(out, line)
+	}
+}
+
+// writeEntryMessage writes a message about an entry, for forwarders reporting messages.
+func (b *EntryManager) writeEntryMessage(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string) {
+	b.writeMessage(out, ForwardMessage{Type: msgType, Entry: pfe.snapshot(false), Text: text})
+}
+
+// messageColor is the color of the human-readable text of messages.
+func messageColor(t ForwardMessageType) output.Color {
+	switch t {
+	case MessageError, MessageRetrying:
+		return output.Red
+	case MessageTerminated, MessageLocalPort:
+		return output.Yellow
+	default:
+		return output.Green
+	}
+}
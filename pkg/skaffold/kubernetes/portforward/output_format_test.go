@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestJSONOutputFormatter(t *testing.T) {
+	entry := PortForwardEntry{
+		Resource: latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "web-0",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+			Address:   "127.0.0.1",
+		},
+		PodName:       "web-0",
+		ContainerName: "app",
+		LocalPort:     9000,
+	}
+	tests := []struct {
+		description string
+		msg         ForwardMessage
+		expected    string
+	}{
+		{
+			description: "established",
+			msg:         ForwardMessage{Type: MessageEstablished, Entry: entry, Text: "Port forwarding pod/web-0"},
+			expected: `{"type":"established","resourceType":"pod","resourceName":"web-0","namespace":"default","podName":"web-0","containerName":"app",` +
+				`"port":"8080","address":"127.0.0.1","localPort":9000,"message":"Port forwarding pod/web-0"}`,
+		},
+		{
+			description: "error",
+			msg:         ForwardMessage{Type: MessageError, Entry: PortForwardEntry{Resource: latestV1.PortForwardResource{Type: constants.Service, Name: "db", Port: schemautil.FromInt(5432)}}, Err: errors.New("boom"), Text: "boom"},
+			expected:    `{"type":"error","resourceType":"service","resourceName":"db","namespace":"","port":"5432","localPort":0,"error":"boom","message":"boom"}`,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckDeepEqual(test.expected, JSONOutputFormatter(test.msg))
+		})
+	}
+}
+
+func TestOutputFormatterFor(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		formatter, err := OutputFormatterFor("text")
+		t.CheckNoError(err)
+		t.CheckTrue(formatter == nil)
+
+		formatter, err = OutputFormatterFor("json")
+		t.CheckNoError(err)
+		t.CheckTrue(formatter != nil)
+
+		_, err = OutputFormatterFor("xml")
+		t.CheckErrorContains(`unknown port forward output format "xml"`, err)
+	})
+}
+
+func TestSetOutputFormatter(t *testing.T) {
+	testutil.Run(t, "messages are rendered by the formatter", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		em.SetOutputFormatter(func(msg ForwardMessage) string {
+			if msg.Type != MessageEstablished {
+				return ""
+			}
+			return "[portforward] " + msg.Entry.Resource.Name
+		})
+		var out bytes.Buffer
+		em.forwardPortForwardEntry(context.Background(), &out, newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "leeroy-app",
+			Namespace: "default",
+			Port:      schemautil.FromInt(50051),
+			Address:   "127.0.0.1",
+		}, "", "", "", "", 50051, false))
+
+		t.CheckDeepEqual("[portforward] leeroy-app\n", out.String())
+	})
+}
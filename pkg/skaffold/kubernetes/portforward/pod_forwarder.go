@@ -21,22 +21,29 @@ import (
 	"fmt"
 	"io"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/watch"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
-	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 )
 
 var (
 	// For testing
-	newPodWatcher    = kubernetes.NewPodWatcher
-	topLevelOwnerKey = kubernetes.TopLevelOwnerKey
+	newPodWatcher             = kubernetes.NewFieldSelectedPodWatcher
+	newMultiContextPodWatcher = kubernetes.NewMultiContextPodWatcher
+	topLevelOwnerKey          = kubernetes.TopLevelOwnerKey
+	topLevelOwnerKeyInContext = kubernetes.TopLevelOwnerKeyInContext
 )
 
 // WatchingPodForwarder is responsible for selecting pods satisfying a certain condition and port-forwarding the exposed
@@ -46,24 +53,194 @@ type WatchingPodForwarder struct {
 	entryManager *EntryManager
 	podWatcher   kubernetes.PodWatcher
 	events       chan kubernetes.PodEvent
+	// podSelectors select the pods to forward, by decreasing priority.
+	podSelectors *prioritizedSelectors
+	// fieldSelector restricts the pods that are forwarded.
+	fieldSelector fields.Selector
+	// labelCondition restricts the pods that are forwarded, for as long as their labels match it.
+	labelCondition labels.Selector
 
 	// portSelector returns a possibly-filtered and possibly-generated set of ports for a pod.
 	containerPorts portSelector
+
+	// workloads, if set, restricts forwarding to a single Ready pod of each workload.
+	workloads *workloadPods
+
+	// veto, if set, is consulted before forwarding each entry.
+	veto VetoHook
+
+	// deleteGrace, if set, delays terminating the forwards of a deleted pod, in case it reappears in the meantime.
+	deleteGrace time.Duration
+	// pendingDeletes are the pods deleted less than deleteGrace ago, by kube-context, namespace and name.
+	// It's only accessed from the event loop.
+	pendingDeletes map[string]*v1.Pod
+	// expiredDeletes receives the deletions of pods once their grace period is over.
+	expiredDeletes chan kubernetes.PodEvent
+
+	// onRequest, if set, only forwards the container ports requested with requestForward.
+	onRequest bool
+	// runningPods are the running pods of the current kube-context, by namespace and name, when forwarding on request.
+	// It's only accessed from the event loop, as is requestedPorts.
+	runningPods map[string]*v1.Pod
+	// requestedPorts are the container ports requested to be forwarded, by namespace and name of their pod.
+	requestedPorts map[string]map[int32]bool
+	// requests receives the requests to forward a container port.
+	requests chan portRequest
+	// replicaRequests are the container ports requested to be forwarded from replicas picked by a selector.
+	replicaRequests []*replicaRequest
+	// podOwners are the top level owners of the running pods, by namespace and name, when forwarding on request.
+	podOwners map[string]string
+
+	// pauseLock guards paused and deferredEvents, as the dev loop pauses and resumes forwarding.
+	pauseLock sync.Mutex
+	// paused defers handling the pod events until forwarding resumes.
+	paused bool
+	// deferredEvents are the latest events of the pods changed while paused, by kube-context, namespace and name.
+	deferredEvents map[string]kubernetes.PodEvent
+	// resumed asks the event loop to handle the deferred events once forwarding resumes, and to tell when it's done.
+	resumed chan chan struct{}
+	// loopDone is closed once the event loop started last is over.
+	loopDone chan struct{}
+}
+
+// portRequest asks the event loop to forward a container port of a running pod,
+// or of the replica of a workload picked by a selector.
+type portRequest struct {
+	namespace string
+	podName   string
+	owner     string
+	replica   ReplicaSelector
+	port      int32
+	reply     chan portReply
+}
+
+// portReply is the local port a requested container port is forwarded to, or why it can't be.
+type portReply struct {
+	localPort int
+	err       error
 }
 
+// VetoHook rejects forwarding an entry by returning an error explaining why, eg. to enforce a policy
+// on the local ports or namespaces that can be forwarded.
+type VetoHook func(entry PortForwardEntry) error
+
 // portSelector selects a set of ContainerPorts from a container in a pod.
 type portSelector func(*v1.Pod, v1.Container) []v1.ContainerPort
 
-// NewWatchingPodForwarder returns a struct that tracks and port-forwards pods as they are created and modified
-func NewWatchingPodForwarder(entryManager *EntryManager, podSelector kubernetes.PodSelector, containerPorts portSelector) *WatchingPodForwarder {
+// prioritizedSelectors selects the pods matching any of its selectors, the first ones having the highest priority.
+// Selectors can be added with a lower priority than the others while pods are watched.
+type prioritizedSelectors struct {
+	lock      sync.RWMutex
+	selectors []kubernetes.PodSelector
+}
+
+func newPrioritizedSelectors(selectors ...kubernetes.PodSelector) *prioritizedSelectors {
+	return &prioritizedSelectors{selectors: selectors}
+}
+
+// add appends a selector with the lowest priority, unless it's already there.
+func (s *prioritizedSelectors) add(selector kubernetes.PodSelector) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for _, existing := range s.selectors {
+		if existing == selector {
+			return
+		}
+	}
+	s.selectors = append(s.selectors, selector)
+}
+
+func (s *prioritizedSelectors) Select(pod *v1.Pod) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, selector := range s.selectors {
+		if selector.Select(pod) {
+			return true
+		}
+	}
+	return false
+}
+
+// priority returns the index of the first selector matching the pod, or the lowest priority if none does.
+func (s *prioritizedSelectors) priority(pod *v1.Pod) int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for i, selector := range s.selectors {
+		if selector.Select(pod) {
+			return i
+		}
+	}
+	if len(s.selectors) == 0 {
+		return 0
+	}
+	return len(s.selectors) - 1
+}
+
+// Has returns true if any of the selectors tracking images under development has the image.
+func (s *prioritizedSelectors) Has(image string) bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	for _, selector := range s.selectors {
+		if images, ok := selector.(imageSet); ok && images.Has(image) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewWatchingPodForwarder returns a struct that tracks and port-forwards pods as they are created and modified.
+// The pods selected by any of the pod selectors are forwarded, eg. one selecting primary images and another
+// on-demand ones. Selectors are given by decreasing priority: once local ports run out, the pods of the first
+// selectors take over the local ports of pods only selected by later ones.
+// Only the pods matching the field selector are forwarded. The label condition is evaluated again each time
+// a pod is modified: the pod is forwarded once its labels match, and its forwards are terminated when they don't anymore.
+func NewWatchingPodForwarder(entryManager *EntryManager, podSelectors []kubernetes.PodSelector, containerPorts portSelector, fieldSelector fields.Selector, labelCondition labels.Selector) *WatchingPodForwarder {
+	return newWatchingPodForwarder(entryManager, newPrioritizedSelectors(podSelectors...), containerPorts, fieldSelector, labelCondition)
+}
+
+func newWatchingPodForwarder(entryManager *EntryManager, podSelectors *prioritizedSelectors, containerPorts portSelector, fieldSelector fields.Selector, labelCondition labels.Selector) *WatchingPodForwarder {
 	return &WatchingPodForwarder{
 		entryManager:   entryManager,
-		podWatcher:     newPodWatcher(podSelector),
+		podWatcher:     newPodWatcher(podSelectors, fieldSelector),
+		podSelectors:   podSelectors,
 		events:         make(chan kubernetes.PodEvent),
+		pendingDeletes: map[string]*v1.Pod{},
+		expiredDeletes: make(chan kubernetes.PodEvent),
+		fieldSelector:  fieldSelector,
+		labelCondition: labelCondition,
 		containerPorts: containerPorts,
+		deferredEvents: map[string]kubernetes.PodEvent{},
+		resumed:        make(chan chan struct{}),
 	}
 }
 
+// SetVetoHook installs a hook consulted before forwarding each container port of the pods.
+// Ports it returns an error for aren't forwarded. It must be called before Start.
+func (p *WatchingPodForwarder) SetVetoHook(hook VetoHook) {
+	p.veto = hook
+}
+
+// watchKubeContexts makes the forwarder watch the pods of other kube-contexts, on top of the current one.
+// Their entries are tagged with their kube-context, and share the local ports of the current kube-context's.
+func (p *WatchingPodForwarder) watchKubeContexts(kubeContexts []string) {
+	p.podWatcher = newMultiContextPodWatcher(p.podSelectors, p.fieldSelector, append([]string{""}, kubeContexts...))
+}
+
+// forwardOnRequest makes the forwarder track the running pods without forwarding them, until one
+// of their container ports is requested with requestForward. Requested ports are forwarded again
+// when their pod restarts or reappears with the same name, as in the usual mode.
+func (p *WatchingPodForwarder) forwardOnRequest() {
+	p.onRequest = true
+	p.runningPods = map[string]*v1.Pod{}
+	p.requestedPorts = map[string]map[int32]bool{}
+	p.requests = make(chan portRequest)
+	p.podOwners = map[string]string{}
+}
+
 func (p *WatchingPodForwarder) Start(ctx context.Context, out io.Writer, namespaces []string) error {
 	p.podWatcher.Register(p.events)
 	p.output = out
@@ -72,8 +249,14 @@ func (p *WatchingPodForwarder) Start(ctx context.Context, out io.Writer, namespa
 		return err
 	}
 
+	loopDone := make(chan struct{})
+	p.pauseLock.Lock()
+	p.loopDone = loopDone
+	p.pauseLock.Unlock()
+
 	go func() {
 		defer stopWatcher()
+		defer close(loopDone)
 
 		for {
 			select {
@@ -83,15 +266,27 @@ func (p *WatchingPodForwarder) Start(ctx context.Context, out io.Writer, namespa
 				if !ok {
 					return
 				}
-
-				// At this point, we know the event's type is "ADDED" or "MODIFIED".
-				// We must take both types into account as it is possible for the pod to have become ready for port-forwarding before we established the watch.
-				pod := evt.Pod
-				if evt.Type != watch.Deleted && pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil {
-					if err := p.portForwardPod(ctx, pod); err != nil {
-						logrus.Warnf("port forwarding pod failed: %s", err)
-					}
+				if p.deferWhilePaused(evt) {
+					continue
+				}
+				p.handlePodEvent(ctx, evt)
+			case handled := <-p.resumed:
+				p.reconcileDeferred(ctx, handled)
+			case deleted := <-p.expiredDeletes:
+				// the pod may have reappeared, or been deleted again since
+				if key := contextPodKey(deleted.KubeContext, deleted.Pod); p.pendingDeletes[key] == deleted.Pod {
+					delete(p.pendingDeletes, key)
+					p.stopForwardingPod(ctx, deleted.KubeContext, deleted.Pod)
 				}
+			case req := <-p.requests:
+				var localPort int
+				var err error
+				if req.owner != "" {
+					localPort, err = p.forwardRequestedReplica(ctx, req)
+				} else {
+					localPort, err = p.forwardRequested(ctx, req)
+				}
+				req.reply <- portReply{localPort: localPort, err: err}
 			}
 		}
 	}()
@@ -99,14 +294,145 @@ func (p *WatchingPodForwarder) Start(ctx context.Context, out io.Writer, namespa
 	return nil
 }
 
+// handlePodEvent forwards the pod of an event, or stops forwarding it once it's deleted.
+func (p *WatchingPodForwarder) handlePodEvent(ctx context.Context, evt kubernetes.PodEvent) {
+	pod := evt.Pod
+	p.trackRunningPod(evt)
+	p.resolveReplicas(ctx)
+	if evt.Type == watch.Deleted {
+		// The pod watcher reconciles with a fresh list of pods after reconnecting,
+		// so this is a true deletion rather than a gap in the watch.
+		p.deletePod(ctx, evt.KubeContext, pod)
+		return
+	}
+	if key := contextPodKey(evt.KubeContext, pod); p.pendingDeletes[key] != nil {
+		logrus.Debugf("pod/%s reappeared after being deleted, keeping its port forwards", pod.Name)
+		delete(p.pendingDeletes, key)
+	}
+
+	// At this point, we know the event's type is "ADDED" or "MODIFIED".
+	// We must take both types into account as it is possible for the pod to have become ready for port-forwarding before we established the watch.
+	if pod.Status.Phase == v1.PodRunning && pod.DeletionTimestamp == nil {
+		if err := p.portForwardPod(ctx, evt.KubeContext, pod); err != nil {
+			logrus.Warnf("port forwarding pod failed: %s", err)
+		}
+	}
+}
+
+// requestForward forwards a container port of a running pod of the current kube-context, when forwarding on request,
+// and returns its local port. The request is handled by the event loop, which owns the state of the tracked pods.
+func (p *WatchingPodForwarder) requestForward(ctx context.Context, namespace, podName string, port int) (int, error) {
+	return p.request(ctx, portRequest{namespace: namespace, podName: podName, port: int32(port)})
+}
+
+// requestReplicaForward forwards a container port of the replica of a workload, given as "<kind>/<name>", that the selector
+// picks among the running pods of the current kube-context, when forwarding on request, and returns its local port.
+// Once a replica is replaced, the port is forwarded from the replica the selector picks then.
+func (p *WatchingPodForwarder) requestReplicaForward(ctx context.Context, namespace, owner string, replica ReplicaSelector, port int) (int, error) {
+	return p.request(ctx, portRequest{namespace: namespace, owner: owner, replica: replica, port: int32(port)})
+}
+
+// request hands a request to the event loop and waits for its reply.
+func (p *WatchingPodForwarder) request(ctx context.Context, req portRequest) (int, error) {
+	req.reply = make(chan portReply, 1)
+	select {
+	case p.requests <- req:
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+	select {
+	case reply := <-req.reply:
+		return reply.localPort, reply.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// trackRunningPod records the pods of the current kube-context that are running, when forwarding on request.
+func (p *WatchingPodForwarder) trackRunningPod(evt kubernetes.PodEvent) {
+	if !p.onRequest || evt.KubeContext != "" {
+		return
+	}
+	key := contextPodKey("", evt.Pod)
+	if evt.Type != watch.Deleted && evt.Pod.Status.Phase == v1.PodRunning && evt.Pod.DeletionTimestamp == nil {
+		p.runningPods[key] = evt.Pod
+	} else {
+		delete(p.runningPods, key)
+		delete(p.podOwners, key)
+	}
+}
+
+// forwardRequested forwards a requested container port of a running pod, and keeps forwarding it from then on.
+func (p *WatchingPodForwarder) forwardRequested(ctx context.Context, req portRequest) (int, error) {
+	key := contextPodKey("", &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: req.namespace, Name: req.podName}})
+	pod, found := p.runningPods[key]
+	if !found {
+		return 0, fmt.Errorf("pod/%s is not running in namespace %q", req.podName, req.namespace)
+	}
+
+	alreadyRequested := p.requestedPorts[key][req.port]
+	if p.requestedPorts[key] == nil {
+		p.requestedPorts[key] = map[int32]bool{}
+	}
+	p.requestedPorts[key][req.port] = true
+	if err := p.portForwardPod(ctx, "", pod); err != nil {
+		if !alreadyRequested {
+			delete(p.requestedPorts[key], req.port)
+		}
+		return 0, err
+	}
+
+	for _, entry := range p.entryManager.forwardedResources.Values() {
+		if entry.automaticPodForwarding && entry.kubeContext == "" && entry.podName == pod.Name && entry.resource.Namespace == pod.Namespace && entry.resource.Port.IntVal == int(req.port) {
+			return entry.localPort, nil
+		}
+	}
+	delete(p.requestedPorts[key], req.port)
+	return 0, fmt.Errorf("pod/%s has no container port %d to forward", req.podName, req.port)
+}
+
 func (p *WatchingPodForwarder) Stop() {
 	p.entryManager.Stop()
 }
 
-func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod) error {
-	ownerReference := topLevelOwnerKey(ctx, pod, pod.Kind)
+// portForwardPod forwards the selected container ports of a pod of the given kube-context, "" being the current one.
+func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, kubeContext string, pod *v1.Pod) error {
+	if !p.fieldSelector.Matches(kubernetes.PodFields(pod)) {
+		logrus.Debugf("not forwarding pod/%s: it doesn't match field selector %q", pod.Name, p.fieldSelector)
+		return nil
+	}
+	if !p.labelCondition.Matches(labels.Set(pod.Labels)) {
+		logrus.Debugf("not forwarding pod/%s: its labels don't match %q", pod.Name, p.labelCondition)
+		p.stopForwardingPod(ctx, kubeContext, pod)
+		return nil
+	}
+
+	annotated, hasAnnotation, err := annotatedPorts(pod)
+	if err != nil {
+		return err
+	}
+
+	priority := p.podSelectors.priority(pod)
+	var ownerReference string
+	if kubeContext == "" {
+		ownerReference = topLevelOwnerKey(ctx, pod, pod.Kind)
+	} else {
+		ownerReference = topLevelOwnerKeyInContext(ctx, kubeContext, pod, pod.Kind)
+	}
+	if p.workloads != nil && !p.workloads.shouldForward(kubeContext, ownerReference, pod) {
+		logrus.Debugf("not forwarding pod/%s: another pod of %s is forwarded", pod.Name, ownerReference)
+		return nil
+	}
+	ordinal, inStatefulSet := statefulSetOrdinal(pod)
+	if inStatefulSet {
+		// replicas of a StatefulSet keep their name across restarts, so each of them gets its own forward
+		ownerReference = fmt.Sprintf("%s-%d", ownerReference, ordinal)
+	}
 	for _, c := range pod.Spec.Containers {
 		for _, port := range p.containerPorts(pod, c) {
+			if p.onRequest && !p.requestedPorts[contextPodKey(kubeContext, pod)][port.ContainerPort] {
+				continue
+			}
 			// get current entry for this container
 			resource := latestV1.PortForwardResource{
 				Type:      constants.Pod,
@@ -115,17 +441,46 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 				Port:      schemautil.FromInt(int(port.ContainerPort)),
 				Address:   constants.DefaultPortForwardAddress,
 			}
+			if hasAnnotation {
+				// only forward the ports listed in the annotation
+				a, found := findAnnotatedPort(annotated, port)
+				if !found {
+					continue
+				}
+				resource.LocalPort = a.localPort
+			}
+			if inStatefulSet {
+				resource.LocalPort = ordinalLocalPort(resource, ordinal)
+			}
 
-			entry, err := p.podForwardingEntry(pod.ResourceVersion, c.Name, port.Name, ownerReference, resource)
+			entry, err := p.podForwardingEntry(pod.ResourceVersion, kubeContext, c.Name, port.Name, ownerReference, priority, resource)
 			if err != nil {
 				return fmt.Errorf("getting pod forwarding entry: %w", err)
 			}
+			entry.podUID = string(pod.UID)
+			entry.restartCount = restartCount(pod, c.Name)
+			if p.veto != nil {
+				if err := p.veto(entry.snapshot(false)); err != nil {
+					logrus.Infof("not forwarding pod/%s/%s port %s: %v", pod.Name, c.Name, entry.resource.Port.String(), err)
+					p.releaseLocalPort(entry)
+					continue
+				}
+			}
 			if entry.resource.Port.IntVal != entry.localPort {
-				output.Yellow.Fprintf(p.output, "Forwarding container %s/%s to local port %d.\n", pod.Name, c.Name, entry.localPort)
+				p.entryManager.writeMessage(p.output, ForwardMessage{
+					Type:  MessageLocalPort,
+					Entry: entry.snapshot(false),
+					Text:  fmt.Sprintf("Forwarding container %s/%s to local port %d.", pod.Name, c.Name, entry.localPort),
+				})
 			}
 			if prevEntry, ok := p.entryManager.forwardedResources.Load(entry.key()); ok {
-				// Check if this is a new generation of pod
-				if entry.resourceVersion > prevEntry.resourceVersion {
+				switch {
+				case entry.resourceVersion > prevEntry.resourceVersion:
+					// Check if this is a new generation of pod
+					p.entryManager.Terminate(prevEntry)
+				case entry.restartCount > prevEntry.restartCount:
+					// the container was restarted without any other change to the pod: only its own forwards are stale
+					logrus.Debugf("container %s/%s restarted, forwarding port %s again", pod.Name, c.Name, entry.resource.Port.String())
 					p.entryManager.Terminate(prevEntry)
 				}
 			}
@@ -135,12 +490,113 @@ func (p *WatchingPodForwarder) portForwardPod(ctx context.Context, pod *v1.Pod)
 	return nil
 }
 
-func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName, portName, ownerReference string, resource latestV1.PortForwardResource) (*portForwardEntry, error) {
+// releaseLocalPort frees the local port reserved for an entry that won't be forwarded,
+// unless it's still used by the current forward of the entry.
+func (p *WatchingPodForwarder) releaseLocalPort(entry *portForwardEntry) {
+	if _, forwarded := p.entryManager.forwardedResources.Load(entry.key()); !forwarded {
+		p.entryManager.forwardedPorts.Delete(entry.localPort)
+	}
+}
+
+// deletePod stops forwarding a deleted pod, once the grace period is over if there's one.
+// The forwards of a pod that reappears within the grace period are kept, and re-pointed to
+// the new pod of the same workload, if any, as with any new pod.
+func (p *WatchingPodForwarder) deletePod(ctx context.Context, kubeContext string, pod *v1.Pod) {
+	if p.deleteGrace <= 0 {
+		p.stopForwardingPod(ctx, kubeContext, pod)
+		return
+	}
+
+	logrus.Debugf("pod/%s was deleted, stopping its port forwards in %v unless it reappears", pod.Name, p.deleteGrace)
+	p.pendingDeletes[contextPodKey(kubeContext, pod)] = pod
+	time.AfterFunc(p.deleteGrace, func() {
+		select {
+		case p.expiredDeletes <- kubernetes.PodEvent{Type: watch.Deleted, Pod: pod, KubeContext: kubeContext}:
+		case <-ctx.Done():
+		}
+	})
+}
+
+// stopForwardingPod terminates the port forwards of a pod that's gone, or not selected anymore. When forwarding
+// a single pod per workload, another Ready pod of the workload is forwarded in its place.
+func (p *WatchingPodForwarder) stopForwardingPod(ctx context.Context, kubeContext string, pod *v1.Pod) {
+	p.terminatePodEntries(kubeContext, pod)
+	if p.workloads == nil {
+		return
+	}
+	if next, found := p.workloads.remove(kubeContext, pod); found {
+		logrus.Debugf("forwarding pod/%s in place of pod/%s", next.Name, pod.Name)
+		if err := p.portForwardPod(ctx, kubeContext, next); err != nil {
+			logrus.Warnf("port forwarding pod failed: %s", err)
+		}
+	}
+}
+
+// terminatePodEntries terminates the port forwards of the given pod of a kube-context, if any.
+func (p *WatchingPodForwarder) terminatePodEntries(kubeContext string, pod *v1.Pod) {
+	for _, entry := range p.entryManager.forwardedResources.Values() {
+		if !entry.automaticPodForwarding || entry.kubeContext != kubeContext || entry.podName != pod.Name || entry.resource.Namespace != pod.Namespace {
+			continue
+		}
+		p.entryManager.writeMessage(p.output, ForwardMessage{
+			Type:  MessageTerminated,
+			Entry: entry.snapshot(false),
+			Text:  fmt.Sprintf("Stopped forwarding container %s/%s on local port %d.", pod.Name, entry.containerName, entry.localPort),
+		})
+		p.entryManager.Terminate(entry)
+	}
+}
+
+// restartCount returns the number of times the named container of the pod was restarted.
+func restartCount(pod *v1.Pod, containerName string) int32 {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.Name == containerName {
+			return status.RestartCount
+		}
+	}
+	return 0
+}
+
+// statefulSetOrdinal returns the ordinal of a pod managed by a StatefulSet, eg. 1 for `mydb-1`.
+func statefulSetOrdinal(pod *v1.Pod) (int, bool) {
+	for _, owner := range pod.OwnerReferences {
+		if owner.Kind != "StatefulSet" || (owner.Controller != nil && !*owner.Controller) {
+			continue
+		}
+		prefix := owner.Name + "-"
+		if !strings.HasPrefix(pod.Name, prefix) {
+			return 0, false
+		}
+		ordinal, err := strconv.Atoi(strings.TrimPrefix(pod.Name, prefix))
+		if err != nil || ordinal < 0 {
+			return 0, false
+		}
+		return ordinal, true
+	}
+	return 0, false
+}
+
+// ordinalLocalPort offsets the preferred local port of a StatefulSet replica by its ordinal,
+// so that `mydb-0` and `mydb-1` are forwarded to predictable local ports across restarts.
+func ordinalLocalPort(resource latestV1.PortForwardResource, ordinal int) int {
+	base := resource.LocalPort
+	if base == 0 {
+		base = int(resource.Port.IntVal)
+	}
+	if base+ordinal > 65535 {
+		return resource.LocalPort
+	}
+	return base + ordinal
+}
+
+func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, kubeContext, containerName, portName, ownerReference string, priority int, resource latestV1.PortForwardResource) (*portForwardEntry, error) {
 	rv, err := strconv.Atoi(resourceVersion)
 	if err != nil {
 		return nil, fmt.Errorf("converting resource version to integer: %w", err)
 	}
 	entry := newPortForwardEntry(rv, resource, resource.Name, containerName, portName, ownerReference, 0, true)
+	entry.priority = priority
+	entry.kubeContext = kubeContext
 
 	// If we have, return the current entry
 	oldEntry, ok := p.entryManager.forwardedResources.Load(entry.key())
@@ -151,7 +607,17 @@ func (p *WatchingPodForwarder) podForwardingEntry(resourceVersion, containerName
 	}
 
 	// retrieve an open port on the host
-	entry.localPort = retrieveAvailablePort(resource.Address, resource.Port.IntVal, &p.entryManager.forwardedPorts)
+	requestPort := resource.Port.IntVal
+	if resource.LocalPort != 0 {
+		requestPort = resource.LocalPort
+	}
+	if entry.localPort, err = p.entryManager.allocateLocalPort(entry, requestPort); err != nil {
+		port, preempted := p.entryManager.preemptLocalPort(priority)
+		if !preempted {
+			return nil, err
+		}
+		entry.localPort = port
+	}
 
 	return entry, nil
 }
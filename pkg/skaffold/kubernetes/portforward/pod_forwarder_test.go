@@ -18,20 +18,28 @@ package portforward
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"reflect"
+	"sort"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
 )
@@ -399,6 +407,84 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 				},
 			},
 		},
+		{
+			description:    "ports listed in annotation",
+			availablePorts: []int{19090},
+			expectedPorts:  []int{19090},
+			expectedEntries: map[string]*portForwardEntry{
+				"owner-containername-namespace-grpc-9090": {
+					resourceVersion: 1,
+					podName:         "podname",
+					containerName:   "containername",
+					resource: latestV1.PortForwardResource{
+						Type:      "pod",
+						Name:      "podname",
+						Namespace: "namespace",
+						Port:      schemautil.FromInt(9090),
+						Address:   "127.0.0.1",
+						LocalPort: 19090,
+					},
+					ownerReference:         "owner",
+					automaticPodForwarding: true,
+					portName:               "grpc",
+					localPort:              19090,
+				},
+			},
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "podname",
+						ResourceVersion: "1",
+						Namespace:       "namespace",
+						Annotations:     map[string]string{PortForwardAnnotation: "grpc:9090->19090"},
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "containername",
+								Ports: []v1.ContainerPort{
+									{
+										ContainerPort: 8080,
+										Name:          "http",
+									},
+									{
+										ContainerPort: 9090,
+										Name:          "grpc",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			description: "invalid annotation",
+			pods: []*v1.Pod{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            "podname",
+						ResourceVersion: "1",
+						Namespace:       "namespace",
+						Annotations:     map[string]string{PortForwardAnnotation: "8080,8080"},
+					},
+					Spec: v1.PodSpec{
+						Containers: []v1.Container{
+							{
+								Name: "containername",
+								Ports: []v1.ContainerPort{
+									{
+										ContainerPort: 8080,
+										Name:          "http",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			shouldErr: true,
+		},
 	}
 	for _, test := range tests {
 		testutil.Run(t, test.description, func(t *testutil.T) {
@@ -413,10 +499,10 @@ func TestAutomaticPortForwardPod(t *testing.T) {
 			entryManager := NewEntryManager(nil)
 			entryManager.entryForwarder = test.forwarder
 
-			p := NewWatchingPodForwarder(entryManager, kubernetes.NewImageList(), allPorts)
+			p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
 			p.Start(context.Background(), ioutil.Discard, nil)
 			for _, pod := range test.pods {
-				err := p.portForwardPod(context.Background(), pod)
+				err := p.portForwardPod(context.Background(), "", pod)
 				t.CheckError(test.shouldErr, err)
 			}
 
@@ -478,7 +564,7 @@ func TestStartPodForwarder(t *testing.T) {
 		testutil.Run(t, test.description, func(t *testutil.T) {
 			testEvent.InitializeState([]latestV1.Pipeline{{}})
 			t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
-			t.Override(&newPodWatcher, func(kubernetes.PodSelector) kubernetes.PodWatcher {
+			t.Override(&newPodWatcher, func(kubernetes.PodSelector, fields.Selector) kubernetes.PodWatcher {
 				return &fakePodWatcher{
 					events: []kubernetes.PodEvent{test.event},
 				}
@@ -490,7 +576,7 @@ func TestStartPodForwarder(t *testing.T) {
 			fakeForwarder := newTestForwarder()
 			entryManager := NewEntryManager(fakeForwarder)
 
-			p := NewWatchingPodForwarder(entryManager, imageList, allPorts)
+			p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
 			p.Start(context.Background(), ioutil.Discard, nil)
 
 			// wait for the pod resource to be forwarded
@@ -505,6 +591,690 @@ func TestStartPodForwarder(t *testing.T) {
 	}
 }
 
+type terminationCountingForwarder struct {
+	*testForwarder
+	terminations int32
+}
+
+func (f *terminationCountingForwarder) Terminate(pfe *portForwardEntry) {
+	atomic.AddInt32(&f.terminations, 1)
+	f.testForwarder.Terminate(pfe)
+}
+
+func TestPodForwarderTerminatesDeletedPods(t *testing.T) {
+	testutil.Run(t, "forwards survive a watch reconnect and stop when the pod is deleted", func(t *testutil.T) {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", ResourceVersion: "9"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Image: "image",
+				Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+		t.Override(&newPodWatcher, func(kubernetes.PodSelector, fields.Selector) kubernetes.PodWatcher {
+			return &fakePodWatcher{
+				events: []kubernetes.PodEvent{
+					{Type: watch.Added, Pod: pod},
+					// the pod is listed again after the watch reconnects
+					{Type: watch.Modified, Pod: pod},
+					{Type: watch.Deleted, Pod: pod},
+				},
+			}
+		})
+
+		imageList := kubernetes.NewImageList()
+		imageList.Add("image")
+		fakeForwarder := &terminationCountingForwarder{testForwarder: newTestForwarder()}
+		entryManager := NewEntryManager(fakeForwarder)
+
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
+		p.Start(context.Background(), ioutil.Discard, nil)
+
+		err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return atomic.LoadInt32(&fakeForwarder.terminations) > 0, nil
+		})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(int32(1), atomic.LoadInt32(&fakeForwarder.terminations))
+		t.CheckDeepEqual(0, entryManager.forwardedResources.Length())
+	})
+}
+
+func TestPodForwarderMultipleKubeContexts(t *testing.T) {
+	testutil.Run(t, "pods of several kube-contexts are forwarded to distinct local ports", func(t *testutil.T) {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", ResourceVersion: "9"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Image: "image",
+				Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080, 8081}))
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+		t.Override(&topLevelOwnerKeyInContext, func(context.Context, string, metav1.Object, string) string { return "owner" })
+		var watchedContexts []string
+		t.Override(&newMultiContextPodWatcher, func(_ kubernetes.PodSelector, _ fields.Selector, kubeContexts []string) kubernetes.PodWatcher {
+			watchedContexts = kubeContexts
+			return &fakePodWatcher{
+				events: []kubernetes.PodEvent{
+					{Type: watch.Added, Pod: pod},
+					{Type: watch.Added, Pod: pod, KubeContext: "cluster-b"},
+					{Type: watch.Deleted, Pod: pod, KubeContext: "cluster-b"},
+				},
+			}
+		})
+
+		imageList := kubernetes.NewImageList()
+		imageList.Add("image")
+		fakeForwarder := &terminationCountingForwarder{testForwarder: newTestForwarder()}
+		entryManager := NewEntryManager(fakeForwarder)
+
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
+		p.watchKubeContexts([]string{"cluster-b"})
+		p.Start(context.Background(), ioutil.Discard, nil)
+
+		err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return atomic.LoadInt32(&fakeForwarder.terminations) > 0, nil
+		})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"", "cluster-b"}, watchedContexts)
+		// only the pod of the other kube-context was terminated
+		t.CheckDeepEqual(int32(1), atomic.LoadInt32(&fakeForwarder.terminations))
+		entry, found := entryManager.forwardedResources.Load("owner-mycontainer-default-myport-8080")
+		t.CheckTrue(found)
+		t.CheckDeepEqual("", entry.kubeContext)
+		t.CheckDeepEqual(8080, entry.localPort)
+		t.CheckDeepEqual(1, entryManager.forwardedResources.Length())
+	})
+}
+
+func TestPodForwarderOnRequest(t *testing.T) {
+	testutil.Run(t, "only requested ports of tracked pods are forwarded", func(t *testutil.T) {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", ResourceVersion: "9"},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Image: "image",
+				Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}, {Name: "debug", ContainerPort: 5005}},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{5005, 8080}))
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+		t.Override(&newPodWatcher, func(kubernetes.PodSelector, fields.Selector) kubernetes.PodWatcher {
+			return &fakePodWatcher{events: []kubernetes.PodEvent{{Type: watch.Added, Pod: pod}}}
+		})
+
+		imageList := kubernetes.NewImageList()
+		imageList.Add("image")
+		entryManager := NewEntryManager(newTestForwarder())
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
+		p.forwardOnRequest()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		p.Start(ctx, ioutil.Discard, nil)
+
+		// the pod is tracked once its event is handled
+		var localPort int
+		err := wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			var err error
+			localPort, err = p.requestForward(ctx, "default", "app", 5005)
+			return err == nil, nil
+		})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(5005, localPort)
+		_, found := entryManager.forwardedResources.Load("owner-mycontainer-default-debug-5005")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(1, entryManager.forwardedResources.Length())
+
+		_, err = p.requestForward(ctx, "default", "app", 9000)
+		t.CheckErrorContains("pod/app has no container port 9000", err)
+		_, err = p.requestForward(ctx, "default", "other", 8080)
+		t.CheckErrorContains("pod/other is not running", err)
+		t.CheckDeepEqual(1, entryManager.forwardedResources.Length())
+	})
+}
+
+func TestPodForwarderDeleteGracePeriod(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default", ResourceVersion: "9"},
+		Spec: v1.PodSpec{Containers: []v1.Container{{
+			Name:  "mycontainer",
+			Image: "image",
+			Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+		}}},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+
+	tests := []struct {
+		description          string
+		events               []kubernetes.PodEvent
+		expectedTerminations int32
+	}{
+		{
+			description: "pod reappearing within the grace period is still forwarded",
+			events: []kubernetes.PodEvent{
+				{Type: watch.Added, Pod: pod},
+				{Type: watch.Deleted, Pod: pod},
+				{Type: watch.Added, Pod: pod},
+			},
+		},
+		{
+			description: "deleted pod is terminated after the grace period",
+			events: []kubernetes.PodEvent{
+				{Type: watch.Added, Pod: pod},
+				{Type: watch.Deleted, Pod: pod},
+			},
+			expectedTerminations: 1,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+			t.Override(&newPodWatcher, func(kubernetes.PodSelector, fields.Selector) kubernetes.PodWatcher {
+				return &fakePodWatcher{events: test.events}
+			})
+
+			imageList := kubernetes.NewImageList()
+			imageList.Add("image")
+			fakeForwarder := &terminationCountingForwarder{testForwarder: newTestForwarder()}
+			entryManager := NewEntryManager(fakeForwarder)
+
+			p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
+			p.deleteGrace = 50 * time.Millisecond
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			p.Start(ctx, ioutil.Discard, nil)
+
+			// wait for the grace period to be over
+			time.Sleep(200 * time.Millisecond)
+			t.CheckDeepEqual(test.expectedTerminations, atomic.LoadInt32(&fakeForwarder.terminations))
+			_, forwarded := entryManager.forwardedResources.Load("owner-mycontainer-default-myport-8080")
+			t.CheckDeepEqual(test.expectedTerminations == 0, forwarded)
+		})
+	}
+}
+
+func TestPortForwardRestartedContainer(t *testing.T) {
+	pod := func(resourceVersion string, restarts int32) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", UID: "uid", Namespace: "default", ResourceVersion: resourceVersion},
+			Spec: v1.PodSpec{Containers: []v1.Container{
+				{Name: "web", Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}}},
+				{Name: "sidecar", Ports: []v1.ContainerPort{{Name: "admin", ContainerPort: 9090}}},
+			}},
+			Status: v1.PodStatus{
+				Phase: v1.PodRunning,
+				ContainerStatuses: []v1.ContainerStatus{
+					{Name: "web", RestartCount: restarts},
+					{Name: "sidecar"},
+				},
+			},
+		}
+	}
+
+	testutil.Run(t, "only the restarted container is forwarded again", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080, 9090}))
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+
+		fakeForwarder := &terminationCountingForwarder{testForwarder: newTestForwarder()}
+		entryManager := NewEntryManager(fakeForwarder)
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+		p.output = ioutil.Discard
+
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("1", 0)))
+		// the restart count increments on an otherwise unchanged pod
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("1", 1)))
+		t.CheckDeepEqual(int32(1), atomic.LoadInt32(&fakeForwarder.terminations))
+
+		web, found := fakeForwarder.forwardedResources.Load("owner-web-default-http-8080")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(int32(1), web.restartCount)
+		t.CheckDeepEqual(8080, web.localPort)
+		sidecar, found := fakeForwarder.forwardedResources.Load("owner-sidecar-default-admin-9090")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(int32(0), sidecar.restartCount)
+		t.CheckDeepEqual(9090, sidecar.localPort)
+
+		// a new generation of the pod forwards all its containers again
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("2", 1)))
+		t.CheckDeepEqual(int32(3), atomic.LoadInt32(&fakeForwarder.terminations))
+		sidecar, found = fakeForwarder.forwardedResources.Load("owner-sidecar-default-admin-9090")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(2, sidecar.resourceVersion)
+	})
+}
+
+func TestPortForwardPodNoAvailablePort(t *testing.T) {
+	pod := func(name, resourceVersion string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+
+	testutil.Run(t, "forwards already established survive running out of ports", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080}))
+		t.Override(&topLevelOwnerKey, func(_ context.Context, pod metav1.Object, _ string) string { return pod.GetName() })
+
+		fakeForwarder := newTestForwarder()
+		entryManager := NewEntryManager(fakeForwarder)
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+		p.output = ioutil.Discard
+
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("first", "1")))
+
+		err := p.portForwardPod(context.Background(), "", pod("second", "2"))
+		t.CheckErrorContains("no local port available on 127.0.0.1 to forward pod/second in namespace default", err)
+		var sErr sErrors.Error
+		t.CheckTrue(errors.As(err, &sErr))
+		t.CheckDeepEqual(proto.StatusCode_DEVINIT_NO_AVAILABLE_PORT, sErr.StatusCode())
+		t.CheckDeepEqual(proto.SuggestionCode_FREE_LOCAL_PORTS, sErr.Suggestions()[0].SuggestionCode)
+
+		// the first pod is still forwarded, and its updates are still handled
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("first", "3")))
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+		entry, found := fakeForwarder.forwardedResources.Load("first-mycontainer-default-myport-8080")
+		t.CheckTrue(found)
+		t.CheckDeepEqual(8080, entry.localPort)
+		t.CheckDeepEqual([]int{8080}, fakeForwarder.forwardedPorts.List())
+	})
+}
+
+func TestPortForwardPodPriority(t *testing.T) {
+	pod := func(name, image, resourceVersion string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Image: image,
+				Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+	primary := kubernetes.NewImageList()
+	primary.Add("primary")
+	onDemand := kubernetes.NewImageList()
+	onDemand.Add("on-demand")
+
+	tests := []struct {
+		description  string
+		pods         []*v1.Pod
+		expectedErr  []bool
+		expectedPods []string
+	}{
+		{
+			description:  "primary pod takes over the port of an on-demand pod",
+			pods:         []*v1.Pod{pod("on-demand-pod", "on-demand", "1"), pod("primary-pod", "primary", "2")},
+			expectedErr:  []bool{false, false},
+			expectedPods: []string{"primary-pod"},
+		},
+		{
+			description:  "on-demand pod doesn't take over the port of a primary pod",
+			pods:         []*v1.Pod{pod("primary-pod", "primary", "1"), pod("on-demand-pod", "on-demand", "2")},
+			expectedErr:  []bool{false, true},
+			expectedPods: []string{"primary-pod"},
+		},
+		{
+			description:  "pods of the same priority don't take over each other's port",
+			pods:         []*v1.Pod{pod("on-demand-1", "on-demand", "1"), pod("on-demand-2", "on-demand", "2")},
+			expectedErr:  []bool{false, true},
+			expectedPods: []string{"on-demand-1"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080}))
+			t.Override(&topLevelOwnerKey, func(_ context.Context, pod metav1.Object, _ string) string { return pod.GetName() })
+
+			fakeForwarder := newTestForwarder()
+			entryManager := NewEntryManager(fakeForwarder)
+			p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{primary, onDemand}, allPorts, fields.Everything(), labels.Everything())
+			p.output = ioutil.Discard
+
+			for i, pod := range test.pods {
+				err := p.portForwardPod(context.Background(), "", pod)
+				t.CheckError(test.expectedErr[i], err)
+			}
+
+			var forwarded []string
+			for _, entry := range fakeForwarder.forwardedResources.Values() {
+				forwarded = append(forwarded, entry.podName)
+				t.CheckDeepEqual(8080, entry.localPort)
+			}
+			t.CheckDeepEqual(test.expectedPods, forwarded)
+			t.CheckDeepEqual([]int{8080}, fakeForwarder.forwardedPorts.List())
+		})
+	}
+}
+
+func TestPortForwardOnePodPerWorkload(t *testing.T) {
+	pod := func(name, resourceVersion string, ready bool) *v1.Pod {
+		readyStatus := v1.ConditionFalse
+		if ready {
+			readyStatus = v1.ConditionTrue
+		}
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: resourceVersion},
+			Spec: v1.PodSpec{Containers: []v1.Container{{
+				Name:  "mycontainer",
+				Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+			}}},
+			Status: v1.PodStatus{
+				Phase:      v1.PodRunning,
+				Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: readyStatus}},
+			},
+		}
+	}
+
+	testutil.Run(t, "a single Ready pod is forwarded until it's deleted", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080, 8081, 8082}))
+		t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "Deployment-app" })
+
+		fakeForwarder := newTestForwarder()
+		p := NewWatchingPodForwarder(NewEntryManager(fakeForwarder), []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+		p.workloads = newWorkloadPods()
+		p.output = ioutil.Discard
+		forwarded := func() []string {
+			var names []string
+			for _, entry := range fakeForwarder.forwardedResources.Values() {
+				names = append(names, entry.podName)
+			}
+			return names
+		}
+		ctx := context.Background()
+
+		// pods that aren't Ready are not chosen
+		t.CheckNoError(p.portForwardPod(ctx, "", pod("app-a", "1", false)))
+		t.CheckEmpty(forwarded())
+
+		t.CheckNoError(p.portForwardPod(ctx, "", pod("app-b", "2", true)))
+		t.CheckNoError(p.portForwardPod(ctx, "", pod("app-a", "3", true)))
+		t.CheckNoError(p.portForwardPod(ctx, "", pod("app-c", "4", true)))
+		t.CheckDeepEqual([]string{"app-b"}, forwarded())
+
+		// the chosen pod is kept when it's not Ready anymore
+		t.CheckNoError(p.portForwardPod(ctx, "", pod("app-b", "5", false)))
+		t.CheckDeepEqual([]string{"app-b"}, forwarded())
+
+		// another Ready pod is forwarded once the chosen one is deleted
+		p.stopForwardingPod(ctx, "", pod("app-b", "6", false))
+		t.CheckDeepEqual([]string{"app-a"}, forwarded())
+
+		// deleting a pod that isn't forwarded changes nothing
+		p.stopForwardingPod(ctx, "", pod("app-c", "7", true))
+		t.CheckDeepEqual([]string{"app-a"}, forwarded())
+
+		p.stopForwardingPod(ctx, "", pod("app-a", "8", true))
+		t.CheckEmpty(forwarded())
+	})
+}
+
+func TestPortForwardPodFieldSelector(t *testing.T) {
+	pod := func(node string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "pod-" + node,
+				ResourceVersion: "1",
+				Namespace:       "default",
+			},
+			Spec: v1.PodSpec{
+				NodeName: node,
+				Containers: []v1.Container{{
+					Name:  "mycontainer",
+					Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+				}},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+
+	testutil.Run(t, "pods not matching the field selector are not forwarded", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{9000, 9001}))
+		t.Override(&topLevelOwnerKey, func(_ context.Context, pod metav1.Object, _ string) string { return pod.GetName() })
+
+		fakeForwarder := newTestForwarder()
+		entryManager := NewEntryManager(fakeForwarder)
+		selector, err := fields.ParseSelector("status.phase=Running,spec.nodeName=node-1")
+		t.CheckNoError(err)
+
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, selector, labels.Everything())
+		p.output = ioutil.Discard
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("node-1")))
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("node-2")))
+
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+		_, found := fakeForwarder.forwardedResources.Load("pod-node-1-mycontainer-default-myport-8080")
+		t.CheckTrue(found)
+	})
+}
+
+func TestPortForwardPodLabelCondition(t *testing.T) {
+	pod := func(resourceVersion, track string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "app",
+				ResourceVersion: resourceVersion,
+				Namespace:       "default",
+				Labels:          map[string]string{"app": "app", "track": track},
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "mycontainer",
+					Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+				}},
+			},
+			Status: v1.PodStatus{Phase: v1.PodRunning},
+		}
+	}
+
+	testutil.Run(t, "pods are forwarded while their labels match the condition", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{9000, 9001}))
+		t.Override(&topLevelOwnerKey, func(_ context.Context, pod metav1.Object, _ string) string { return pod.GetName() })
+
+		fakeForwarder := newTestForwarder()
+		entryManager := NewEntryManager(fakeForwarder)
+		condition, err := labels.Parse("track=canary")
+		t.CheckNoError(err)
+
+		p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), condition)
+		p.output = ioutil.Discard
+
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("1", "stable")))
+		t.CheckDeepEqual(0, fakeForwarder.forwardedResources.Length())
+
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("2", "canary")))
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+		t.CheckDeepEqual(1, entryManager.forwardedResources.Length())
+
+		// the label is changed back: the forward is torn down
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("3", "stable")))
+		t.CheckDeepEqual(0, fakeForwarder.forwardedResources.Length())
+		t.CheckDeepEqual(0, entryManager.forwardedResources.Length())
+		t.CheckDeepEqual(0, entryManager.forwardedPorts.Length())
+
+		// and established again once it matches
+		t.CheckNoError(p.portForwardPod(context.Background(), "", pod("4", "canary")))
+		t.CheckDeepEqual(1, fakeForwarder.forwardedResources.Length())
+	})
+}
+
+func TestPortForwardPodVeto(t *testing.T) {
+	pod := &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            "app",
+			ResourceVersion: "1",
+			Namespace:       "default",
+		},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{{
+				Name: "mycontainer",
+				Ports: []v1.ContainerPort{
+					{Name: "http", ContainerPort: 80},
+					{Name: "debug", ContainerPort: 8080},
+				},
+			}},
+		},
+		Status: v1.PodStatus{Phase: v1.PodRunning},
+	}
+
+	tests := []struct {
+		description string
+		veto        VetoHook
+		expected    []string
+	}{
+		{
+			description: "no hook",
+			expected:    []string{"app-mycontainer-default-debug-8080", "app-mycontainer-default-http-80"},
+		},
+		{
+			description: "privileged ports are vetoed",
+			veto: func(entry PortForwardEntry) error {
+				if entry.LocalPort < 1024 {
+					return fmt.Errorf("local port %d is privileged", entry.LocalPort)
+				}
+				return nil
+			},
+			expected: []string{"app-mycontainer-default-debug-8080"},
+		},
+		{
+			description: "namespace is vetoed",
+			veto: func(entry PortForwardEntry) error {
+				if entry.Resource.Namespace == "default" {
+					return errors.New("forwarding from the default namespace is not allowed")
+				}
+				return nil
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&retrieveAvailablePort, func(_ string, req int, ports *util.PortSet) int {
+				ports.LoadOrSet(req)
+				return req
+			})
+			t.Override(&topLevelOwnerKey, func(_ context.Context, pod metav1.Object, _ string) string { return pod.GetName() })
+
+			fakeForwarder := newTestForwarder()
+			entryManager := NewEntryManager(fakeForwarder)
+			p := NewWatchingPodForwarder(entryManager, []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+			p.output = ioutil.Discard
+			p.SetVetoHook(test.veto)
+			t.CheckNoError(p.portForwardPod(context.Background(), "", pod))
+
+			var forwarded []string
+			for _, entry := range entryManager.forwardedResources.Values() {
+				forwarded = append(forwarded, entry.key())
+			}
+			sort.Strings(forwarded)
+			t.CheckDeepEqual(test.expected, forwarded)
+			// the local ports of vetoed entries are released
+			t.CheckDeepEqual(len(test.expected), entryManager.forwardedPorts.Length())
+		})
+	}
+}
+
+func TestPortForwardStatefulSetPods(t *testing.T) {
+	pod := func(name string, owner *metav1.OwnerReference, annotations map[string]string) *v1.Pod {
+		pod := &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            name,
+				ResourceVersion: "1",
+				Namespace:       "default",
+				Annotations:     annotations,
+			},
+			Spec: v1.PodSpec{
+				Containers: []v1.Container{{
+					Name:  "db",
+					Ports: []v1.ContainerPort{{Name: "sql", ContainerPort: 5432}},
+				}},
+			},
+		}
+		if owner != nil {
+			pod.OwnerReferences = []metav1.OwnerReference{*owner}
+		}
+		return pod
+	}
+	statefulSet := &metav1.OwnerReference{Kind: "StatefulSet", Name: "mydb"}
+	replicaSet := &metav1.OwnerReference{Kind: "ReplicaSet", Name: "mydb-5b9d"}
+
+	tests := []struct {
+		description string
+		pods        []*v1.Pod
+		expected    map[string]int
+	}{
+		{
+			description: "replicas are forwarded to ports offset by their ordinal",
+			pods:        []*v1.Pod{pod("mydb-0", statefulSet, nil), pod("mydb-1", statefulSet, nil), pod("mydb-2", statefulSet, nil)},
+			expected: map[string]int{
+				"owner-0-db-default-sql-5432": 5432,
+				"owner-1-db-default-sql-5432": 5433,
+				"owner-2-db-default-sql-5432": 5434,
+			},
+		},
+		{
+			description: "ordinal offsets the annotated local port",
+			pods:        []*v1.Pod{pod("mydb-1", statefulSet, map[string]string{PortForwardAnnotation: "sql->15432"})},
+			expected: map[string]int{
+				"owner-1-db-default-sql-5432": 15433,
+			},
+		},
+		{
+			description: "other pods share a single forward",
+			pods:        []*v1.Pod{pod("mydb-5b9d-x7k2p", replicaSet, nil), pod("mydb-5b9d-q9v4s", replicaSet, nil)},
+			expected: map[string]int{
+				"owner-db-default-sql-5432": 5432,
+			},
+		},
+		{
+			description: "pod name not matching the StatefulSet",
+			pods:        []*v1.Pod{pod("other-1", statefulSet, nil)},
+			expected: map[string]int{
+				"owner-db-default-sql-5432": 5432,
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			testEvent.InitializeState([]latestV1.Pipeline{{}})
+			t.Override(&retrieveAvailablePort, func(_ string, req int, _ *util.PortSet) int { return req })
+			t.Override(&topLevelOwnerKey, func(context.Context, metav1.Object, string) string { return "owner" })
+
+			fakeForwarder := newTestForwarder()
+			p := NewWatchingPodForwarder(NewEntryManager(fakeForwarder), []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+			p.output = ioutil.Discard
+			for _, pod := range test.pods {
+				t.CheckNoError(p.portForwardPod(context.Background(), "", pod))
+			}
+
+			actual := map[string]int{}
+			for key, entry := range fakeForwarder.forwardedResources.resources {
+				actual[key] = entry.localPort
+			}
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
 type fakePodWatcher struct {
 	events   []kubernetes.PodEvent
 	receiver chan<- kubernetes.PodEvent
@@ -0,0 +1,159 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// PortForwardAnnotation lists the ports of a pod that should be forwarded,
+// instead of all the ports declared by its containers.
+// Ports are comma-separated and given by number, name, or both as `name:number`,
+// optionally followed by `->localPort` to request a local port, eg. `8080,grpc:9090->19090`.
+const PortForwardAnnotation = "skaffold.dev/port-forward"
+
+// annotatedPort is a port listed in the PortForwardAnnotation.
+type annotatedPort struct {
+	name      string
+	port      int32
+	localPort int
+}
+
+func (a annotatedPort) String() string {
+	switch {
+	case a.name == "":
+		return strconv.Itoa(int(a.port))
+	case a.port == 0:
+		return a.name
+	default:
+		return fmt.Sprintf("%s:%d", a.name, a.port)
+	}
+}
+
+// matches returns true if the container port is the one designated by the annotation.
+func (a annotatedPort) matches(port v1.ContainerPort) bool {
+	if a.name != "" && a.name != port.Name {
+		return false
+	}
+	return a.port == 0 || a.port == port.ContainerPort
+}
+
+// findAnnotatedPort returns the first annotated port designating the container port.
+func findAnnotatedPort(ports []annotatedPort, port v1.ContainerPort) (annotatedPort, bool) {
+	for _, a := range ports {
+		if a.matches(port) {
+			return a, true
+		}
+	}
+	return annotatedPort{}, false
+}
+
+// annotatedPorts returns the ports listed in the pod's PortForwardAnnotation.
+// It returns false if the pod doesn't have the annotation.
+func annotatedPorts(pod *v1.Pod) ([]annotatedPort, bool, error) {
+	value, found := pod.Annotations[PortForwardAnnotation]
+	if !found {
+		return nil, false, nil
+	}
+	ports, err := parsePortForwardAnnotation(value)
+	if err != nil {
+		return nil, true, fmt.Errorf("invalid %s annotation on pod/%s: %w", PortForwardAnnotation, pod.Name, err)
+	}
+	return ports, true, nil
+}
+
+func parsePortForwardAnnotation(value string) ([]annotatedPort, error) {
+	var ports []annotatedPort
+	seen := map[string]bool{}
+	localPorts := map[int]bool{}
+
+	for _, spec := range strings.Split(value, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			continue
+		}
+		port, err := parseAnnotatedPort(spec)
+		if err != nil {
+			return nil, err
+		}
+		if seen[port.String()] {
+			return nil, fmt.Errorf("port %q is listed more than once", port)
+		}
+		seen[port.String()] = true
+		if port.localPort != 0 {
+			if localPorts[port.localPort] {
+				return nil, fmt.Errorf("local port %d is requested more than once", port.localPort)
+			}
+			localPorts[port.localPort] = true
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// parseAnnotatedPort parses `[name:]port[->localPort]` or `name[->localPort]`.
+func parseAnnotatedPort(spec string) (annotatedPort, error) {
+	var port annotatedPort
+
+	target := spec
+	if i := strings.Index(spec, "->"); i >= 0 {
+		target = strings.TrimSpace(spec[:i])
+		local, err := parsePortNumber(strings.TrimSpace(spec[i+2:]))
+		if err != nil {
+			return port, fmt.Errorf("invalid local port in %q: %w", spec, err)
+		}
+		port.localPort = int(local)
+	}
+
+	name, number := "", target
+	if i := strings.Index(target, ":"); i >= 0 {
+		name, number = target[:i], target[i+1:]
+		if name == "" {
+			return port, fmt.Errorf("missing port name in %q", spec)
+		}
+	} else if _, err := strconv.Atoi(target); err != nil {
+		name, number = target, ""
+	}
+	port.name = name
+
+	if number != "" {
+		n, err := parsePortNumber(number)
+		if err != nil {
+			return port, fmt.Errorf("invalid port in %q: %w", spec, err)
+		}
+		port.port = n
+	}
+	if port.name == "" && port.port == 0 {
+		return port, fmt.Errorf("missing port in %q", spec)
+	}
+	return port, nil
+}
+
+func parsePortNumber(s string) (int32, error) {
+	n, err := strconv.ParseInt(s, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if n < 1 || n > 65535 {
+		return 0, fmt.Errorf("%d is out of range", n)
+	}
+	return int32(n), nil
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	v1 "k8s.io/api/core/v1"
+
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestParsePortForwardAnnotation(t *testing.T) {
+	tests := []struct {
+		description string
+		value       string
+		expected    []annotatedPort
+		shouldErr   bool
+	}{
+		{
+			description: "numbers and names",
+			value:       "8080, grpc:9090->19090,debug->5005",
+			expected: []annotatedPort{
+				{port: 8080},
+				{name: "grpc", port: 9090, localPort: 19090},
+				{name: "debug", localPort: 5005},
+			},
+		},
+		{
+			description: "empty",
+			value:       "",
+		},
+		{
+			description: "duplicate port",
+			value:       "8080,9090,8080",
+			shouldErr:   true,
+		},
+		{
+			description: "duplicate local port",
+			value:       "8080->9000,http->9000",
+			shouldErr:   true,
+		},
+		{
+			description: "invalid local port",
+			value:       "8080->http",
+			shouldErr:   true,
+		},
+		{
+			description: "port out of range",
+			value:       "grpc:90900",
+			shouldErr:   true,
+		},
+		{
+			description: "missing name",
+			value:       ":8080",
+			shouldErr:   true,
+		},
+		{
+			description: "missing port",
+			value:       "->8080",
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			ports, err := parsePortForwardAnnotation(test.value)
+
+			t.CheckErrorAndDeepEqual(test.shouldErr, err, test.expected, ports, cmp.AllowUnexported(annotatedPort{}))
+		})
+	}
+}
+
+func TestAnnotatedPortMatches(t *testing.T) {
+	http := v1.ContainerPort{Name: "http", ContainerPort: 8080}
+
+	testutil.CheckDeepEqual(t, true, annotatedPort{port: 8080}.matches(http))
+	testutil.CheckDeepEqual(t, true, annotatedPort{name: "http"}.matches(http))
+	testutil.CheckDeepEqual(t, true, annotatedPort{name: "http", port: 8080}.matches(http))
+	testutil.CheckDeepEqual(t, false, annotatedPort{name: "http", port: 9090}.matches(http))
+	testutil.CheckDeepEqual(t, false, annotatedPort{name: "grpc"}.matches(http))
+	testutil.CheckDeepEqual(t, false, annotatedPort{port: 9090}.matches(http))
+}
@@ -18,11 +18,15 @@ package portforward
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 type portForwardEntry struct {
@@ -37,6 +41,174 @@ type portForwardEntry struct {
 	terminated             bool
 	terminationLock        sync.Mutex
 	cancel                 context.CancelFunc
+	proxy                  *connectionProxy
+	// webSocketURL is the WebSocket endpoint of the entry, when forwarded over WebSocket.
+	webSocketURL string
+	// forwardType is InClusterForwardType when the entry is forwarded from within the cluster.
+	forwardType string
+	// inClusterAddress is the address of the Service forwarding the entry within the cluster.
+	inClusterAddress string
+	// iteration is the dev loop iteration during which the entry was forwarded.
+	iteration int
+	// podUID tells apart pods recreated with the same name, eg. StatefulSet replicas.
+	podUID string
+	// restartCount is the number of restarts of the container when it was forwarded.
+	restartCount int32
+	// capturePath is the absolute path of the file recording the entry's traffic, if any.
+	capturePath string
+	// tlsConfig is the configuration of the TLS connections to the resource, when the entry terminates TLS.
+	tlsConfig *tls.Config
+	// priority ranks automatically forwarded pods when local ports are scarce, 0 being the highest.
+	priority int
+	// bridgeInterface is the network interface whose address the local port is bound to, if any.
+	bridgeInterface string
+	// hostAlias is the name registered for the entry in the hosts file, if any.
+	hostAlias string
+	// kubeContext is the kube-context of the forwarded pod, when forwarding the pods of other kube-contexts than the current one.
+	kubeContext string
+	// health serves the state of the entry on a local port, if health endpoints are enabled.
+	health *healthEndpoint
+}
+
+// PortForwardEntry is a snapshot of an active port forward.
+type PortForwardEntry struct {
+	// Resource is the forwarded resource, as configured or discovered.
+	Resource latestV1.PortForwardResource
+	// PodName is the name of the forwarded pod, for pods discovered automatically.
+	PodName string
+	// ContainerName is the name of the container exposing the port, for pods discovered automatically.
+	ContainerName string
+	// PortName is the name of the container port, if any.
+	PortName string
+	// OwnerReference is the name of the workload owning the forwarded pod, if any.
+	OwnerReference string
+	// LocalPort is the local port the resource is forwarded to.
+	LocalPort int
+	// Ready is true once the tunnel to the resource is established.
+	Ready bool
+	// Disabled is true if the port forward was disabled at runtime.
+	Disabled bool
+	// WebSocketURL is the WebSocket endpoint of the port forward, when forwarded over WebSocket.
+	WebSocketURL string
+	// Type is "in-cluster" when the port forward is served by a Service within the cluster
+	// rather than a local port, and empty otherwise.
+	Type string
+	// InClusterAddress is the `service.namespace.svc:port` address of in-cluster port forwards.
+	InClusterAddress string
+	// Iteration is the dev loop iteration during which the port forward was established,
+	// or 0 if it was established outside of the dev loop, eg. by `skaffold run`.
+	Iteration int
+	// CapturePath is the file the traffic of the port forward is recorded to, if any.
+	CapturePath string
+	// ReadySince is when the tunnel to the resource was last established, or zero if it's not ready.
+	ReadySince time.Time
+	// BridgeInterface is the network interface whose address the local port is bound to, if bound to a Docker bridge network.
+	BridgeInterface string
+	// HostAlias is the name of the port forward's address registered in the hosts file, if any.
+	HostAlias string
+	// KubeContext is the kube-context of the forwarded pod, if it's not the current one.
+	KubeContext string
+	// HealthPort is the local port of the HTTP endpoint serving the state of the port forward, if any.
+	HealthPort int
+}
+
+// summary describes the forward as `namespace/pod container:port -> address:localPort`
+// for pods, with the namespace prefixed by `kube-context:` for pods of other kube-contexts, and `namespace/type/name port -> address:localPort` for other resources.
+func (e PortForwardEntry) summary() string {
+	local := fmt.Sprintf("%s:%d", e.Resource.Address, e.LocalPort)
+	switch {
+	case e.WebSocketURL != "":
+		local = e.WebSocketURL
+	case e.InClusterAddress != "":
+		local = e.InClusterAddress
+	}
+	if e.Disabled {
+		local += " (disabled)"
+	}
+	if e.PodName != "" && e.ContainerName != "" {
+		namespace := e.Resource.Namespace
+		if e.KubeContext != "" {
+			namespace = e.KubeContext + ":" + namespace
+		}
+		return fmt.Sprintf("%s/%s %s:%s -> %s", namespace, e.PodName, e.ContainerName, e.Resource.Port.String(), local)
+	}
+	return fmt.Sprintf("%s/%s/%s %s -> %s", e.Resource.Namespace, strings.ToLower(string(e.Resource.Type)), e.Resource.Name, e.Resource.Port.String(), local)
+}
+
+// ActivePortForwards converts snapshots of port forwards to their API representation,
+// with their uptime at the given time.
+func ActivePortForwards(entries []PortForwardEntry, now time.Time) *proto.ActivePortForwards {
+	active := &proto.ActivePortForwards{}
+	for _, e := range entries {
+		state := "Not Ready"
+		var uptime int64
+		switch {
+		case e.Disabled:
+			state = "Disabled"
+		case e.Ready:
+			state = "Ready"
+			if !e.ReadySince.IsZero() {
+				uptime = int64(now.Sub(e.ReadySince) / time.Second)
+			}
+		}
+		active.PortForwards = append(active.PortForwards, &proto.ActivePortForward{
+			LocalPort:     int32(e.LocalPort),
+			PodName:       e.PodName,
+			ContainerName: e.ContainerName,
+			Namespace:     e.Resource.Namespace,
+			PortName:      e.PortName,
+			ResourceType:  string(e.Resource.Type),
+			ResourceName:  e.Resource.Name,
+			Address:       e.Resource.Address,
+			TargetPort: &proto.IntOrString{
+				Type:   int32(e.Resource.Port.Type),
+				IntVal: int32(e.Resource.Port.IntVal),
+				StrVal: e.Resource.Port.StrVal,
+			},
+			State:         state,
+			UptimeSeconds: uptime,
+		})
+	}
+	return active
+}
+
+// sortEntries orders entries by namespace, then pod, then container port. Within a namespace,
+// forwards of other resources come after the pods, ordered by resource type, name and port.
+func sortEntries(entries []PortForwardEntry) {
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].less(entries[j])
+	})
+}
+
+func (e PortForwardEntry) less(o PortForwardEntry) bool {
+	if e.Resource.Namespace != o.Resource.Namespace {
+		return e.Resource.Namespace < o.Resource.Namespace
+	}
+	if (e.PodName == "") != (o.PodName == "") {
+		return e.PodName != ""
+	}
+	if e.PodName != o.PodName {
+		return e.PodName < o.PodName
+	}
+	if e.PodName == "" {
+		if e.Resource.Type != o.Resource.Type {
+			return e.Resource.Type < o.Resource.Type
+		}
+		if e.Resource.Name != o.Resource.Name {
+			return e.Resource.Name < o.Resource.Name
+		}
+	}
+	if p, q := e.Resource.Port, o.Resource.Port; p != q {
+		// port numbers come before port names
+		if p.Type != q.Type {
+			return p.Type < q.Type
+		}
+		if p.IntVal != q.IntVal {
+			return p.IntVal < q.IntVal
+		}
+		return p.StrVal < q.StrVal
+	}
+	return e.LocalPort < o.LocalPort
 }
 
 // newPortForwardEntry returns a port forward entry.
@@ -53,17 +225,70 @@ func newPortForwardEntry(resourceVersion int, resource latestV1.PortForwardResou
 	}
 }
 
+// renewed returns a copy of the entry, to forward it again once it's terminated. The copy keeps the
+// resource, the pod and the options the entry was forwarded with, but none of its runtime state.
+func (p *portForwardEntry) renewed() *portForwardEntry {
+	entry := newPortForwardEntry(p.resourceVersion, p.resource, p.podName, p.containerName, p.portName, p.ownerReference, p.localPort, p.automaticPodForwarding)
+	entry.podUID = p.podUID
+	entry.restartCount = p.restartCount
+	entry.capturePath = p.capturePath
+	entry.tlsConfig = p.tlsConfig
+	entry.priority = p.priority
+	entry.bridgeInterface = p.bridgeInterface
+	entry.kubeContext = p.kubeContext
+	return entry
+}
+
 // key is an identifier for the lock on a port during the skaffold dev cycle.
 // if automaticPodForwarding is set, we return a key that doesn't include podName, since we want the key
-// to be the same whenever pods restart
+// to be the same whenever pods restart. The keys of pods of other kube-contexts are prefixed with their kube-context,
+// so that the same workloads deployed to several clusters don't collide.
 func (p *portForwardEntry) key() string {
 	if p.automaticPodForwarding {
-		return fmt.Sprintf("%s-%s-%s-%s-%s", p.ownerReference, p.containerName, p.resource.Namespace, p.portName, p.resource.Port.String())
+		key := fmt.Sprintf("%s-%s-%s-%s-%s", p.ownerReference, p.containerName, p.resource.Namespace, p.portName, p.resource.Port.String())
+		if p.kubeContext != "" {
+			key = p.kubeContext + "-" + key
+		}
+		return key
 	}
 	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
 }
 
+// newTLSConfig returns the configuration of TLS connections to the entry's resource.
+// Certificates are not verified, since in-cluster services rarely have certificates trusted locally.
+func (p *portForwardEntry) newTLSConfig() *tls.Config {
+	config := &tls.Config{
+		InsecureSkipVerify: true,
+	}
+	if strings.EqualFold(string(p.resource.Type), "service") {
+		config.ServerName = fmt.Sprintf("%s.%s.svc", p.resource.Name, p.resource.Namespace)
+	}
+	return config
+}
+
 // String is a utility function that returns the port forward entry as a user-readable string
 func (p *portForwardEntry) String() string {
 	return fmt.Sprintf("%s-%s-%s-%s", strings.ToLower(string(p.resource.Type)), p.resource.Name, p.resource.Namespace, p.resource.Port.String())
 }
+
+// snapshot copies the exported state of the entry.
+func (p *portForwardEntry) snapshot(ready bool) PortForwardEntry {
+	return PortForwardEntry{
+		Resource:         p.resource,
+		PodName:          p.podName,
+		ContainerName:    p.containerName,
+		PortName:         p.portName,
+		OwnerReference:   p.ownerReference,
+		LocalPort:        p.localPort,
+		Ready:            ready,
+		WebSocketURL:     p.webSocketURL,
+		Type:             p.forwardType,
+		InClusterAddress: p.inClusterAddress,
+		Iteration:        p.iteration,
+		CapturePath:      p.capturePath,
+		BridgeInterface:  p.bridgeInterface,
+		HostAlias:        p.hostAlias,
+		KubeContext:      p.kubeContext,
+		HealthPort:       p.health.port(),
+	}
+}
@@ -19,9 +19,12 @@ package portforward
 import (
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -92,6 +95,20 @@ func TestAutomaticPodForwardingKey(t *testing.T) {
 			}, "", "containerName", "portName", "owner", 0, true),
 			expected: "owner-containerName-default-portName-8080",
 		},
+		{
+			description: "entry for automatically port forwarded pod of another kube-context",
+			pfe: func() *portForwardEntry {
+				pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+					Type:      "pod",
+					Name:      "podName",
+					Namespace: "default",
+					Port:      schemautil.FromInt(8080),
+				}, "", "containerName", "portName", "owner", 0, true)
+				pfe.kubeContext = "cluster-b"
+				return pfe
+			}(),
+			expected: "cluster-b-owner-containerName-default-portName-8080",
+		},
 	}
 
 	for _, test := range tests {
@@ -108,3 +125,94 @@ func TestAutomaticPodForwardingKey(t *testing.T) {
 		})
 	}
 }
+
+func TestNewTLSConfig(t *testing.T) {
+	tests := []struct {
+		description        string
+		resourceType       latestV1.ResourceType
+		expectedServerName string
+	}{
+		{
+			description:        "service",
+			resourceType:       "service",
+			expectedServerName: "app.default.svc",
+		},
+		{
+			description:  "pod",
+			resourceType: "pod",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pfe := newPortForwardEntry(0, latestV1.PortForwardResource{
+				Type:         test.resourceType,
+				Name:         "app",
+				Namespace:    "default",
+				Port:         schemautil.FromInt(8443),
+				TerminateTLS: true,
+			}, "", "", "", "", 0, false)
+
+			config := pfe.newTLSConfig()
+			t.CheckTrue(config.InsecureSkipVerify)
+			t.CheckDeepEqual(test.expectedServerName, config.ServerName)
+		})
+	}
+}
+
+func TestActivePortForwards(t *testing.T) {
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	pod := latestV1.PortForwardResource{
+		Type:      constants.Pod,
+		Name:      "web-0",
+		Namespace: "default",
+		Port:      schemautil.FromString("http"),
+		Address:   "127.0.0.1",
+	}
+	service := latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      "db",
+		Namespace: "default",
+		Port:      schemautil.FromInt(5432),
+		Address:   "127.0.0.1",
+	}
+
+	actual := ActivePortForwards([]PortForwardEntry{
+		{Resource: pod, PodName: "web-0", ContainerName: "app", PortName: "http", LocalPort: 9000, Ready: true, ReadySince: now.Add(-90 * time.Second)},
+		{Resource: service, LocalPort: 9001},
+		{Resource: service, LocalPort: 9002, Disabled: true},
+	}, now)
+
+	testutil.CheckDeepEqual(t, &proto.ActivePortForwards{PortForwards: []*proto.ActivePortForward{
+		{
+			LocalPort:     9000,
+			PodName:       "web-0",
+			ContainerName: "app",
+			Namespace:     "default",
+			PortName:      "http",
+			ResourceType:  "pod",
+			ResourceName:  "web-0",
+			Address:       "127.0.0.1",
+			TargetPort:    &proto.IntOrString{Type: 1, StrVal: "http"},
+			State:         "Ready",
+			UptimeSeconds: 90,
+		},
+		{
+			LocalPort:    9001,
+			Namespace:    "default",
+			ResourceType: "service",
+			ResourceName: "db",
+			Address:      "127.0.0.1",
+			TargetPort:   &proto.IntOrString{IntVal: 5432},
+			State:        "Not Ready",
+		},
+		{
+			LocalPort:    9002,
+			Namespace:    "default",
+			ResourceType: "service",
+			ResourceName: "db",
+			Address:      "127.0.0.1",
+			TargetPort:   &proto.IntOrString{IntVal: 5432},
+			State:        "Disabled",
+		},
+	}}, actual)
+}
@@ -25,6 +25,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/util/wait"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubectl"
 	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
 	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
@@ -33,7 +34,7 @@ import (
 
 // SimulateDevCycle is used for testing a port forward + stop + restart in a simulated dev cycle
 func SimulateDevCycle(t *testing.T, kubectlCLI *kubectl.CLI, namespace string) {
-	em := NewEntryManager(NewKubectlForwarder(kubectlCLI))
+	em := NewEntryManager(NewKubectlForwarder(kubectlCLI, config.PortForwardOptions{}))
 	portForwardEventHandler := portForwardEvent
 	defer func() { portForwardEvent = portForwardEventHandler }()
 	portForwardEvent = func(entry *portForwardEntry) {}
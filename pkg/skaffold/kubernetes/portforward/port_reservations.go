@@ -0,0 +1,106 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+)
+
+// portReservations remembers the local port of each entry in a file, by entry key, so that
+// resources are forwarded to the same local ports across sessions when they are still free.
+// Pods discovered automatically are identified by their workload, so that new pods of a workload
+// get the local port of the previous ones.
+type portReservations struct {
+	path  string
+	ports map[string]int
+	lock  sync.Mutex
+}
+
+// newPortReservations loads the reservations of the given file, if it exists.
+func newPortReservations(path string) (*portReservations, error) {
+	r := &portReservations{
+		path:  path,
+		ports: map[string]int{},
+	}
+	content, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return r, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading port reservations %s: %w", path, err)
+	}
+	if len(content) > 0 {
+		if err := json.Unmarshal(content, &r.ports); err != nil {
+			return nil, fmt.Errorf("parsing port reservations %s: %w", path, err)
+		}
+	}
+	return r, nil
+}
+
+// get returns the local port reserved for an entry, if any.
+func (r *portReservations) get(key string) (int, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	port, found := r.ports[key]
+	return port, found && port > 0
+}
+
+// reserve records the local port of an entry, and saves the reservations if it changed.
+// Failing to save them only loses the reservation for the next session, so it's only logged.
+func (r *portReservations) reserve(key string, port int) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.ports[key] == port {
+		return
+	}
+	r.ports[key] = port
+	if err := r.write(); err != nil {
+		logrus.Warnf("saving port reservations: %v", err)
+	}
+}
+
+func (r *portReservations) write() error {
+	content, err := json.MarshalIndent(r.ports, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0755); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(r.path, append(content, '\n'), 0644)
+}
+
+// EnablePortReservations remembers the local port of each entry forwarded from now on in the given file,
+// and prefers the local port an entry was forwarded to in a previous session, if it's still free.
+// Entries with an explicit local port keep it. An error is returned if the file exists but can't be read.
+func (b *EntryManager) EnablePortReservations(path string) error {
+	r, err := newPortReservations(path)
+	if err != nil {
+		return err
+	}
+	b.portReservations = r
+	return nil
+}
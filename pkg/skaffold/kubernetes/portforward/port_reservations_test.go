@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestPortReservations(t *testing.T) {
+	service := latestV1.PortForwardResource{
+		Type:      constants.Service,
+		Name:      "web",
+		Namespace: "default",
+		Port:      schemautil.FromInt(8080),
+	}
+	explicit := service
+	explicit.Name = "api"
+	explicit.LocalPort = 7000
+
+	testutil.Run(t, "local ports are kept across sessions", func(t *testutil.T) {
+		path := t.NewTempDir().Write("ports.json", `{"service-api-default-8080": 9500}`).Path("ports.json")
+		nextPort := 9000
+		t.Override(&retrieveAvailablePort, func(_ string, req int, ps *util.PortSet) int {
+			// port 8080 is taken on the host
+			port := req
+			if req == 8080 {
+				port = nextPort
+				nextPort++
+			}
+			ps.Set(port)
+			return port
+		})
+
+		em := NewEntryManager(newTestForwarder())
+		t.CheckNoError(em.EnablePortReservations(path))
+		port, err := em.allocateLocalPort(newPortForwardEntry(0, service, "", "", "", "", 0, false), 8080)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(9000, port)
+		// explicit local ports take precedence over reservations
+		port, err = em.allocateLocalPort(newPortForwardEntry(0, explicit, "", "", "", "", 0, false), 7000)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(7000, port)
+		content, err := ioutil.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("{\n  \"service-api-default-8080\": 7000,\n  \"service-web-default-8080\": 9000\n}\n", string(content))
+
+		// next session
+		em = NewEntryManager(newTestForwarder())
+		t.CheckNoError(em.EnablePortReservations(path))
+		port, err = em.allocateLocalPort(newPortForwardEntry(0, service, "", "", "", "", 0, false), 8080)
+		t.CheckNoError(err)
+		t.CheckDeepEqual(9000, port)
+	})
+
+	testutil.Run(t, "missing reservations file is created", func(t *testutil.T) {
+		path := t.NewTempDir().Path("reservations/ports.json")
+		t.Override(&retrieveAvailablePort, func(_ string, req int, ps *util.PortSet) int {
+			ps.Set(req)
+			return req
+		})
+
+		em := NewEntryManager(newTestForwarder())
+		t.CheckNoError(em.EnablePortReservations(path))
+		_, err := em.allocateLocalPort(newPortForwardEntry(0, service, "", "", "", "", 0, false), 8080)
+		t.CheckNoError(err)
+
+		content, err := ioutil.ReadFile(path)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("{\n  \"service-web-default-8080\": 8080\n}\n", string(content))
+	})
+
+	testutil.Run(t, "invalid reservations file", func(t *testutil.T) {
+		path := t.NewTempDir().Write("ports.json", "{").Path("ports.json")
+
+		em := NewEntryManager(newTestForwarder())
+
+		t.CheckErrorContains("parsing port reservations", em.EnablePortReservations(path))
+	})
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+var (
+	// probeTimeout bounds how long dialing the local port of an entry may take.
+	probeTimeout = time.Second
+	// probeGracePeriod is how long a probe connection must stay open to be considered healthy.
+	// Tunnels close the connections they accept right away when the forwarded port refuses them.
+	probeGracePeriod = 100 * time.Millisecond
+	// probeInterval is the delay between the probes of an entry that isn't healthy yet.
+	probeInterval = time.Second
+)
+
+// entryProber is implemented by forwarders that check the health of their entries
+// in another way than dialing their local port.
+type entryProber interface {
+	probe(pfe *portForwardEntry) error
+}
+
+// probe checks that the connections to the entry reach the forwarded resource.
+func (b *EntryManager) probe(pfe *portForwardEntry) error {
+	if p, ok := b.entryForwarder.(entryProber); ok {
+		return p.probe(pfe)
+	}
+	return probeLocalPort(pfe)
+}
+
+// probeLocalPort dials the local port of the entry, and checks that the tunnel keeps the connection open.
+func probeLocalPort(pfe *portForwardEntry) error {
+	address := pfe.resource.Address
+	if address == "" || net.ParseIP(address).IsUnspecified() {
+		address = util.Loopback
+	}
+	conn, err := net.DialTimeout("tcp", net.JoinHostPort(address, strconv.Itoa(pfe.localPort)), probeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.SetReadDeadline(time.Now().Add(probeGracePeriod)); err != nil {
+		return err
+	}
+	_, err = conn.Read(make([]byte, 1))
+	var netErr net.Error
+	switch {
+	case err == nil, errors.As(err, &netErr) && netErr.Timeout():
+		// the resource either greeted the probe or is waiting for it to speak
+		return nil
+	case errors.Is(err, io.EOF):
+		return fmt.Errorf("connection to %s:%d closed by the tunnel", address, pfe.localPort)
+	default:
+		return err
+	}
+}
+
+// entryStateChanged records that an entry is ready once a probe through its tunnel succeeds,
+// and that it isn't ready right away. While the probe fails, it's retried until the entry
+// changes state again or stops being tracked.
+func (b *EntryManager) entryStateChanged(p *portForwardEntry, ready bool) {
+	b.readiness.lock.Lock()
+	if _, found := b.readiness.entries[p]; !found {
+		b.readiness.lock.Unlock()
+		return
+	}
+	stop := b.stopProbe(p)
+	if !ready {
+		b.setEntryState(p, false)
+		b.readiness.lock.Unlock()
+		return
+	}
+	b.readiness.probes[p] = stop
+	b.readiness.lock.Unlock()
+
+	err := b.probe(p)
+	if err == nil {
+		b.updateProbedEntryState(p, stop)
+		return
+	}
+	logrus.Debugf("port forward %v isn't healthy yet: %v", p, err)
+	interval := probeInterval
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(interval):
+			}
+			if err := b.probe(p); err != nil {
+				logrus.Debugf("port forward %v isn't healthy yet: %v", p, err)
+				continue
+			}
+			b.updateProbedEntryState(p, stop)
+			return
+		}
+	}()
+}
+
+// stopProbe stops probing the entry, and returns the channel that stops the next probe.
+// It must be called with the readiness lock held.
+func (b *EntryManager) stopProbe(p *portForwardEntry) chan struct{} {
+	if b.readiness.probes == nil {
+		b.readiness.probes = map[*portForwardEntry]chan struct{}{}
+	}
+	if stop, found := b.readiness.probes[p]; found {
+		close(stop)
+		delete(b.readiness.probes, p)
+	}
+	return make(chan struct{})
+}
+
+// updateProbedEntryState marks the entry ready, unless its state changed since the probe started.
+func (b *EntryManager) updateProbedEntryState(p *portForwardEntry, stop chan struct{}) {
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+
+	if b.readiness.probes[p] != stop {
+		return
+	}
+	delete(b.readiness.probes, p)
+	b.setEntryState(p, true)
+}
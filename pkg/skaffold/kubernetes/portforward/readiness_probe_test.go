@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestProbeLocalPort(t *testing.T) {
+	tests := []struct {
+		description string
+		handle      func(net.Conn)
+		stopped     bool
+		shouldErr   bool
+	}{
+		{
+			description: "connection kept open",
+			handle:      func(net.Conn) {},
+		},
+		{
+			description: "connection closed by the tunnel",
+			handle:      func(conn net.Conn) { conn.Close() },
+			shouldErr:   true,
+		},
+		{
+			description: "nothing listening",
+			stopped:     true,
+			shouldErr:   true,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			listener, err := net.Listen("tcp", net.JoinHostPort(util.Loopback, "0"))
+			t.CheckNoError(err)
+			defer listener.Close()
+			go func() {
+				for {
+					conn, err := listener.Accept()
+					if err != nil {
+						return
+					}
+					defer conn.Close()
+					test.handle(conn)
+				}
+			}()
+			if test.stopped {
+				listener.Close()
+			}
+
+			pfe := newPortForwardEntry(0, latestV1.PortForwardResource{}, "", "", "", "", listener.Addr().(*net.TCPAddr).Port, false)
+			err = probeLocalPort(pfe)
+
+			t.CheckError(test.shouldErr, err)
+		})
+	}
+}
+
+// probingForwarder fails the probes of its entries until it's healthy.
+type probingForwarder struct {
+	*testForwarder
+	lock    sync.Mutex
+	healthy bool
+	probes  int
+}
+
+func (f *probingForwarder) probe(*portForwardEntry) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.probes++
+	if !f.healthy {
+		return errors.New("connection refused")
+	}
+	return nil
+}
+
+func (f *probingForwarder) setHealthy() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.healthy = true
+}
+
+func TestEntryReadyOnceProbed(t *testing.T) {
+	entry := func() *portForwardEntry {
+		return newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Pod,
+			Name:      "resource",
+			Namespace: "default",
+		}, "", "", "", "", 9000, false)
+	}
+	ready := func(em *EntryManager, pfe *portForwardEntry) bool {
+		em.readiness.lock.Lock()
+		defer em.readiness.lock.Unlock()
+		return em.readiness.entries[pfe]
+	}
+	probing := func(em *EntryManager) int {
+		em.readiness.lock.Lock()
+		defer em.readiness.lock.Unlock()
+		return len(em.readiness.probes)
+	}
+
+	testutil.Run(t, "ready once the probe succeeds", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&probeInterval, 10*time.Millisecond)
+
+		forwarder := &probingForwarder{testForwarder: newTestForwarder()}
+		em := NewEntryManager(forwarder)
+		pfe := entry()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+		t.CheckFalse(ready(em, pfe))
+
+		forwarder.setHealthy()
+		t.CheckNoError(wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return ready(em, pfe), nil
+		}))
+		t.CheckDeepEqual(0, probing(em))
+	})
+
+	testutil.Run(t, "not ready reports stop the probes", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&probeInterval, time.Hour)
+
+		em := NewEntryManager(&probingForwarder{testForwarder: newTestForwarder()})
+		pfe := entry()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+		t.CheckDeepEqual(1, probing(em))
+
+		em.entryStateChanged(pfe, false)
+		t.CheckDeepEqual(0, probing(em))
+		t.CheckFalse(ready(em, pfe))
+	})
+
+	testutil.Run(t, "terminating an entry stops its probes", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&probeInterval, time.Hour)
+
+		em := NewEntryManager(&probingForwarder{testForwarder: newTestForwarder()})
+		pfe := entry()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+		em.Terminate(pfe)
+		t.CheckDeepEqual(0, probing(em))
+	})
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"sort"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+)
+
+// pausableForwarder is implemented by forwarders that can hold off forwarding the resources
+// that change while the dev loop rebuilds and redeploys, and catch up once it's done.
+type pausableForwarder interface {
+	pause()
+	resume()
+}
+
+// pause defers handling the pod events, while pods come and go during a rebuild,
+// until forwarding resumes. Only the latest event of each pod is kept.
+func (p *WatchingPodForwarder) pause() {
+	p.pauseLock.Lock()
+	defer p.pauseLock.Unlock()
+
+	p.paused = true
+}
+
+// resume handles the events deferred while paused, in a single batch, and the following ones as they come.
+// It returns once the deferred events are handled, so that the pods they forward are listed right after.
+func (p *WatchingPodForwarder) resume() {
+	p.pauseLock.Lock()
+	if !p.paused {
+		p.pauseLock.Unlock()
+		return
+	}
+	p.paused = false
+	loopDone := p.loopDone
+	p.pauseLock.Unlock()
+
+	if loopDone == nil {
+		// not started yet, so no event was deferred
+		return
+	}
+	handled := make(chan struct{})
+	select {
+	case p.resumed <- handled:
+		<-handled
+	case <-loopDone:
+	}
+}
+
+// deferWhilePaused records the event of a pod to handle it once forwarding resumes, and returns true if it's paused.
+func (p *WatchingPodForwarder) deferWhilePaused(evt kubernetes.PodEvent) bool {
+	p.pauseLock.Lock()
+	defer p.pauseLock.Unlock()
+
+	if !p.paused {
+		return false
+	}
+	p.deferredEvents[contextPodKey(evt.KubeContext, evt.Pod)] = evt
+	return true
+}
+
+// reconcileDeferred handles the latest events of the pods changed while paused, ordered by pod.
+func (p *WatchingPodForwarder) reconcileDeferred(ctx context.Context, handled chan struct{}) {
+	defer close(handled)
+
+	p.pauseLock.Lock()
+	if p.paused {
+		// paused again before the event loop woke up
+		p.pauseLock.Unlock()
+		return
+	}
+	deferred := p.deferredEvents
+	p.deferredEvents = map[string]kubernetes.PodEvent{}
+	p.pauseLock.Unlock()
+
+	if len(deferred) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(deferred))
+	for key := range deferred {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	logrus.Debugf("forwarding the %d pods changed while port forwarding was paused", len(keys))
+	for _, key := range keys {
+		p.handlePodEvent(ctx, deferred[key])
+	}
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"sort"
+	"testing"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestPodForwarderPausedDuringRebuild(t *testing.T) {
+	testutil.Run(t, "pods changed while paused are forwarded once resumed", func(t *testutil.T) {
+		pod := func(name, version string, phase v1.PodPhase) *v1.Pod {
+			return &v1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: version},
+				Spec: v1.PodSpec{Containers: []v1.Container{{
+					Name:  "mycontainer",
+					Image: "image",
+					Ports: []v1.ContainerPort{{Name: "myport", ContainerPort: 8080}},
+				}}},
+				Status: v1.PodStatus{Phase: phase},
+			}
+		}
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080, 8081, 8082}))
+		t.Override(&topLevelOwnerKey, func(_ context.Context, obj metav1.Object, _ string) string { return obj.GetName() })
+		t.Override(&newPodWatcher, func(kubernetes.PodSelector, fields.Selector) kubernetes.PodWatcher {
+			return &fakePodWatcher{
+				events: []kubernetes.PodEvent{
+					{Type: watch.Added, Pod: pod("app-a", "1", v1.PodRunning)},
+					{Type: watch.Added, Pod: pod("app-b", "2", v1.PodRunning)},
+					{Type: watch.Added, Pod: pod("app-c", "3", v1.PodPending)},
+					{Type: watch.Deleted, Pod: pod("app-b", "4", v1.PodRunning)},
+					{Type: watch.Modified, Pod: pod("app-c", "5", v1.PodRunning)},
+				},
+			}
+		})
+
+		imageList := kubernetes.NewImageList()
+		imageList.Add("image")
+		fakeForwarder := newTestForwarder()
+		p := NewWatchingPodForwarder(NewEntryManager(fakeForwarder), []kubernetes.PodSelector{imageList}, allPorts, fields.Everything(), labels.Everything())
+		forwarded := func() []string {
+			var names []string
+			for _, entry := range fakeForwarder.forwardedResources.Values() {
+				names = append(names, entry.podName)
+			}
+			sort.Strings(names)
+			return names
+		}
+		deferred := func() int {
+			p.pauseLock.Lock()
+			defer p.pauseLock.Unlock()
+			return len(p.deferredEvents)
+		}
+
+		p.pause()
+		t.CheckNoError(p.Start(context.Background(), ioutil.Discard, nil))
+
+		// only the latest event of each pod is kept
+		t.CheckNoError(wait.PollImmediate(10*time.Millisecond, time.Second, func() (bool, error) {
+			return deferred() == 3, nil
+		}))
+		t.CheckEmpty(forwarded())
+
+		// the deferred events are handled by the time it's resumed
+		p.resume()
+		t.CheckDeepEqual([]string{"app-a", "app-c"}, forwarded())
+		t.CheckDeepEqual(0, deferred())
+
+		// resuming again is a no-op
+		p.resume()
+		t.CheckDeepEqual([]string{"app-a", "app-c"}, forwarded())
+	})
+
+	testutil.Run(t, "resuming a forwarder that isn't started returns", func(t *testutil.T) {
+		p := NewWatchingPodForwarder(NewEntryManager(newTestForwarder()), nil, allPorts, fields.Everything(), labels.Everything())
+		p.pause()
+		p.resume()
+		t.CheckFalse(p.deferWhilePaused(kubernetes.PodEvent{Type: watch.Added, Pod: &v1.Pod{}}))
+	})
+}
+
+func TestForwarderManagerPause(t *testing.T) {
+	tests := []struct {
+		description         string
+		pauseDuringRebuilds bool
+	}{
+		{description: "paused during rebuilds", pauseDuringRebuilds: true},
+		{description: "not paused during rebuilds"},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			p := NewWatchingPodForwarder(NewEntryManager(newTestForwarder()), nil, allPorts, fields.Everything(), labels.Everything())
+			manager := &ForwarderManager{forwarders: []Forwarder{p}, pauseDuringRebuilds: test.pauseDuringRebuilds}
+
+			manager.Pause()
+			t.CheckDeepEqual(test.pauseDuringRebuilds, p.deferWhilePaused(kubernetes.PodEvent{Type: watch.Added, Pod: &v1.Pod{}}))
+			manager.Resume()
+			t.CheckFalse(p.deferWhilePaused(kubernetes.PodEvent{Type: watch.Added, Pod: &v1.Pod{}}))
+		})
+	}
+}
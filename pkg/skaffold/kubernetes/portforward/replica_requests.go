@@ -0,0 +1,203 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ReplicaSelector picks a replica of a workload to forward: the pod whose IPv4 address ends with an octet,
+// or the pod at a 1-based index among the Ready replicas ordered by name. Exactly one of them must be set.
+type ReplicaSelector struct {
+	// IPSuffix is the last octet of the IP of the pod to forward.
+	IPSuffix int
+	// Index is the 1-based index of the pod to forward among the Ready replicas, ordered by name.
+	Index int
+}
+
+func (s ReplicaSelector) validate() error {
+	switch {
+	case s.IPSuffix != 0 && s.Index != 0:
+		return errors.New("a replica is selected by either its IP suffix or its index, not both")
+	case s.IPSuffix < 0 || s.IPSuffix > 255:
+		return fmt.Errorf("IP suffix %d is not an octet", s.IPSuffix)
+	case s.Index < 0:
+		return fmt.Errorf("replica index %d is not 1-based", s.Index)
+	case s.IPSuffix == 0 && s.Index == 0:
+		return errors.New("a replica is selected by its IP suffix or its index")
+	}
+	return nil
+}
+
+func (s ReplicaSelector) String() string {
+	if s.IPSuffix != 0 {
+		return fmt.Sprintf("with IP suffix .%d", s.IPSuffix)
+	}
+	return fmt.Sprintf("#%d", s.Index)
+}
+
+// replicaRequest is a container port requested to be forwarded from whichever replica of a workload
+// the selector currently picks. It's only accessed from the event loop.
+type replicaRequest struct {
+	namespace string
+	// owner is the workload, as "<kind>/<name>", eg. "deployment/app".
+	owner    string
+	selector ReplicaSelector
+	port     int32
+	// podName is the name of the replica currently forwarded, if any.
+	podName string
+}
+
+func (r *replicaRequest) String() string {
+	return fmt.Sprintf("replica %s of %s in namespace %q", r.selector, r.owner, r.namespace)
+}
+
+// forwardRequestedReplica forwards a container port of the replica of a workload picked by a selector,
+// and keeps forwarding it from the replica picked by the same selector when replicas are replaced.
+func (p *WatchingPodForwarder) forwardRequestedReplica(ctx context.Context, req portRequest) (int, error) {
+	if err := req.replica.validate(); err != nil {
+		return 0, err
+	}
+	request := &replicaRequest{namespace: req.namespace, owner: req.owner, selector: req.replica, port: req.port}
+	for _, r := range p.replicaRequests {
+		if r.namespace == request.namespace && strings.EqualFold(r.owner, request.owner) && r.selector == request.selector && r.port == request.port {
+			request = r
+		}
+	}
+
+	pod, found := p.resolveReplica(ctx, request)
+	if !found {
+		return 0, fmt.Errorf("no running pod is %s", request)
+	}
+	if request.podName != "" && request.podName != pod.Name {
+		p.unrequestPort(request.namespace, request.podName, request.port)
+		request.podName = ""
+	}
+	localPort, err := p.forwardRequested(ctx, portRequest{namespace: pod.Namespace, podName: pod.Name, port: req.port})
+	if err != nil {
+		return 0, err
+	}
+
+	if !p.hasReplicaRequest(request) {
+		p.replicaRequests = append(p.replicaRequests, request)
+	}
+	request.podName = pod.Name
+	return localPort, nil
+}
+
+func (p *WatchingPodForwarder) hasReplicaRequest(request *replicaRequest) bool {
+	for _, r := range p.replicaRequests {
+		if r == request {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveReplicas forwards the requested ports of replicas from the pods their selectors pick now,
+// eg. after a replica was replaced, and stops forwarding them from the pods they don't pick anymore.
+func (p *WatchingPodForwarder) resolveReplicas(ctx context.Context) {
+	for _, r := range p.replicaRequests {
+		pod, found := p.resolveReplica(ctx, r)
+		if found && pod.Name == r.podName {
+			continue
+		}
+
+		if r.podName != "" {
+			logrus.Debugf("pod/%s is not %s anymore", r.podName, r)
+			p.unrequestPort(r.namespace, r.podName, r.port)
+			r.podName = ""
+		}
+		if !found {
+			continue
+		}
+		if _, err := p.forwardRequested(ctx, portRequest{namespace: pod.Namespace, podName: pod.Name, port: r.port}); err != nil {
+			logrus.Warnf("port forwarding %s failed: %s", r, err)
+			continue
+		}
+		logrus.Debugf("forwarding pod/%s as %s", pod.Name, r)
+		r.podName = pod.Name
+	}
+}
+
+// unrequestPort stops forwarding a requested container port of a pod.
+func (p *WatchingPodForwarder) unrequestPort(namespace, podName string, port int32) {
+	key := contextPodKey("", &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: podName}})
+	delete(p.requestedPorts[key], port)
+	for _, entry := range p.entryManager.forwardedResources.Values() {
+		if entry.automaticPodForwarding && entry.kubeContext == "" && entry.podName == podName && entry.resource.Namespace == namespace && entry.resource.Port.IntVal == int(port) {
+			p.entryManager.Terminate(entry)
+		}
+	}
+}
+
+// resolveReplica returns the running pod of the request's workload picked by its selector.
+func (p *WatchingPodForwarder) resolveReplica(ctx context.Context, r *replicaRequest) (*v1.Pod, bool) {
+	var replicas []*v1.Pod
+	for _, pod := range p.runningPods {
+		if pod.Namespace == r.namespace && p.isOwnedBy(ctx, pod, r.owner) {
+			replicas = append(replicas, pod)
+		}
+	}
+	return pickReplica(replicas, r.selector)
+}
+
+// isOwnedBy returns true if the top level owner of a pod is the given workload, as "<kind>/<name>".
+// The owners of pods are cached, since finding them takes calls to the API server.
+func (p *WatchingPodForwarder) isOwnedBy(ctx context.Context, pod *v1.Pod, owner string) bool {
+	key := contextPodKey("", pod)
+	ownerReference, found := p.podOwners[key]
+	if !found {
+		ownerReference = topLevelOwnerKey(ctx, pod, pod.Kind)
+		p.podOwners[key] = ownerReference
+	}
+	return strings.EqualFold(ownerReference, strings.Replace(owner, "/", "-", 1))
+}
+
+// pickReplica returns the replica picked by a selector.
+func pickReplica(replicas []*v1.Pod, selector ReplicaSelector) (*v1.Pod, bool) {
+	if selector.IPSuffix != 0 {
+		suffix := "." + strconv.Itoa(selector.IPSuffix)
+		for _, pod := range replicas {
+			if strings.HasSuffix(pod.Status.PodIP, suffix) {
+				return pod, true
+			}
+		}
+		return nil, false
+	}
+
+	var ready []*v1.Pod
+	for _, pod := range replicas {
+		if isPodReady(pod) {
+			ready = append(ready, pod)
+		}
+	}
+	if selector.Index > len(ready) {
+		return nil, false
+	}
+	sort.Slice(ready, func(i, j int) bool { return ready[i].Name < ready[j].Name })
+	return ready[selector.Index-1], true
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/watch"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func replicaPod(name, ip string, ready bool) *v1.Pod {
+	readyStatus := v1.ConditionFalse
+	if ready {
+		readyStatus = v1.ConditionTrue
+	}
+	return &v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", ResourceVersion: "1"},
+		Spec: v1.PodSpec{Containers: []v1.Container{{
+			Name:  "mycontainer",
+			Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+		}}},
+		Status: v1.PodStatus{
+			Phase:      v1.PodRunning,
+			PodIP:      ip,
+			Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: readyStatus}},
+		},
+	}
+}
+
+func TestPickReplica(t *testing.T) {
+	replicas := []*v1.Pod{
+		replicaPod("app-c", "10.0.0.17", true),
+		replicaPod("app-a", "10.0.0.117", true),
+		replicaPod("app-b", "10.0.0.7", false),
+		replicaPod("app-d", "10.0.0.8", true),
+	}
+	tests := []struct {
+		description string
+		selector    ReplicaSelector
+		expected    string
+	}{
+		{description: "by IP suffix", selector: ReplicaSelector{IPSuffix: 17}, expected: "app-c"},
+		{description: "by IP suffix of a pod that isn't Ready", selector: ReplicaSelector{IPSuffix: 7}, expected: "app-b"},
+		{description: "no pod with IP suffix", selector: ReplicaSelector{IPSuffix: 9}},
+		{description: "first Ready replica", selector: ReplicaSelector{Index: 1}, expected: "app-a"},
+		{description: "replicas that aren't Ready are skipped", selector: ReplicaSelector{Index: 3}, expected: "app-d"},
+		{description: "index out of range", selector: ReplicaSelector{Index: 4}},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			pod, found := pickReplica(replicas, test.selector)
+
+			t.CheckDeepEqual(test.expected != "", found)
+			if found {
+				t.CheckDeepEqual(test.expected, pod.Name)
+			}
+		})
+	}
+}
+
+func TestReplicaSelectorValidate(t *testing.T) {
+	tests := []struct {
+		description string
+		selector    ReplicaSelector
+		shouldErr   bool
+	}{
+		{description: "IP suffix", selector: ReplicaSelector{IPSuffix: 17}},
+		{description: "index", selector: ReplicaSelector{Index: 2}},
+		{description: "none", shouldErr: true},
+		{description: "both", selector: ReplicaSelector{IPSuffix: 17, Index: 2}, shouldErr: true},
+		{description: "not an octet", selector: ReplicaSelector{IPSuffix: 256}, shouldErr: true},
+		{description: "negative index", selector: ReplicaSelector{Index: -1}, shouldErr: true},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.CheckError(test.shouldErr, test.selector.validate())
+		})
+	}
+}
+
+func TestPodForwarderReplicaRequest(t *testing.T) {
+	testutil.Run(t, "the requested replica is forwarded again once it's replaced", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		t.Override(&retrieveAvailablePort, mockRetrieveAvailablePort(util.Loopback, map[int]struct{}{}, []int{8080, 8081, 8082}))
+		t.Override(&topLevelOwnerKey, func(_ context.Context, obj metav1.Object, _ string) string {
+			return "Deployment-" + strings.SplitN(obj.GetName(), "-", 2)[0]
+		})
+
+		fakeForwarder := newTestForwarder()
+		p := NewWatchingPodForwarder(NewEntryManager(fakeForwarder), []kubernetes.PodSelector{kubernetes.NewImageList()}, allPorts, fields.Everything(), labels.Everything())
+		p.forwardOnRequest()
+		p.output = ioutil.Discard
+		forwarded := func() []string {
+			var names []string
+			for _, entry := range fakeForwarder.forwardedResources.Values() {
+				names = append(names, entry.podName)
+			}
+			return names
+		}
+		ctx := context.Background()
+		track := func(eventType watch.EventType, pod *v1.Pod) {
+			p.trackRunningPod(kubernetes.PodEvent{Type: eventType, Pod: pod})
+			p.resolveReplicas(ctx)
+		}
+
+		track(watch.Added, replicaPod("app-a", "10.0.0.5", true))
+		track(watch.Added, replicaPod("app-b", "10.0.0.6", true))
+		track(watch.Added, replicaPod("db-a", "10.0.0.7", true))
+
+		_, err := p.requestReplicaForwardNow(ctx, "deployment/app", ReplicaSelector{IPSuffix: 7})
+		t.CheckErrorContains("no running pod is replica with IP suffix .7 of deployment/app", err)
+		_, err = p.requestReplicaForwardNow(ctx, "deployment/app", ReplicaSelector{Index: 2})
+		t.CheckNoError(err)
+		t.CheckDeepEqual([]string{"app-b"}, forwarded())
+
+		// the same request doesn't forward another replica
+		_, err = p.requestReplicaForwardNow(ctx, "Deployment/app", ReplicaSelector{Index: 2})
+		t.CheckNoError(err)
+		t.CheckDeepEqual(1, len(p.replicaRequests))
+
+		// the second Ready replica is forwarded in place of the one that's replaced
+		track(watch.Deleted, replicaPod("app-b", "10.0.0.6", true))
+		t.CheckEmpty(forwarded())
+		track(watch.Added, replicaPod("app-c", "10.0.0.8", false))
+		t.CheckEmpty(forwarded())
+		track(watch.Modified, replicaPod("app-c", "10.0.0.8", true))
+		t.CheckDeepEqual([]string{"app-c"}, forwarded())
+
+		// a new replica ordered first shifts the index
+		track(watch.Added, replicaPod("app-0", "10.0.0.9", true))
+		t.CheckDeepEqual([]string{"app-a"}, forwarded())
+	})
+}
+
+// requestReplicaForwardNow handles a replica request of port 8080 in namespace "default" without the event loop.
+func (p *WatchingPodForwarder) requestReplicaForwardNow(ctx context.Context, owner string, replica ReplicaSelector) (int, error) {
+	return p.forwardRequestedReplica(ctx, portRequest{namespace: "default", owner: owner, replica: replica, port: 8080})
+}
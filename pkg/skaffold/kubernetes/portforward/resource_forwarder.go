@@ -135,20 +135,34 @@ func (p *ResourceForwarder) portForwardResources(ctx context.Context, resources
 
 func (p *ResourceForwarder) portForwardResource(ctx context.Context, resource latestV1.PortForwardResource) {
 	// Get port forward entry for this resource
-	entry := p.getCurrentEntry(resource)
+	entry, err := p.getCurrentEntry(resource)
+	if err != nil {
+		logrus.Warnf("port forwarding %s/%s failed: %s", resource.Type, resource.Name, err)
+		return
+	}
 	// Forward the entry
 	p.entryManager.forwardPortForwardEntry(ctx, p.output, entry)
 }
 
-func (p *ResourceForwarder) getCurrentEntry(resource latestV1.PortForwardResource) *portForwardEntry {
+func (p *ResourceForwarder) getCurrentEntry(resource latestV1.PortForwardResource) (*portForwardEntry, error) {
+	var bridgeInterface string
+	if resource.BridgeInterface != "" {
+		iface, address, err := resolveBridgeInterface(resource.BridgeInterface)
+		if err != nil {
+			return nil, err
+		}
+		bridgeInterface, resource.Address = iface, address
+	}
+
 	// determine if we have seen this before
 	entry := newPortForwardEntry(0, resource, "", "", "", "", 0, false)
+	entry.bridgeInterface = bridgeInterface
 
 	// If we have, return the current entry
 	oldEntry, ok := p.entryManager.forwardedResources.Load(entry.key())
 	if ok {
 		entry.localPort = oldEntry.localPort
-		return entry
+		return entry, nil
 	}
 
 	// Try to request matching local port *providing* that it is not a system port.
@@ -157,8 +171,16 @@ func (p *ResourceForwarder) getCurrentEntry(resource latestV1.PortForwardResourc
 	if requestPort == 0 && resource.Port.IntVal >= 1024 {
 		requestPort = resource.Port.IntVal
 	}
-	entry.localPort = retrieveAvailablePort(resource.Address, requestPort, &p.entryManager.forwardedPorts)
-	return entry
+	localPort, err := p.entryManager.allocateLocalPort(entry, requestPort)
+	if err != nil {
+		return nil, err
+	}
+	entry.localPort = localPort
+	if bridgeInterface != "" {
+		logrus.Warnf("%s/%s is forwarded to %s:%d on bridge interface %s: it's reachable by every container on that network, and by any host that can route to it",
+			resource.Type, resource.Name, resource.Address, localPort, bridgeInterface)
+	}
+	return entry, nil
 }
 
 // retrieveServiceResources retrieves all services in the cluster matching the given label
@@ -63,6 +63,8 @@ func (f *testForwarder) Terminate(pfe *portForwardEntry) {
 
 func (f *testForwarder) Start(io.Writer) {}
 
+func (f *testForwarder) probe(*portForwardEntry) error { return nil }
+
 func newTestForwarder() *testForwarder {
 	return &testForwarder{}
 }
@@ -214,7 +216,8 @@ func TestGetCurrentEntryFunc(t *testing.T) {
 				resources: test.forwardedResources,
 			}
 			rf := NewServicesForwarder(entryManager, "")
-			actualEntry := rf.getCurrentEntry(test.resource)
+			actualEntry, err := rf.getCurrentEntry(test.resource)
+			t.CheckNoError(err)
 
 			expectedEntry := test.expected
 			expectedEntry.resource = test.resource
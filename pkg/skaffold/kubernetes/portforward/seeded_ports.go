@@ -0,0 +1,86 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"math/rand"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+const (
+	// seeded ports are drawn from [seededPortMin, seededPortMin+seededPortRange), below the ephemeral
+	// ports of most systems, so that they don't depend on the connections open on the host.
+	seededPortMin   = 20000
+	seededPortRange = 10000
+	// maxSeededTries is how many ports are drawn before giving up on finding an available one.
+	maxSeededTries = 100
+)
+
+// seededPorts allocates local ports like retrieveAvailablePort, except that ports are drawn from
+// a pseudo-random sequence when the requested port isn't available, instead of being picked by the
+// system. Sessions with the same seed and the same requests get the same local ports.
+type seededPorts struct {
+	rand *rand.Rand
+	lock sync.Mutex
+}
+
+func newSeededPorts(seed int64) *seededPorts {
+	return &seededPorts{rand: rand.New(rand.NewSource(seed))}
+}
+
+// availablePort returns the requested port if it's available, or the next available port of the sequence.
+// It returns -1 if none is.
+func (s *seededPorts) availablePort(address string, port int, usedPorts *util.PortSet) int {
+	if port > 0 && takePort(address, port, usedPorts) {
+		return port
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	for i := 0; i < maxSeededTries; i++ {
+		p := seededPortMin + s.rand.Intn(seededPortRange)
+		if takePort(address, p, usedPorts) {
+			logrus.Debugf("found open port: %d", p)
+			return p
+		}
+	}
+	return -1
+}
+
+// takePort marks the port as used, if it's neither used already nor taken on the host.
+func takePort(address string, port int, usedPorts *util.PortSet) bool {
+	if usedPorts.LoadOrSet(port) {
+		return false
+	}
+	if !isPortFree(address, port) {
+		usedPorts.Delete(port)
+		return false
+	}
+	return true
+}
+
+// SetPortSeed makes the choice of local ports reproducible: when the port a resource requests isn't
+// available, its local port is drawn from a sequence generated by the given seed rather than picked
+// by the system. By default, local ports aren't seeded.
+func (b *EntryManager) SetPortSeed(seed int64) {
+	b.seededPorts = newSeededPorts(seed)
+}
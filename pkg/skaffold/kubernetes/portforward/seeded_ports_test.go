@@ -0,0 +1,85 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSeededPorts(t *testing.T) {
+	resource := func(name string, port int) latestV1.PortForwardResource {
+		return latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      name,
+			Namespace: "default",
+			Port:      schemautil.FromInt(port),
+		}
+	}
+	allocate := func(t *testutil.T, seed int64) []int {
+		em := NewEntryManager(newTestForwarder())
+		em.SetPortSeed(seed)
+		var ports []int
+		for _, r := range []latestV1.PortForwardResource{resource("web", 8080), resource("api", 8080), resource("db", 0), resource("cache", 6379)} {
+			port, err := em.pickLocalPort(r, int(r.Port.IntVal))
+			t.CheckNoError(err)
+			ports = append(ports, port)
+		}
+		return ports
+	}
+
+	testutil.Run(t, "same seed, same ports", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return true })
+
+		first := allocate(t, 42)
+		second := allocate(t, 42)
+
+		t.CheckDeepEqual(first, second)
+		t.CheckDeepEqual(8080, first[0])
+		t.CheckDeepEqual(6379, first[3])
+		for _, port := range first[1:3] {
+			t.CheckTrue(port >= seededPortMin && port < seededPortMin+seededPortRange)
+		}
+	})
+
+	testutil.Run(t, "ports taken on the host are skipped", func(t *testutil.T) {
+		taken := map[int]bool{}
+		t.Override(&isPortFree, func(_ string, port int) bool { return !taken[port] })
+		taken[8080] = true
+		taken[allocate(t, 7)[0]] = true
+
+		ports := allocate(t, 7)
+
+		t.CheckFalse(taken[ports[0]])
+		t.CheckFalse(taken[ports[1]])
+		t.CheckTrue(ports[0] != ports[1])
+	})
+
+	testutil.Run(t, "no port available", func(t *testutil.T) {
+		t.Override(&isPortFree, func(string, int) bool { return false })
+
+		em := NewEntryManager(newTestForwarder())
+		em.SetPortSeed(42)
+		_, err := em.pickLocalPort(resource("web", 8080), 8080)
+
+		t.CheckError(true, err)
+	})
+}
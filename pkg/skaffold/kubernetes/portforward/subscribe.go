@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"sync"
+)
+
+// ForwardStateChangeType is the kind of change of a port forward.
+type ForwardStateChangeType string
+
+const (
+	// ForwardAdded is sent when an entry starts being forwarded, before its tunnel is established.
+	ForwardAdded ForwardStateChangeType = "Added"
+	// ForwardReady is sent once the tunnel of an entry is established.
+	ForwardReady ForwardStateChangeType = "Ready"
+	// ForwardNotReady is sent when the tunnel of an entry is lost, eg. while it's re-established.
+	ForwardNotReady ForwardStateChangeType = "NotReady"
+	// ForwardRemoved is sent when an entry is terminated or disabled.
+	ForwardRemoved ForwardStateChangeType = "Removed"
+)
+
+// subscriptionBufferSize is the number of changes kept for a subscriber that doesn't keep up.
+const subscriptionBufferSize = 64
+
+// ForwardStateChange is a change of a port forward, along with the port forward's state after it.
+type ForwardStateChange struct {
+	Type  ForwardStateChangeType
+	Entry PortForwardEntry
+}
+
+type subscriptions struct {
+	channels []chan ForwardStateChange
+	lock     sync.Mutex
+}
+
+// Subscribe returns a channel receiving every port forward that's added, removed, or whose readiness changes.
+// Changes are sent in order, but a subscriber that doesn't keep up loses the oldest ones rather than
+// blocking port forwarding. The channel is closed by Unsubscribe.
+func (b *EntryManager) Subscribe() <-chan ForwardStateChange {
+	b.subscriptions.lock.Lock()
+	defer b.subscriptions.lock.Unlock()
+
+	ch := make(chan ForwardStateChange, subscriptionBufferSize)
+	b.subscriptions.channels = append(b.subscriptions.channels, ch)
+	return ch
+}
+
+// Unsubscribe stops sending changes to a channel returned by Subscribe, and closes it.
+func (b *EntryManager) Unsubscribe(ch <-chan ForwardStateChange) {
+	b.subscriptions.lock.Lock()
+	defer b.subscriptions.lock.Unlock()
+
+	for i, c := range b.subscriptions.channels {
+		if c == ch {
+			b.subscriptions.channels = append(b.subscriptions.channels[:i], b.subscriptions.channels[i+1:]...)
+			close(c)
+			return
+		}
+	}
+}
+
+// publish sends a change to every subscriber, dropping the oldest change of subscribers whose buffer is full.
+// It must be called with the readiness lock held so that changes are sent in order.
+func (b *EntryManager) publish(changeType ForwardStateChangeType, p *portForwardEntry) {
+	b.subscriptions.lock.Lock()
+	defer b.subscriptions.lock.Unlock()
+
+	if len(b.subscriptions.channels) == 0 {
+		return
+	}
+	change := ForwardStateChange{
+		Type:  changeType,
+		Entry: p.snapshot(b.readiness.entries[p]),
+	}
+	change.Entry.ReadySince = b.readiness.since[p]
+	change.Entry.Disabled = b.disabled.has(p.key())
+	for _, ch := range b.subscriptions.channels {
+		for sent := false; !sent; {
+			select {
+			case ch <- change:
+				sent = true
+			default:
+				select {
+				case <-ch:
+				default:
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestSubscribe(t *testing.T) {
+	entry := func(localPort int) *portForwardEntry {
+		return newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "svc",
+			Namespace: "default",
+			Port:      schemautil.FromInt(localPort),
+		}, "", "", "", "", localPort, false)
+	}
+	received := func(ch <-chan ForwardStateChange) []ForwardStateChangeType {
+		var types []ForwardStateChangeType
+		for {
+			select {
+			case change := <-ch:
+				types = append(types, change.Type)
+			default:
+				return types
+			}
+		}
+	}
+
+	testutil.Run(t, "changes are streamed in order", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		readySince := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		t.Override(&timeNow, func() time.Time { return readySince })
+
+		em := NewEntryManager(newTestForwarder())
+		ch := em.Subscribe()
+
+		pfe := entry(9000)
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, pfe)
+		added, ready := <-ch, <-ch
+		t.CheckDeepEqual(ForwardAdded, added.Type)
+		t.CheckDeepEqual(9000, added.Entry.LocalPort)
+		t.CheckFalse(added.Entry.Ready)
+		t.CheckDeepEqual(ForwardReady, ready.Type)
+		t.CheckTrue(ready.Entry.Ready)
+		t.CheckDeepEqual(readySince, ready.Entry.ReadySince)
+
+		em.updateEntryState(pfe, false)
+		em.Terminate(pfe)
+		t.CheckDeepEqual([]ForwardStateChangeType{ForwardNotReady, ForwardRemoved}, received(ch))
+	})
+
+	testutil.Run(t, "oldest changes are dropped for slow subscribers", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		ch := em.Subscribe()
+		for i := 0; i < subscriptionBufferSize; i++ {
+			em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry(9000+i))
+		}
+
+		// each entry is added then ready: only the changes of the last half of the entries are kept
+		first, last := <-ch, ForwardStateChange{}
+		for i := 1; i < subscriptionBufferSize; i++ {
+			last = <-ch
+		}
+		t.CheckDeepEqual(ForwardAdded, first.Type)
+		t.CheckDeepEqual(9000+subscriptionBufferSize/2, first.Entry.LocalPort)
+		t.CheckDeepEqual(ForwardReady, last.Type)
+		t.CheckDeepEqual(9000+subscriptionBufferSize-1, last.Entry.LocalPort)
+		t.CheckEmpty(received(ch))
+	})
+
+	testutil.Run(t, "unsubscribe closes the channel", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+
+		em := NewEntryManager(newTestForwarder())
+		ch := em.Subscribe()
+		other := em.Subscribe()
+		em.Unsubscribe(ch)
+
+		_, open := <-ch
+		t.CheckFalse(open)
+
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry(9000))
+		t.CheckDeepEqual([]ForwardStateChangeType{ForwardAdded, ForwardReady}, received(other))
+	})
+}
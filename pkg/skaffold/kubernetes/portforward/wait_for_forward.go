@@ -0,0 +1,57 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+)
+
+// WaitForForward blocks until the tunnel of the port forward identified by key is established,
+// and returns its local port. It returns immediately if the port forward is already ready,
+// and with the context's error if the context is done first.
+// Keys are `<type>-<name>-<namespace>-<port>` for the resources of the config, eg. `service-web-default-8080`,
+// and `<owner>-<container>-<namespace>-<port name>-<port>` for the container ports of pods forwarded automatically,
+// prefixed with `<kube-context>-` for the pods of other kube-contexts than the current one.
+func (b *EntryManager) WaitForForward(ctx context.Context, key string) (localPort int, err error) {
+	// subscribe before checking the current state, so that no change is missed in between.
+	changes := b.Subscribe()
+	defer b.Unsubscribe(changes)
+
+	for {
+		if localPort, ready := b.readyLocalPort(key); ready {
+			return localPort, nil
+		}
+		select {
+		case <-ctx.Done():
+			return 0, fmt.Errorf("waiting for port forward %s: %w", key, ctx.Err())
+		case <-changes:
+		}
+	}
+}
+
+// readyLocalPort returns the local port of the port forward identified by key, if its tunnel is established.
+func (b *EntryManager) readyLocalPort(key string) (int, bool) {
+	entry, found := b.forwardedResources.Load(key)
+	if !found {
+		return 0, false
+	}
+
+	b.readiness.lock.Lock()
+	defer b.readiness.lock.Unlock()
+	return entry.localPort, b.readiness.entries[entry]
+}
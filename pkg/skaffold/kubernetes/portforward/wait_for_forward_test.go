@@ -0,0 +1,84 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+	testEvent "github.com/GoogleContainerTools/skaffold/testutil/event"
+)
+
+func TestWaitForForward(t *testing.T) {
+	newEntry := func() *portForwardEntry {
+		return newPortForwardEntry(0, latestV1.PortForwardResource{
+			Type:      constants.Service,
+			Name:      "web",
+			Namespace: "default",
+			Port:      schemautil.FromInt(8080),
+		}, "", "", "", "", 9000, false)
+	}
+
+	testutil.Run(t, "already ready", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		em := NewEntryManager(newTestForwarder())
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, newEntry())
+
+		localPort, err := em.WaitForForward(context.Background(), "service-web-default-8080")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(9000, localPort)
+	})
+
+	testutil.Run(t, "ready later", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		em := NewEntryManager(newTestForwarder())
+		entry := newEntry()
+		em.forwardPortForwardEntry(context.Background(), ioutil.Discard, entry)
+		em.updateEntryState(entry, false)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			em.updateEntryState(entry, true)
+		}()
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		localPort, err := em.WaitForForward(ctx, "service-web-default-8080")
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(9000, localPort)
+	})
+
+	testutil.Run(t, "never forwarded", func(t *testutil.T) {
+		testEvent.InitializeState([]latestV1.Pipeline{{}})
+		em := NewEntryManager(newTestForwarder())
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		_, err := em.WaitForForward(ctx, "service-web-default-8080")
+
+		t.CheckErrorContains("waiting for port forward service-web-default-8080", err)
+		t.CheckDeepEqual(context.DeadlineExceeded, errors.Unwrap(err))
+	})
+}
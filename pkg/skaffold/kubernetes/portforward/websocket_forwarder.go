@@ -0,0 +1,195 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+)
+
+// WebSocketForwarder exposes each port forward as a WebSocket endpoint on its local port,
+// for browser-based dev environments that can't open plain TCP connections.
+// The wrapped forwarder tunnels to the cluster from an internal loopback port, through the
+// configured proxy if any, and every WebSocket connection is bridged to a TCP connection to that port.
+type WebSocketForwarder struct {
+	forwarder       EntryForwarder
+	listenerOptions ListenerOptions
+
+	lock    sync.Mutex
+	bridges map[*portForwardEntry]*webSocketBridge
+}
+
+// webSocketBridge serves the WebSocket endpoint of an entry.
+type webSocketBridge struct {
+	// inner is the entry forwarded by the wrapped forwarder, on an internal port.
+	inner  *portForwardEntry
+	server *http.Server
+}
+
+// NewWebSocketForwarder returns a forwarder serving the forwards of `forwarder` over WebSocket.
+func NewWebSocketForwarder(forwarder EntryForwarder) *WebSocketForwarder {
+	return &WebSocketForwarder{
+		forwarder: forwarder,
+		bridges:   map[*portForwardEntry]*webSocketBridge{},
+	}
+}
+
+func (w *WebSocketForwarder) Start(out io.Writer) {
+	w.forwarder.Start(out)
+}
+
+// Forward serves the WebSocket endpoint on the entry's local port and
+// has the wrapped forwarder forward the entry to an internal port.
+func (w *WebSocketForwarder) Forward(parentCtx context.Context, pfe *portForwardEntry) error {
+	port, err := freeLoopbackPort()
+	if err != nil {
+		return fmt.Errorf("port forwarding %v over WebSocket: %w", pfe, err)
+	}
+	inner := newPortForwardEntry(pfe.resourceVersion, pfe.resource, pfe.podName, pfe.containerName, pfe.portName, pfe.ownerReference, port, pfe.automaticPodForwarding)
+	inner.resource.Address = util.Loopback
+
+	address := pfe.resource.Address
+	if address == "" {
+		address = util.Loopback
+	}
+	hostPort := net.JoinHostPort(address, strconv.Itoa(pfe.localPort))
+	l, err := listen(address, pfe.localPort, w.listenerOptions)
+	if err != nil {
+		return fmt.Errorf("port forwarding %v over WebSocket: %w", pfe, err)
+	}
+
+	bridge := &webSocketBridge{inner: inner}
+	// browser-based tools are served from other origins, so the origin isn't checked
+	bridge.server = &http.Server{Handler: websocket.Server{Handler: bridge.relay}}
+	go bridge.server.Serve(l)
+
+	w.lock.Lock()
+	w.bridges[pfe] = bridge
+	w.lock.Unlock()
+	pfe.webSocketURL = "ws://" + hostPort
+
+	return w.forwarder.Forward(parentCtx, inner)
+}
+
+// relay copies data between a WebSocket connection and the internal port, in binary frames.
+func (b *webSocketBridge) relay(ws *websocket.Conn) {
+	defer ws.Close()
+	ws.PayloadType = websocket.BinaryFrame
+
+	conn, err := net.Dial("tcp", net.JoinHostPort(util.Loopback, strconv.Itoa(b.inner.localPort)))
+	if err != nil {
+		logrus.Debugf("bridging WebSocket connection to %v: %v", b.inner, err)
+		return
+	}
+	defer conn.Close()
+
+	done := make(chan struct{}, 2)
+	go func() {
+		io.Copy(conn, ws)
+		done <- struct{}{}
+	}()
+	go func() {
+		io.Copy(ws, conn)
+		done <- struct{}{}
+	}()
+	<-done
+}
+
+// Terminate stops serving the WebSocket endpoint and terminates the internal forward.
+func (w *WebSocketForwarder) Terminate(pfe *portForwardEntry) {
+	w.lock.Lock()
+	bridge, found := w.bridges[pfe]
+	delete(w.bridges, pfe)
+	w.lock.Unlock()
+	if !found {
+		return
+	}
+
+	bridge.server.Close()
+	w.forwarder.Terminate(bridge.inner)
+}
+
+// setListenerOptions configures the WebSocket endpoints. The internal ports are only
+// reached from Skaffold, so the wrapped forwarder keeps its own options.
+func (w *WebSocketForwarder) setListenerOptions(options ListenerOptions) {
+	w.listenerOptions = options
+}
+
+// reportEntryState relays the state changes of internal forwards as changes of the entries they serve.
+func (w *WebSocketForwarder) reportEntryState(onStateChange func(pfe *portForwardEntry, ready bool)) {
+	r, ok := w.forwarder.(entryStateReporter)
+	if !ok {
+		return
+	}
+	r.reportEntryState(func(inner *portForwardEntry, ready bool) {
+		if pfe, found := w.entryOf(inner); found {
+			onStateChange(pfe, ready)
+		}
+	})
+}
+
+// reportMessages relays the messages about internal forwards as messages about the entries they serve.
+func (w *WebSocketForwarder) reportMessages(writeMessage func(out io.Writer, pfe *portForwardEntry, msgType ForwardMessageType, text string)) {
+	r, ok := w.forwarder.(messageReporter)
+	if !ok {
+		return
+	}
+	r.reportMessages(func(out io.Writer, inner *portForwardEntry, msgType ForwardMessageType, text string) {
+		if pfe, found := w.entryOf(inner); found {
+			writeMessage(out, pfe, msgType, text)
+		}
+	})
+}
+
+// drain lets the connections to the internal forwards of the entries finish.
+func (w *WebSocketForwarder) drain(entries []*portForwardEntry) {
+	d, ok := w.forwarder.(entryDrainer)
+	if !ok {
+		return
+	}
+	var inner []*portForwardEntry
+	w.lock.Lock()
+	for _, pfe := range entries {
+		if bridge, found := w.bridges[pfe]; found {
+			inner = append(inner, bridge.inner)
+		}
+	}
+	w.lock.Unlock()
+	d.drain(inner)
+}
+
+func (w *WebSocketForwarder) entryOf(inner *portForwardEntry) (*portForwardEntry, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	for pfe, bridge := range w.bridges {
+		if bridge.inner == inner {
+			return pfe, true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"testing"
+
+	"golang.org/x/net/websocket"
+
+	latestV1 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/latest/v1"
+	schemautil "github.com/GoogleContainerTools/skaffold/pkg/skaffold/schema/util"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+// echoForwarder stands in for kubectl by serving an echo server on the local port of forwarded entries.
+type echoForwarder struct {
+	listeners map[*portForwardEntry]net.Listener
+}
+
+func (f *echoForwarder) Start(io.Writer) {}
+
+func (f *echoForwarder) Forward(_ context.Context, pfe *portForwardEntry) error {
+	l, err := net.Listen("tcp", net.JoinHostPort(pfe.resource.Address, strconv.Itoa(pfe.localPort)))
+	if err != nil {
+		return err
+	}
+	f.listeners[pfe] = l
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				io.Copy(conn, conn)
+				conn.Close()
+			}()
+		}
+	}()
+	return nil
+}
+
+func (f *echoForwarder) Terminate(pfe *portForwardEntry) {
+	if l, found := f.listeners[pfe]; found {
+		l.Close()
+		delete(f.listeners, pfe)
+	}
+}
+
+func TestWebSocketForwarder(t *testing.T) {
+	testutil.Run(t, "bridge WebSocket connections to the forwarded port", func(t *testutil.T) {
+		inner := &echoForwarder{listeners: map[*portForwardEntry]net.Listener{}}
+		forwarder := NewWebSocketForwarder(inner)
+
+		localPort, err := freeLoopbackPort()
+		t.CheckNoError(err)
+		resource := latestV1.PortForwardResource{Type: "pod", Name: "app", Namespace: "default", Port: schemautil.FromInt(8080), Address: util.Loopback}
+		pfe := newPortForwardEntry(0, resource, "app", "app", "http", "", localPort, true)
+
+		t.CheckNoError(forwarder.Forward(context.Background(), pfe))
+		url := fmt.Sprintf("ws://127.0.0.1:%d", localPort)
+		t.CheckDeepEqual(url, pfe.webSocketURL)
+		t.CheckDeepEqual(url, pfe.snapshot(true).WebSocketURL)
+		t.CheckDeepEqual(1, len(inner.listeners))
+
+		ws, err := websocket.Dial(url, "", "http://localhost/")
+		t.CheckNoError(err)
+		_, err = ws.Write([]byte("hello"))
+		t.CheckNoError(err)
+		reply := make([]byte, 5)
+		_, err = io.ReadFull(ws, reply)
+		t.CheckNoError(err)
+		t.CheckDeepEqual("hello", string(reply))
+		ws.Close()
+
+		forwarder.Terminate(pfe)
+		t.CheckDeepEqual(0, len(inner.listeners))
+		_, err = websocket.Dial(url, "", "http://localhost/")
+		t.CheckError(true, err)
+	})
+}
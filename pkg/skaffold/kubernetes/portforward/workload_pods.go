@@ -0,0 +1,124 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portforward
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// workloadPods chooses a single Ready pod of each workload to be forwarded, instead of all its replicas.
+// The chosen pod is kept for as long as it exists, even if it stops being Ready, and another Ready
+// pod of the workload is only chosen once it's gone.
+type workloadPods struct {
+	lock sync.Mutex
+	// chosen maps workloads to the key of their forwarded pod.
+	chosen map[string]string
+	// ready maps workloads to their Ready pods, by key.
+	ready map[string]map[string]*v1.Pod
+	// workloads maps pod keys to their workload.
+	workloads map[string]string
+}
+
+func newWorkloadPods() *workloadPods {
+	return &workloadPods{
+		chosen:    map[string]string{},
+		ready:     map[string]map[string]*v1.Pod{},
+		workloads: map[string]string{},
+	}
+}
+
+// shouldForward records the state of a pod of a kube-context owned by the given workload, and
+// returns true if it's the pod chosen to be forwarded for the workload.
+func (w *workloadPods) shouldForward(kubeContext, ownerReference string, pod *v1.Pod) bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	key := contextPodKey(kubeContext, pod)
+	workload := key
+	if ownerReference != "" {
+		workload = strings.TrimPrefix(kubeContext+"/"+pod.Namespace+"/"+ownerReference, "/")
+	}
+	w.workloads[key] = workload
+
+	if isPodReady(pod) {
+		if w.ready[workload] == nil {
+			w.ready[workload] = map[string]*v1.Pod{}
+		}
+		w.ready[workload][key] = pod
+	} else {
+		delete(w.ready[workload], key)
+	}
+
+	if chosen, found := w.chosen[workload]; found {
+		return chosen == key
+	}
+	if !isPodReady(pod) {
+		return false
+	}
+	w.chosen[workload] = key
+	return true
+}
+
+// remove forgets a pod of a kube-context that's gone. If it was the chosen pod of its workload,
+// another Ready pod of the workload is chosen and returned, if any.
+func (w *workloadPods) remove(kubeContext string, pod *v1.Pod) (*v1.Pod, bool) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	key := contextPodKey(kubeContext, pod)
+	workload, found := w.workloads[key]
+	if !found {
+		return nil, false
+	}
+	delete(w.workloads, key)
+	delete(w.ready[workload], key)
+	if w.chosen[workload] != key {
+		return nil, false
+	}
+	delete(w.chosen, workload)
+
+	candidates := make([]string, 0, len(w.ready[workload]))
+	for candidate := range w.ready[workload] {
+		candidates = append(candidates, candidate)
+	}
+	if len(candidates) == 0 {
+		return nil, false
+	}
+	// pick the same replacement regardless of map ordering
+	sort.Strings(candidates)
+	w.chosen[workload] = candidates[0]
+	return w.ready[workload][candidates[0]], true
+}
+
+// contextPodKey identifies a pod by namespace and name, prefixed by its kube-context
+// if it's not the current one, to tell apart pods of the same name in several clusters.
+func contextPodKey(kubeContext string, pod *v1.Pod) string {
+	return strings.TrimPrefix(kubeContext+"/"+pod.Namespace+"/"+pod.Name, "/")
+}
+
+func isPodReady(pod *v1.Pod) bool {
+	for _, c := range pod.Status.Conditions {
+		if c.Type == v1.PodReady && c.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	deployerr "github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy/error"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches the condition of a Job whose pods failed too many times, with the name of the Job when it's given, e.g.
+	// job/db-migrate failed: BackoffLimitExceeded: Job has reached the specified backoff limit
+	jobBackoffLimitErr = regexp.MustCompile(`(?:(?:jobs?(?:\.batch)?/|[Jj]ob ")([a-z0-9][-a-z0-9.]*)[^\n]*?)?Job has reached the specified backoff limit`)
+
+	// matches connections to a Service without ready endpoints, through the API server's proxy or a port forward, e.g.
+	// error trying to reach service: no endpoints available for service "web"
+	serviceNoEndpointsErr = regexp.MustCompile(`no endpoints available for service \\?"?([a-z0-9][-a-z0-9.]*)`)
+
+	problems = []sErrors.Problem{
+		sErrors.ClusterUnknownAuthorityProblem(proto.StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY),
+		deployerr.ClusterUnauthorizedProblem(proto.StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED, nil),
+		{
+			Regexp:           jobBackoffLimitErr,
+			ErrCode:          proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT,
+			Description:      jobBackoffLimitDescription,
+			SuggestionForErr: jobBackoffLimitSuggestion,
+		},
+		{
+			Regexp:           serviceNoEndpointsErr,
+			ErrCode:          proto.StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS,
+			Description:      serviceNoEndpointsDescription,
+			SuggestionForErr: serviceNoEndpointsSuggestion,
+		},
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.StatusCheck, problems)
+}
+
+func jobBackoffLimitDescription(err error) string {
+	if job := failedJob(err); job != "" {
+		return fmt.Sprintf("Job %s failed: its pods failed as many times as its backoff limit allows", job)
+	}
+	return "A Job failed: its pods failed as many times as its backoff limit allows"
+}
+
+func jobBackoffLimitSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	job := failedJob(err)
+	if job == "" {
+		job = "<job>"
+	}
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_JOB_LOGS,
+		Action:         fmt.Sprintf("Check the logs of the pods of the Job with `kubectl logs job/%s` and fix the command that fails", job),
+	}}
+}
+
+func failedJob(err error) string {
+	if match := jobBackoffLimitErr.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
+func serviceNoEndpointsDescription(err error) string {
+	return fmt.Sprintf("Service %s has no ready endpoints", serviceWithoutEndpoints(err))
+}
+
+func serviceNoEndpointsSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	service := serviceWithoutEndpoints(err)
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_SERVICE_ENDPOINTS,
+		Action: fmt.Sprintf("Check that the pods backing the Service are ready, and that its selector matches their labels,"+
+			" eg. by comparing `kubectl get endpoints %s` with `kubectl describe service %s`", service, service),
+	}}
+}
+
+func serviceWithoutEndpoints(err error) string {
+	if match := serviceNoEndpointsErr.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
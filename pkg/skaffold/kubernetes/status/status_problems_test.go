@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package status
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestJobBackoffLimitProblem(t *testing.T) {
+	tests := []struct {
+		description string
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "job name given",
+			err:         fmt.Errorf("job/db-migrate failed: BackoffLimitExceeded: Job has reached the specified backoff limit"),
+			expected: "Job db-migrate failed: its pods failed as many times as its backoff limit allows." +
+				" Check the logs of the pods of the Job with `kubectl logs job/db-migrate` and fix the command that fails.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT,
+				Message: "job/db-migrate failed: BackoffLimitExceeded: Job has reached the specified backoff limit",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_JOB_LOGS,
+					Action:         "Check the logs of the pods of the Job with `kubectl logs job/db-migrate` and fix the command that fails",
+				}},
+			},
+		},
+		{
+			description: "quoted job name",
+			err:         fmt.Errorf(`Job "seed.data" failed: Job has reached the specified backoff limit`),
+			expected: "Job seed.data failed: its pods failed as many times as its backoff limit allows." +
+				" Check the logs of the pods of the Job with `kubectl logs job/seed.data` and fix the command that fails.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT,
+				Message: `Job "seed.data" failed: Job has reached the specified backoff limit`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_JOB_LOGS,
+					Action:         "Check the logs of the pods of the Job with `kubectl logs job/seed.data` and fix the command that fails",
+				}},
+			},
+		},
+		{
+			description: "no job name",
+			err:         fmt.Errorf("BackoffLimitExceeded: Job has reached the specified backoff limit"),
+			expected: "A Job failed: its pods failed as many times as its backoff limit allows." +
+				" Check the logs of the pods of the Job with `kubectl logs job/<job>` and fix the command that fails.",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT,
+				Message: "BackoffLimitExceeded: Job has reached the specified backoff limit",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_JOB_LOGS,
+					Action:         "Check the logs of the pods of the Job with `kubectl logs job/<job>` and fix the command that fails",
+				}},
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.StatusCheck, problems)
+				return pc
+			})
+			t.CheckDeepEqual(test.expected, sErrors.ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(test.expectedAE, sErrors.ActionableErr(nil, constants.StatusCheck, test.err))
+		})
+	}
+}
+
+func TestServiceNoEndpointsProblem(t *testing.T) {
+	suggestion := "Check that the pods backing the Service are ready, and that its selector matches their labels," +
+		" eg. by comparing `kubectl get endpoints web` with `kubectl describe service web`"
+
+	tests := []struct {
+		description string
+		err         error
+	}{
+		{
+			description: "api server proxy",
+			err:         fmt.Errorf(`error trying to reach service: no endpoints available for service "web"`),
+		},
+		{
+			description: "escaped quotes",
+			err:         fmt.Errorf(`{"kind":"Status","message":"no endpoints available for service \"web\""}`),
+		},
+		{
+			description: "unquoted",
+			err:         fmt.Errorf("port forward failed: no endpoints available for service web"),
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.StatusCheck, problems)
+				return pc
+			})
+			t.CheckDeepEqual("Service web has no ready endpoints. "+suggestion+".", sErrors.ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(&proto.ActionableErr{
+				ErrCode: proto.StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS,
+				Message: test.err.Error(),
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_SERVICE_ENDPOINTS,
+					Action:         suggestion,
+				}},
+			}, sErrors.ActionableErr(nil, constants.StatusCheck, test.err))
+		})
+	}
+}
@@ -20,14 +20,40 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"sync"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
+	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
 
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	errWatcherStopped = errors.New("pod watcher stopped")
+
+	// matches `failed to watch *v1.Pod: ... is forbidden` and `pods is forbidden: User "x" cannot watch resource "pods"`
+	watchForbidden = regexp.MustCompile(`(?i)\bforbidden\b`)
+
+	// For testing
+	watchReconnectBackoff = wait.Backoff{
+		Duration: 500 * time.Millisecond,
+		Factor:   2,
+		Jitter:   0.5,
+		Steps:    10,
+		Cap:      30 * time.Second,
+	}
+	// watchHealthyPeriod is how long a watch must stay open for the reconnection backoff to start over.
+	watchHealthyPeriod = time.Minute
 )
 
 type PodWatcher interface {
@@ -38,7 +64,11 @@ type PodWatcher interface {
 
 // podWatcher is a pod watcher for multiple namespaces.
 type podWatcher struct {
-	podSelector  PodSelector
+	podSelector PodSelector
+	// fieldSelector restricts the watched pods on the API server.
+	fieldSelector fields.Selector
+	// kubeContext is the kube-context whose pods are watched, or "" for the current one.
+	kubeContext  string
 	receivers    map[chan<- PodEvent]bool
 	receiverLock sync.Mutex
 }
@@ -46,13 +76,37 @@ type podWatcher struct {
 type PodEvent struct {
 	Type watch.EventType
 	Pod  *v1.Pod
+	// KubeContext is the kube-context of the pod when watching several kube-contexts, and "" for the current one.
+	KubeContext string
 }
 
 func NewPodWatcher(podSelector PodSelector) PodWatcher {
+	return NewFieldSelectedPodWatcher(podSelector, fields.Everything())
+}
+
+// NewFieldSelectedPodWatcher returns a pod watcher that only watches the pods matching a field selector,
+// eg. `spec.nodeName=node-1`.
+func NewFieldSelectedPodWatcher(podSelector PodSelector, fieldSelector fields.Selector) PodWatcher {
 	return &podWatcher{
-		podSelector: podSelector,
-		receivers:   make(map[chan<- PodEvent]bool),
+		podSelector:   podSelector,
+		fieldSelector: fieldSelector,
+		receivers:     make(map[chan<- PodEvent]bool),
+	}
+}
+
+// NewMultiContextPodWatcher returns a pod watcher for the pods of several kube-contexts, "" standing for the current one.
+// The events are tagged with the kube-context of their pod.
+func NewMultiContextPodWatcher(podSelector PodSelector, fieldSelector fields.Selector, kubeContexts []string) PodWatcher {
+	aggregate := &aggregatePodWatcher{}
+	for _, kubeContext := range kubeContexts {
+		aggregate.watchers = append(aggregate.watchers, &podWatcher{
+			podSelector:   podSelector,
+			fieldSelector: fieldSelector,
+			kubeContext:   kubeContext,
+			receivers:     make(map[chan<- PodEvent]bool),
+		})
 	}
+	return aggregate
 }
 
 func (w *podWatcher) Register(receiver chan<- PodEvent) {
@@ -72,61 +126,253 @@ func (w *podWatcher) Start(namespaces []string) (func(), error) {
 		return func() {}, errors.New("no receiver was registered")
 	}
 
-	var watchers []watch.Interface
+	var watchers []*namespaceWatcher
 	stopWatchers := func() {
 		for _, w := range watchers {
-			w.Stop()
+			w.stop()
 		}
 	}
 
-	kubeclient, err := client.Client()
+	kubeclient, err := client.ContextClient(w.kubeContext)
 	if err != nil {
 		return func() {}, fmt.Errorf("getting k8s client: %w", err)
 	}
 
-	var forever int64 = 3600 * 24 * 365 * 100
-
 	for _, ns := range namespaces {
-		watcher, err := kubeclient.CoreV1().Pods(ns).Watch(context.Background(), metav1.ListOptions{
-			TimeoutSeconds: &forever,
-		})
+		nsWatcher := &namespaceWatcher{
+			pods:          kubeclient.CoreV1().Pods(ns),
+			fieldSelector: w.fieldSelector.String(),
+			backoff:       watchReconnectBackoff,
+			healthyPeriod: watchHealthyPeriod,
+			done:          make(chan struct{}),
+		}
+		watcher, err := nsWatcher.watch("")
 		if err != nil {
 			stopWatchers()
-			return func() {}, fmt.Errorf("initializing pod watcher for %q: %w", ns, err)
+			return func() {}, watchErr(ns, err)
 		}
 
-		watchers = append(watchers, watcher)
-		go func() {
-			for evt := range watcher.ResultChan() {
-				// If the event's type is "ERROR", warn and continue.
-				if evt.Type == watch.Error {
-					logrus.Warnf("got unexpected event of type %s", evt.Type)
-					continue
-				}
-
-				// Grab the pod from the event.
-				pod, ok := evt.Object.(*v1.Pod)
-				if !ok {
-					continue
-				}
-
-				if !w.podSelector.Select(pod) {
-					continue
-				}
-
-				w.receiverLock.Lock()
-				for receiver, open := range w.receivers {
-					if open {
-						receiver <- PodEvent{
-							Type: evt.Type,
-							Pod:  pod,
-						}
-					}
-				}
-				w.receiverLock.Unlock()
+		watchers = append(watchers, nsWatcher)
+		go nsWatcher.run(ns, watcher, w.dispatch)
+	}
+
+	return stopWatchers, nil
+}
+
+// watchErr classifies a failure to establish the watch on the pods of a namespace.
+func watchErr(ns string, err error) error {
+	var suggestions []*proto.Suggestion
+	if apierrors.IsForbidden(err) || watchForbidden.MatchString(err.Error()) {
+		suggestions = []*proto.Suggestion{{
+			SuggestionCode: proto.SuggestionCode_CHECK_POD_WATCH_PERMISSIONS,
+			Action:         fmt.Sprintf("Check that your user is allowed to list and watch pods in namespace %q, eg. with `kubectl auth can-i watch pods -n %s`", ns, ns),
+		}}
+	}
+	return sErrors.NewError(err, proto.ActionableErr{
+		ErrCode:     proto.StatusCode_DEVINIT_WATCH_PODS_FAILED,
+		Message:     fmt.Sprintf("initializing pod watcher for %q: %s", ns, err),
+		Suggestions: suggestions,
+	})
+}
+
+func (w *podWatcher) dispatch(evt watch.Event) {
+	// If the event's type is "ERROR", warn and continue.
+	if evt.Type == watch.Error {
+		logrus.Warnf("got unexpected event of type %s", evt.Type)
+		return
+	}
+
+	// Grab the pod from the event.
+	pod, ok := evt.Object.(*v1.Pod)
+	if !ok {
+		return
+	}
+
+	if !w.podSelector.Select(pod) || !w.fieldSelector.Matches(PodFields(pod)) {
+		return
+	}
+
+	w.receiverLock.Lock()
+	for receiver, open := range w.receivers {
+		if open {
+			receiver <- PodEvent{
+				Type:        evt.Type,
+				Pod:         pod,
+				KubeContext: w.kubeContext,
 			}
-		}()
+		}
+	}
+	w.receiverLock.Unlock()
+}
+
+// aggregatePodWatcher fans in the events of the pod watchers of several kube-contexts.
+type aggregatePodWatcher struct {
+	watchers []PodWatcher
+}
+
+func (a *aggregatePodWatcher) Register(receiver chan<- PodEvent) {
+	for _, w := range a.watchers {
+		w.Register(receiver)
+	}
+}
+
+func (a *aggregatePodWatcher) Deregister(receiver chan<- PodEvent) {
+	for _, w := range a.watchers {
+		w.Deregister(receiver)
+	}
+}
+
+// Start watches the pods of the namespaces in every kube-context, and fails if any of them can't be watched.
+func (a *aggregatePodWatcher) Start(namespaces []string) (func(), error) {
+	var stops []func()
+	stopWatchers := func() {
+		for _, stop := range stops {
+			stop()
+		}
 	}
 
+	for _, w := range a.watchers {
+		stop, err := w.Start(namespaces)
+		if err != nil {
+			stopWatchers()
+			return func() {}, err
+		}
+		stops = append(stops, stop)
+	}
 	return stopWatchers, nil
 }
+
+// namespaceWatcher keeps a pod watch open on a single namespace,
+// re-establishing it whenever the API server closes the result channel.
+// Pods deleted while the watch was down are reported as deleted once it's back.
+type namespaceWatcher struct {
+	pods          corev1.PodInterface
+	fieldSelector string
+	backoff       wait.Backoff
+	healthyPeriod time.Duration
+	done          chan struct{}
+	lock          sync.Mutex
+	current       watch.Interface
+	stopped       bool
+}
+
+// watch starts watching the pods of the namespace from the given resource version,
+// or from their current state if it's empty.
+func (n *namespaceWatcher) watch(resourceVersion string) (watch.Interface, error) {
+	var forever int64 = 3600 * 24 * 365 * 100
+
+	watcher, err := n.pods.Watch(context.Background(), metav1.ListOptions{
+		TimeoutSeconds:  &forever,
+		FieldSelector:   n.fieldSelector,
+		ResourceVersion: resourceVersion,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	if n.stopped {
+		watcher.Stop()
+		return nil, errWatcherStopped
+	}
+	n.current = watcher
+	return watcher, nil
+}
+
+func (n *namespaceWatcher) run(ns string, watcher watch.Interface, dispatch func(watch.Event)) {
+	backoff := n.backoff
+
+	// known are the pods seen so far, to tell which ones were deleted while the watch was down.
+	known := map[string]*v1.Pod{}
+	track := func(evt watch.Event) {
+		if pod, ok := evt.Object.(*v1.Pod); ok {
+			switch evt.Type {
+			case watch.Added, watch.Modified:
+				known[pod.Name] = pod
+			case watch.Deleted:
+				delete(known, pod.Name)
+			}
+		}
+		dispatch(evt)
+	}
+
+	for {
+		established := time.Now()
+		for evt := range watcher.ResultChan() {
+			track(evt)
+		}
+
+		// A watch that stayed open for a while was healthy: start over with a short delay.
+		// One that fails soon after it's established keeps backing off, even if it delivered events.
+		if time.Since(established) >= n.healthyPeriod {
+			backoff = n.backoff
+		}
+
+		for {
+			select {
+			case <-n.done:
+				return
+			case <-time.After(backoff.Step()):
+			}
+
+			pods, err := n.pods.List(context.Background(), metav1.ListOptions{FieldSelector: n.fieldSelector})
+			if err == nil {
+				watcher, err = n.watch(pods.ResourceVersion)
+			}
+			if err == nil {
+				reconcile(pods.Items, known, track)
+				break
+			}
+			if errors.Is(err, errWatcherStopped) {
+				return
+			}
+			logrus.Debugf("re-establishing pod watcher for %q: %v", ns, err)
+		}
+	}
+}
+
+// reconcile compares the pods known before the watch was interrupted with a fresh list:
+// listed pods are reported as modified since they may have changed in the meantime,
+// and only the known pods missing from the list are reported as deleted.
+func reconcile(listed []v1.Pod, known map[string]*v1.Pod, track func(watch.Event)) {
+	present := map[string]bool{}
+	for i := range listed {
+		present[listed[i].Name] = true
+		track(watch.Event{Type: watch.Modified, Object: &listed[i]})
+	}
+	for name, pod := range known {
+		if !present[name] {
+			track(watch.Event{Type: watch.Deleted, Object: pod})
+		}
+	}
+}
+
+func (n *namespaceWatcher) stop() {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	if n.stopped {
+		return
+	}
+	n.stopped = true
+	close(n.done)
+	if n.current != nil {
+		n.current.Stop()
+	}
+}
+
+// PodFields returns the pod fields that field selectors can match against on the API server.
+func PodFields(pod *v1.Pod) fields.Set {
+	return fields.Set{
+		"metadata.name":            pod.Name,
+		"metadata.namespace":       pod.Namespace,
+		"spec.nodeName":            pod.Spec.NodeName,
+		"spec.restartPolicy":       string(pod.Spec.RestartPolicy),
+		"spec.schedulerName":       pod.Spec.SchedulerName,
+		"spec.serviceAccountName":  pod.Spec.ServiceAccountName,
+		"status.phase":             string(pod.Status.Phase),
+		"status.podIP":             pod.Status.PodIP,
+		"status.nominatedNodeName": pod.Status.NominatedNodeName,
+	}
+}
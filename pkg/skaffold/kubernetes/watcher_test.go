@@ -20,16 +20,25 @@ import (
 	"context"
 	"errors"
 	"sort"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/fake"
 	k8stesting "k8s.io/client-go/testing"
 
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/client"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 	"github.com/GoogleContainerTools/skaffold/testutil"
 )
 
@@ -92,6 +101,29 @@ func TestPodWatcher(t *testing.T) {
 		defer cleanup()
 
 		t.CheckErrorContains("unable to watch", err)
+		t.CheckDeepEqual(proto.StatusCode_DEVINIT_WATCH_PODS_FAILED, err.(sErrors.Error).StatusCode())
+		t.CheckDeepEqual(0, len(err.(sErrors.Error).Suggestions()))
+	})
+
+	testutil.Run(t, "forbidden to watch pods", func(t *testutil.T) {
+		clientset := fake.NewSimpleClientset()
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "pods"}, "", errors.New(`User "dev" cannot watch resource "pods" in API group "" in the namespace "ns"`))
+		})
+
+		watcher := NewPodWatcher(&anyPod{})
+		watcher.Register(make(chan PodEvent))
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+
+		t.CheckErrorContains(`initializing pod watcher for "ns": pods is forbidden`, err)
+		t.CheckDeepEqual(proto.StatusCode_DEVINIT_WATCH_PODS_FAILED, err.(sErrors.Error).StatusCode())
+		suggestions := err.(sErrors.Error).Suggestions()
+		t.CheckDeepEqual(1, len(suggestions))
+		t.CheckDeepEqual(proto.SuggestionCode_CHECK_POD_WATCH_PERMISSIONS, suggestions[0].SuggestionCode)
+		t.CheckDeepEqual("Check that your user is allowed to list and watch pods in namespace \"ns\", eg. with `kubectl auth can-i watch pods -n ns`", suggestions[0].Action)
 	})
 
 	testutil.Run(t, "filter 3 events", func(t *testutil.T) {
@@ -129,4 +161,188 @@ func TestPodWatcher(t *testing.T) {
 		t.CheckDeepEqual("pod2", podEvents[1].Pod.Name)
 		t.CheckDeepEqual("pod3", podEvents[2].Pod.Name)
 	})
+	testutil.Run(t, "filter by field selector", func(t *testutil.T) {
+		clientset := fake.NewSimpleClientset()
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		var fieldSelector string
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (bool, watch.Interface, error) {
+			fieldSelector = action.(k8stesting.WatchActionImpl).GetWatchRestrictions().Fields.String()
+			return false, nil, nil
+		})
+
+		events := make(chan PodEvent)
+		watcher := NewFieldSelectedPodWatcher(&anyPod{}, fields.OneTermEqualSelector("spec.nodeName", "node-1"))
+		watcher.Register(events)
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+		t.CheckNoError(err)
+		t.CheckDeepEqual("spec.nodeName=node-1", fieldSelector)
+
+		// the fake clientset doesn't filter on fields, so pods are also matched client-side
+		onNode := func(name, node string) *v1.Pod {
+			p := pod(name)
+			p.Spec.NodeName = node
+			return p
+		}
+		clientset.CoreV1().Pods("ns").Create(context.Background(), onNode("ignored", "node-2"), metav1.CreateOptions{})
+		clientset.CoreV1().Pods("ns").Create(context.Background(), onNode("pod1", "node-1"), metav1.CreateOptions{})
+
+		t.CheckDeepEqual("pod1", (<-events).Pod.Name)
+	})
+	testutil.Run(t, "reconnect after watch is closed", func(t *testutil.T) {
+		t.Override(&watchReconnectBackoff, wait.Backoff{Duration: time.Millisecond, Factor: 2, Jitter: 0.5, Steps: 5})
+
+		clientset := fake.NewSimpleClientset()
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		watches := make(chan *watch.FakeWatcher, 3)
+		attempts := 0
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			attempts++
+			// Fail the first reconnection attempt to exercise the backoff.
+			if attempts == 2 {
+				return true, nil, errors.New("api server unavailable")
+			}
+			fakeWatch := watch.NewFake()
+			watches <- fakeWatch
+			return true, fakeWatch, nil
+		})
+
+		events := make(chan PodEvent)
+		watcher := NewPodWatcher(&anyPod{})
+		watcher.Register(events)
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+		t.CheckNoError(err)
+
+		first := <-watches
+		first.Add(pod("pod1"))
+		t.CheckDeepEqual("pod1", (<-events).Pod.Name)
+		first.Stop()
+
+		second := <-watches
+		// pod1 isn't listed anymore
+		t.CheckDeepEqual(PodEvent{Type: watch.Deleted, Pod: pod("pod1")}, <-events)
+		second.Add(pod("pod2"))
+		t.CheckDeepEqual("pod2", (<-events).Pod.Name)
+		t.CheckDeepEqual(3, attempts)
+	})
+	testutil.Run(t, "backoff grows while watches fail soon after delivering events", func(t *testutil.T) {
+		t.Override(&watchReconnectBackoff, wait.Backoff{Duration: time.Millisecond, Factor: 2, Steps: 20, Cap: time.Second})
+		t.Override(&watchHealthyPeriod, time.Hour)
+
+		clientset := fake.NewSimpleClientset()
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		var attempts int32
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			atomic.AddInt32(&attempts, 1)
+			// each watch delivers an event, then fails right away
+			fakeWatch := watch.NewFakeWithChanSize(1, false)
+			fakeWatch.Add(pod("pod1"))
+			fakeWatch.Stop()
+			return true, fakeWatch, nil
+		})
+
+		events := make(chan PodEvent, 100)
+		watcher := NewPodWatcher(&anyPod{})
+		watcher.Register(events)
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+		t.CheckNoError(err)
+
+		// reconnecting after 1ms, 2ms, 4ms... takes 9 attempts to last over 255ms
+		time.Sleep(200 * time.Millisecond)
+		t.CheckTrue(atomic.LoadInt32(&attempts) < 10)
+	})
+	testutil.Run(t, "reconnect preserves existing pods", func(t *testutil.T) {
+		t.Override(&watchReconnectBackoff, wait.Backoff{Duration: time.Millisecond, Factor: 2, Jitter: 0.5, Steps: 5})
+
+		inNamespace := func(name string) *v1.Pod {
+			p := pod(name)
+			p.Namespace = "ns"
+			return p
+		}
+		// only pod1 still exists when the watch reconnects
+		clientset := fake.NewSimpleClientset(inNamespace("pod1"))
+		t.Override(&client.Client, func() (kubernetes.Interface, error) { return clientset, nil })
+
+		watches := make(chan *watch.FakeWatcher, 2)
+		var resourceVersions []string
+		clientset.Fake.PrependWatchReactor("pods", func(action k8stesting.Action) (handled bool, ret watch.Interface, err error) {
+			resourceVersions = append(resourceVersions, action.(k8stesting.WatchActionImpl).GetWatchRestrictions().ResourceVersion)
+			fakeWatch := watch.NewFake()
+			watches <- fakeWatch
+			return true, fakeWatch, nil
+		})
+		clientset.Fake.PrependReactor("list", "pods", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &v1.PodList{ListMeta: metav1.ListMeta{ResourceVersion: "42"}, Items: []v1.Pod{*inNamespace("pod1")}}, nil
+		})
+
+		events := make(chan PodEvent)
+		watcher := NewPodWatcher(&anyPod{})
+		watcher.Register(events)
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+		t.CheckNoError(err)
+
+		first := <-watches
+		first.Add(inNamespace("pod1"))
+		t.CheckDeepEqual(watch.Added, (<-events).Type)
+		first.Add(inNamespace("pod2"))
+		t.CheckDeepEqual(watch.Added, (<-events).Type)
+		first.Stop()
+
+		<-watches
+		// the existing pod is refreshed instead of being deleted, and only the missing one is deleted
+		t.CheckDeepEqual(PodEvent{Type: watch.Modified, Pod: inNamespace("pod1")}, <-events)
+		t.CheckDeepEqual(PodEvent{Type: watch.Deleted, Pod: inNamespace("pod2")}, <-events)
+		// the watch resumes from the list
+		t.CheckDeepEqual([]string{"", "42"}, resourceVersions)
+	})
+}
+
+func TestMultiContextPodWatcher(t *testing.T) {
+	testutil.Run(t, "events are tagged with their kube-context", func(t *testutil.T) {
+		clientsets := map[string]*fake.Clientset{
+			"":          fake.NewSimpleClientset(),
+			"cluster-b": fake.NewSimpleClientset(),
+		}
+		t.Override(&client.ContextClient, func(kubeContext string) (kubernetes.Interface, error) {
+			return clientsets[kubeContext], nil
+		})
+
+		events := make(chan PodEvent)
+		watcher := NewMultiContextPodWatcher(&anyPod{}, fields.Everything(), []string{"", "cluster-b"})
+		watcher.Register(events)
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+		t.CheckNoError(err)
+
+		clientsets[""].CoreV1().Pods("ns").Create(context.Background(), pod("pod1"), metav1.CreateOptions{})
+		clientsets["cluster-b"].CoreV1().Pods("ns").Create(context.Background(), pod("pod1"), metav1.CreateOptions{})
+
+		podEvents := []PodEvent{<-events, <-events}
+		sort.Slice(podEvents, func(i, j int) bool { return podEvents[i].KubeContext < podEvents[j].KubeContext })
+		t.CheckDeepEqual("", podEvents[0].KubeContext)
+		t.CheckDeepEqual("cluster-b", podEvents[1].KubeContext)
+		t.CheckDeepEqual("pod1", podEvents[1].Pod.Name)
+	})
+
+	testutil.Run(t, "fail to get the client of a kube-context", func(t *testutil.T) {
+		t.Override(&client.ContextClient, func(kubeContext string) (kubernetes.Interface, error) {
+			if kubeContext == "cluster-b" {
+				return nil, errors.New("unable to get client")
+			}
+			return fake.NewSimpleClientset(), nil
+		})
+
+		watcher := NewMultiContextPodWatcher(&anyPod{}, fields.Everything(), []string{"", "cluster-b"})
+		watcher.Register(make(chan PodEvent))
+		cleanup, err := watcher.Start([]string{"ns"})
+		defer cleanup()
+
+		t.CheckErrorContains("unable to get client", err)
+	})
 }
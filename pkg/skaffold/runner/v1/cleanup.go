@@ -19,8 +19,10 @@ package v1
 import (
 	"context"
 	"io"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/deploy"
 )
 
 func (r *SkaffoldRunner) Cleanup(ctx context.Context, out io.Writer) error {
-	return r.deployer.Cleanup(ctx, out)
+	return deploy.ReportBenignCleanupErr(out, r.deployer.Cleanup(ctx, out))
 }
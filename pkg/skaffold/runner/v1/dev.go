@@ -65,6 +65,12 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 	}
 
 	r.deployer.GetLogger().Mute()
+	// hold off forwarding the pods that come and go until the iteration is over,
+	// and forward them on the way out if it fails before listing the port forwards
+	if portForwards, err := portForwardController(r.deployer); err == nil {
+		portForwards.Pause()
+		defer portForwards.Resume()
+	}
 	// if any action is going to be performed, reset the monitor's changed component tracker for debouncing
 	defer r.monitor.Reset()
 	defer r.listener.LogWatchToUser(out)
@@ -196,6 +202,11 @@ func (r *SkaffoldRunner) doDev(ctx context.Context, out io.Writer) error {
 
 		endTrace()
 	}
+	// the summary lists the pods deployed by this iteration
+	if portForwards, err := portForwardController(r.deployer); err == nil {
+		portForwards.Resume()
+		portForwards.Summary(out)
+	}
 	event.DevLoopComplete(r.devIteration)
 	eventV2.TaskSucceeded(constants.DevLoop)
 	endTrace()
@@ -347,6 +358,9 @@ func (r *SkaffoldRunner) Dev(ctx context.Context, out io.Writer, artifacts []*la
 		return fmt.Errorf("starting logger: %w", err)
 	}
 
+	if portForwards, err := portForwardController(r.deployer); err == nil {
+		portForwards.Summary(out)
+	}
 	output.Yellow.Fprintln(out, "Press Ctrl+C to exit")
 
 	event.DevLoopComplete(r.devIteration)
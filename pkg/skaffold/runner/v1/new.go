@@ -18,7 +18,9 @@ package v1
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/sirupsen/logrus"
 
@@ -33,6 +35,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/filemon"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/instrumentation"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/kubernetes/portforward"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/loader"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/log"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
@@ -44,6 +47,7 @@ import (
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/tag"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/test"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/trigger"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
 // NewForConfig returns a new SkaffoldRunner for a SkaffoldConfig
@@ -128,6 +132,40 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	if runCtx.Notification() {
 		deployer = runner.WithNotification(deployer)
 	}
+	// give the server a callback to disable or enable port forwards when a user request is received
+	server.SetPortForwardCallback(func(localPort int, enabled bool) error {
+		logrus.Debugf("port forward on local port %d update to enabled=%t received, calling back to runner", localPort, enabled)
+		portForwards, err := portForwardController(deployer)
+		if err != nil {
+			return err
+		}
+		return portForwards.EnablePortForward(localPort, enabled)
+	})
+	// to forward the port of a pod on request, when pods are forwarded on demand
+	server.SetPodPortForwardCallback(func(namespace, podName string, port int) (int, error) {
+		logrus.Debugf("port forward of pod/%s port %d requested, calling back to runner", podName, port)
+		portForwards, err := portForwardController(deployer)
+		if err != nil {
+			return 0, err
+		}
+		return portForwards.ForwardPodPort(namespace, podName, port)
+	})
+	server.SetReplicaPortForwardCallback(func(namespace, owner string, ipSuffix, index, port int) (int, error) {
+		logrus.Debugf("port forward of a replica of %s port %d requested, calling back to runner", owner, port)
+		portForwards, err := portForwardController(deployer)
+		if err != nil {
+			return 0, err
+		}
+		return portForwards.ForwardReplicaPort(namespace, owner, portforward.ReplicaSelector{IPSuffix: ipSuffix, Index: index}, port)
+	})
+	// and to list the active port forwards on request
+	server.SetPortForwardsCallback(func() *proto.ActivePortForwards {
+		portForwards, err := portForwardController(deployer)
+		if err != nil {
+			return &proto.ActivePortForwards{}
+		}
+		return portforward.ActivePortForwards(portForwards.PortForwards(), time.Now())
+	})
 
 	monitor := filemon.NewMonitor()
 	intents, intentChan := setupIntents(runCtx)
@@ -155,6 +193,14 @@ func NewForConfig(runCtx *runcontext.RunContext) (*SkaffoldRunner, error) {
 	}, nil
 }
 
+// portForwardController returns the controller of the port forwards of the deployer's accessor.
+func portForwardController(deployer deploy.Deployer) (portforward.Controller, error) {
+	if portForwards, ok := deployer.GetAccessor().(portforward.Controller); ok {
+		return portForwards, nil
+	}
+	return nil, errors.New("port forwarding is not enabled")
+}
+
 func setupIntents(runCtx *runcontext.RunContext) (*runner.Intents, chan bool) {
 	intents := runner.NewIntents(runCtx.AutoBuild(), runCtx.AutoSync(), runCtx.AutoDeploy())
 
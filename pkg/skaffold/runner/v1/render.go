@@ -22,7 +22,9 @@ import (
 	"io"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/build"
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/docker"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/graph"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/output"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/runner"
@@ -34,7 +36,7 @@ func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []gra
 		for i, a := range builds {
 			digest, err := docker.RemoteDigest(a.Tag, r.runCtx)
 			if err != nil {
-				return fmt.Errorf("failed to resolve the digest of %s: does the image exist remotely?", a.Tag)
+				return sErrors.WithPhase(constants.Render, fmt.Errorf("failed to resolve the digest of %s: does the image exist remotely?", a.Tag))
 			}
 			builds[i].Tag = build.TagWithDigest(a.Tag, digest)
 		}
@@ -42,5 +44,5 @@ func (r *SkaffoldRunner) Render(ctx context.Context, out io.Writer, builds []gra
 	if r.runCtx.DigestSource() == runner.NoneDigestSource {
 		output.Default.Fprintln(out, "--digest-source set to 'none', tags listed in Kubernetes manifests will be used for render")
 	}
-	return r.deployer.Render(ctx, out, builds, offline, filepath)
+	return sErrors.WithPhase(constants.Render, r.deployer.Render(ctx, out, builds, offline, filepath))
 }
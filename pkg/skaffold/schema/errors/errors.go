@@ -19,12 +19,24 @@ package errors
 import (
 	"fmt"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
 )
 
-// ConfigParsingError returns a generic config parsing error
+// ConfigParsingError returns a generic config parsing error, unless the error is known to the problems
+// of the Init phase, eg. a value of the wrong type.
 func ConfigParsingError(err error) error {
+	if err != nil {
+		if code, description, suggestions := sErrors.Classify(nil, constants.Init, err); code != proto.StatusCode_INIT_UNKNOWN {
+			return sErrors.NewError(err,
+				proto.ActionableErr{
+					Message:     fmt.Sprintf("error parsing skaffold configuration file: %s", description),
+					ErrCode:     code,
+					Suggestions: suggestions,
+				})
+		}
+	}
 	return sErrors.NewError(err,
 		proto.ActionableErr{
 			Message: fmt.Sprintf("error parsing skaffold configuration file: %v", err),
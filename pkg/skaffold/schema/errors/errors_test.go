@@ -19,8 +19,10 @@ package errors
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
 	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/proto/enums"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
@@ -101,3 +103,28 @@ func TestErrors(t *testing.T) {
 		})
 	}
 }
+
+func TestConfigParsingErrorOfKnownProblem(t *testing.T) {
+	testutil.Run(t, "", func(t *testutil.T) {
+		t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+			pc := sErrors.NewProblemCatalog()
+			pc.AddPhaseProblems(constants.Init, []sErrors.Problem{{
+				Regexp:      regexp.MustCompile("cannot unmarshal"),
+				ErrCode:     proto.StatusCode_CONFIG_FIELD_TYPE_ERR,
+				Description: func(error) string { return "invalid skaffold config" },
+				Suggestion: func(interface{}) []*proto.Suggestion {
+					return []*proto.Suggestion{{SuggestionCode: proto.SuggestionCode_CONFIG_FIX_FIELD_TYPE, Action: "Fix the field"}}
+				},
+			}})
+			return pc
+		})
+
+		var e sErrors.Error
+		t.CheckTrue(errors.As(ConfigParsingError(fmt.Errorf("line 12: cannot unmarshal !!str `eight` into int")), &e))
+		t.CheckDeepEqual(proto.StatusCode_CONFIG_FIELD_TYPE_ERR, e.StatusCode())
+		t.CheckDeepEqual("error parsing skaffold configuration file: invalid skaffold config. Fix the field.", e.Error())
+
+		t.CheckTrue(errors.As(ConfigParsingError(fmt.Errorf("yaml: line 3: did not find expected key")), &e))
+		t.CheckDeepEqual(proto.StatusCode_CONFIG_FILE_PARSING_ERR, e.StatusCode())
+	})
+}
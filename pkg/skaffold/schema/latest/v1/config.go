@@ -142,6 +142,28 @@ type PortForwardResource struct {
 
 	// LocalPort is the local port to forward to. If the port is unavailable, Skaffold will choose a random open port to forward to. *Optional*.
 	LocalPort int `yaml:"localPort,omitempty"`
+
+	// KeepAliveSeconds is the interval at which TCP keepalive probes are sent on idle forwarded connections,
+	// so that long-lived streams are not dropped by intermediate timeouts. Disabled by default. *Optional*.
+	KeepAliveSeconds int `yaml:"keepAliveSeconds,omitempty"`
+
+	// MaxConnections is the maximum number of concurrent connections accepted on the local port.
+	// Connections past the limit are rejected. Defaults to no limit. *Optional*.
+	MaxConnections int `yaml:"maxConnections,omitempty"`
+
+	// TerminateTLS accepts plaintext connections on the local port and relays them to the resource over TLS,
+	// so that HTTPS services can be reached without their certificates.
+	// The certificate presented by the resource is not verified: only enable it for development services. *Optional*.
+	TerminateTLS bool `yaml:"terminateTLS,omitempty"`
+
+	// CaptureFile is the file the traffic of forwarded connections is recorded to, for debugging.
+	// Once it grows past 16MB, it's renamed with a `.1` suffix and a new capture is started. *Optional*.
+	CaptureFile string `yaml:"captureFile,omitempty"`
+
+	// BridgeInterface is the network interface of a Docker bridge network, eg. `docker0`, or its address, eg. `172.17.0.1`,
+	// to bind the local port to instead of `address`, so that containers on that network can reach the resource.
+	// The port is then also reachable by any host that can route to the interface. *Optional*.
+	BridgeInterface string `yaml:"bridgeInterface,omitempty"`
 }
 
 // BuildConfig contains all the configuration for the build steps.
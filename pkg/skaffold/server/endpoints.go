@@ -80,6 +80,62 @@ func (s *server) AutoSync(ctx context.Context, request *proto.TriggerRequest) (r
 	return executeAutoTrigger("sync", request, event.UpdateStateAutoSyncTrigger, func() {}, s.autoSyncCallback)
 }
 
+func (s *server) PortForward(ctx context.Context, request *proto.PortForwardRequest) (*empty.Empty, error) {
+	v, ok := request.GetState().GetVal().(*proto.TriggerState_Enabled)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "missing required boolean parameter 'enabled'")
+	}
+	if err := s.portForwardCallback(int(request.GetLocalPort()), v.Enabled); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &empty.Empty{}, nil
+}
+
+func (s *server) ForwardPodPort(ctx context.Context, request *proto.PodPortForwardRequest) (*proto.PodPortForwardResponse, error) {
+	if request.GetOwner() != "" {
+		return s.forwardReplicaPort(request)
+	}
+	if request.GetNamespace() == "" || request.GetPodName() == "" || request.GetPort() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing required parameters 'namespace', 'podName' and 'port'")
+	}
+	if s.podPortForwardCallback == nil {
+		return nil, status.Error(codes.FailedPrecondition, "pods are not forwarded on demand")
+	}
+	localPort, err := s.podPortForwardCallback(request.GetNamespace(), request.GetPodName(), int(request.GetPort()))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &proto.PodPortForwardResponse{LocalPort: int32(localPort)}, nil
+}
+
+// forwardReplicaPort forwards a container port of the replica of a workload picked by its IP suffix or index.
+func (s *server) forwardReplicaPort(request *proto.PodPortForwardRequest) (*proto.PodPortForwardResponse, error) {
+	if request.GetNamespace() == "" || request.GetPort() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "missing required parameters 'namespace' and 'port'")
+	}
+	if request.GetPodName() != "" {
+		return nil, status.Error(codes.InvalidArgument, "'podName' and 'owner' can't be both set")
+	}
+	if (request.GetPodIPSuffix() > 0) == (request.GetReplicaIndex() > 0) {
+		return nil, status.Error(codes.InvalidArgument, "exactly one of 'podIPSuffix' and 'replicaIndex' is required with 'owner'")
+	}
+	if s.replicaPortForwardCallback == nil {
+		return nil, status.Error(codes.FailedPrecondition, "pods are not forwarded on demand")
+	}
+	localPort, err := s.replicaPortForwardCallback(request.GetNamespace(), request.GetOwner(), int(request.GetPodIPSuffix()), int(request.GetReplicaIndex()), int(request.GetPort()))
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	return &proto.PodPortForwardResponse{LocalPort: int32(localPort)}, nil
+}
+
+func (s *server) GetPortForwards(context.Context, *empty.Empty) (*proto.ActivePortForwards, error) {
+	if s.portForwardsCallback == nil {
+		return &proto.ActivePortForwards{}, nil
+	}
+	return s.portForwardsCallback(), nil
+}
+
 func executeAutoTrigger(triggerName string, request *proto.TriggerRequest, updateTriggerStateFunc func(bool), resetPhaseStateFunc func(), serverCallback func(bool)) (res *empty.Empty, err error) {
 	res = &empty.Empty{}
 	v, ok := request.GetState().GetVal().(*proto.TriggerState_Enabled)
@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/event"
 	v2 "github.com/GoogleContainerTools/skaffold/pkg/skaffold/server/v2"
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/util"
@@ -48,12 +49,17 @@ var (
 )
 
 type server struct {
-	buildIntentCallback  func()
-	syncIntentCallback   func()
-	deployIntentCallback func()
-	autoBuildCallback    func(bool)
-	autoSyncCallback     func(bool)
-	autoDeployCallback   func(bool)
+	buildIntentCallback    func()
+	syncIntentCallback     func()
+	deployIntentCallback   func()
+	autoBuildCallback      func(bool)
+	autoSyncCallback       func(bool)
+	autoDeployCallback     func(bool)
+	portForwardCallback    func(int, bool) error
+	portForwardsCallback   func() *proto.ActivePortForwards
+	podPortForwardCallback func(namespace, podName string, port int) (int, error)
+	// replicaPortForwardCallback forwards a container port of the replica of a workload picked by its IP suffix or index.
+	replicaPortForwardCallback func(namespace, owner string, ipSuffix, index, port int) (int, error)
 }
 
 func SetBuildCallback(callback func()) {
@@ -92,6 +98,30 @@ func SetAutoSyncCallback(callback func(bool)) {
 	}
 }
 
+func SetPortForwardCallback(callback func(int, bool) error) {
+	if srv != nil {
+		srv.portForwardCallback = callback
+	}
+}
+
+func SetPortForwardsCallback(callback func() *proto.ActivePortForwards) {
+	if srv != nil {
+		srv.portForwardsCallback = callback
+	}
+}
+
+func SetPodPortForwardCallback(callback func(namespace, podName string, port int) (int, error)) {
+	if srv != nil {
+		srv.podPortForwardCallback = callback
+	}
+}
+
+func SetReplicaPortForwardCallback(callback func(namespace, owner string, ipSuffix, index, port int) (int, error)) {
+	if srv != nil {
+		srv.replicaPortForwardCallback = callback
+	}
+}
+
 // Initialize creates the gRPC and HTTP servers for serving the state and event log.
 // It returns a shutdown callback for tearing down the grpc server,
 // which the runner is responsible for calling.
@@ -153,6 +183,7 @@ func newGRPCServer(preferredPort int, usedPorts *util.PortSet) (func() error, in
 		autoBuildCallback:    func(bool) {},
 		autoSyncCallback:     func(bool) {},
 		autoDeployCallback:   func(bool) {},
+		portForwardCallback:  func(int, bool) error { return errors.New("port forwarding is not enabled") },
 	}
 	v2.Srv = &v2.Server{
 		BuildIntentCallback:  func() {},
@@ -233,8 +264,13 @@ func errorHandler(ctx context.Context, _ *runtime.ServeMux, marshaler runtime.Ma
 	writer.Header().Set("Content-type", marshaler.ContentType())
 	s, _ := status.FromError(err)
 	writer.WriteHeader(runtime.HTTPStatusFromCode(s.Code()))
+	msg := s.Message()
+	if rpcConnectionRefused.MatchString(msg) {
+		// the gateway can't reach the gRPC server
+		msg = sErrors.ShowAIError(nil, errors.New(msg)).Error()
+	}
 	if err := json.NewEncoder(writer).Encode(errResponse{
-		Err: s.Message(),
+		Err: msg,
 	}); err != nil {
 		writer.Write([]byte(`{"error": "failed to marshal error message"}`))
 	}
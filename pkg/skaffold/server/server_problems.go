@@ -0,0 +1,59 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches a client of Skaffold's gRPC API, such as the HTTP gateway, dialing a port nothing listens on, e.g.
+	// failed to dial gRPC: connection error: desc = "transport: Error while dialing dial tcp 127.0.0.1:50051: connect: connection refused"
+	rpcConnectionRefused = regexp.MustCompile(`(?:failed to dial gRPC|Error while dialing)[^\n]*?dial tcp [^\s"]*:(\d+): connect: connection refused`)
+
+	problems = []sErrors.Problem{
+		{
+			Regexp:      rpcConnectionRefused,
+			ErrCode:     proto.StatusCode_DEVINIT_RPC_CONNECTION_REFUSED,
+			Description: rpcConnectionRefusedDescription,
+			Suggestion: func(interface{}) []*proto.Suggestion {
+				return []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_RPC_PORT,
+					Action: fmt.Sprintf("Check that Skaffold is running with `--enable-rpc` (the default for `skaffold dev` and `skaffold debug`),"+
+						" and connect to the ports it reports when it starts: they are set with `--rpc-port` (default %d) for gRPC and `--rpc-http-port` (default %d) for HTTP,"+
+						" and Skaffold picks other ports when these are taken", constants.DefaultRPCPort, constants.DefaultRPCHTTPPort),
+				}}
+			},
+		},
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.DevInit, problems)
+}
+
+func rpcConnectionRefusedDescription(err error) string {
+	if match := rpcConnectionRefused.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return fmt.Sprintf("Could not connect to the API of Skaffold on port %s: the API server isn't running, or it listens on a different port", match[1])
+	}
+	return "Could not connect to the API of Skaffold: the API server isn't running, or it listens on a different port"
+}
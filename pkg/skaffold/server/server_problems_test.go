@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestServerProblems(t *testing.T) {
+	suggestion := "Check that Skaffold is running with `--enable-rpc` (the default for `skaffold dev` and `skaffold debug`)," +
+		" and connect to the ports it reports when it starts: they are set with `--rpc-port` (default 50051) for gRPC and `--rpc-http-port` (default 50052) for HTTP," +
+		" and Skaffold picks other ports when these are taken"
+
+	tests := []struct {
+		description string
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "gRPC client",
+			err:         fmt.Errorf(`failed to dial gRPC: connection error: desc = "transport: Error while dialing dial tcp 127.0.0.1:50051: connect: connection refused"`),
+			expected:    "Could not connect to the API of Skaffold on port 50051: the API server isn't running, or it listens on a different port. " + suggestion + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEVINIT_RPC_CONNECTION_REFUSED,
+				Message: `failed to dial gRPC: connection error: desc = "transport: Error while dialing dial tcp 127.0.0.1:50051: connect: connection refused"`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_RPC_PORT,
+					Action:         suggestion,
+				}},
+			},
+		},
+		{
+			description: "gateway",
+			err:         fmt.Errorf(`connection error: desc = "transport: Error while dialing dial tcp [::1]:12345: connect: connection refused"`),
+			expected:    "Could not connect to the API of Skaffold on port 12345: the API server isn't running, or it listens on a different port. " + suggestion + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEVINIT_RPC_CONNECTION_REFUSED,
+				Message: `connection error: desc = "transport: Error while dialing dial tcp [::1]:12345: connect: connection refused"`,
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_RPC_PORT,
+					Action:         suggestion,
+				}},
+			},
+		},
+		{
+			description: "other connection refused",
+			err:         fmt.Errorf("Get \"http://127.0.0.1:8080\": dial tcp 127.0.0.1:8080: connect: connection refused"),
+			expected:    "Get \"http://127.0.0.1:8080\": dial tcp 127.0.0.1:8080: connect: connection refused",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_DEVINIT_UNKNOWN,
+				Message:     "Get \"http://127.0.0.1:8080\": dial tcp 127.0.0.1:8080: connect: connection refused",
+				Suggestions: sErrors.ReportIssueSuggestion(nil),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.DevInit, problems)
+				return pc
+			})
+			t.CheckDeepEqual(test.expected, sErrors.ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(test.expectedAE, sErrors.ActionableErr(nil, constants.DevInit, test.err))
+		})
+	}
+}
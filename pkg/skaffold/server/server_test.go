@@ -17,11 +17,20 @@ limitations under the License.
 package server
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/config"
 	"github.com/GoogleContainerTools/skaffold/proto/v1"
@@ -66,3 +75,202 @@ func TestServerStartup(t *testing.T) {
 		httpConn.Close()
 	}
 }
+
+func TestPortForward(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *proto.PortForwardRequest
+		callbackErr error
+		expectedErr codes.Code
+		expected    []string
+	}{
+		{
+			description: "disable",
+			request:     &proto.PortForwardRequest{LocalPort: 9000, State: &proto.TriggerState{Val: &proto.TriggerState_Enabled{Enabled: false}}},
+			expected:    []string{"9000:false"},
+		},
+		{
+			description: "enable",
+			request:     &proto.PortForwardRequest{LocalPort: 9000, State: &proto.TriggerState{Val: &proto.TriggerState_Enabled{Enabled: true}}},
+			expected:    []string{"9000:true"},
+		},
+		{
+			description: "missing state",
+			request:     &proto.PortForwardRequest{LocalPort: 9000},
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			description: "unknown port forward",
+			request:     &proto.PortForwardRequest{LocalPort: 9000, State: &proto.TriggerState{Val: &proto.TriggerState_Enabled{Enabled: false}}},
+			callbackErr: errors.New("no port forward on local port 9000"),
+			expectedErr: codes.FailedPrecondition,
+			expected:    []string{"9000:false"},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			var calls []string
+			s := &server{
+				portForwardCallback: func(localPort int, enabled bool) error {
+					calls = append(calls, fmt.Sprintf("%d:%t", localPort, enabled))
+					return test.callbackErr
+				},
+			}
+
+			_, err := s.PortForward(context.Background(), test.request)
+
+			t.CheckDeepEqual(test.expectedErr, status.Code(err))
+			t.CheckDeepEqual(test.expected, calls)
+		})
+	}
+}
+
+func TestForwardPodPort(t *testing.T) {
+	tests := []struct {
+		description string
+		request     *proto.PodPortForwardRequest
+		noCallback  bool
+		callbackErr error
+		expectedErr codes.Code
+		expected    *proto.PodPortForwardResponse
+	}{
+		{
+			description: "forwards the pod port",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", PodName: "web-0", Port: 8080},
+			expected:    &proto.PodPortForwardResponse{LocalPort: 8080},
+		},
+		{
+			description: "missing port",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", PodName: "web-0"},
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			description: "pods not forwarded on demand",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", PodName: "web-0", Port: 8080},
+			noCallback:  true,
+			expectedErr: codes.FailedPrecondition,
+		},
+		{
+			description: "unknown pod",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", PodName: "web-0", Port: 8080},
+			callbackErr: errors.New("pod/web-0 is not running"),
+			expectedErr: codes.FailedPrecondition,
+		},
+		{
+			description: "forwards a replica by IP suffix",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", Owner: "deployment/web", PodIPSuffix: 17, Port: 8080},
+			expected:    &proto.PodPortForwardResponse{LocalPort: 11700},
+		},
+		{
+			description: "forwards a replica by index",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", Owner: "deployment/web", ReplicaIndex: 2, Port: 8080},
+			expected:    &proto.PodPortForwardResponse{LocalPort: 10002},
+		},
+		{
+			description: "replica selected by both IP suffix and index",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", Owner: "deployment/web", PodIPSuffix: 17, ReplicaIndex: 2, Port: 8080},
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			description: "replica without selector",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", Owner: "deployment/web", Port: 8080},
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			description: "both pod and owner",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", PodName: "web-0", Owner: "deployment/web", ReplicaIndex: 1, Port: 8080},
+			expectedErr: codes.InvalidArgument,
+		},
+		{
+			description: "replicas not forwarded on demand",
+			request:     &proto.PodPortForwardRequest{Namespace: "default", Owner: "deployment/web", ReplicaIndex: 1, Port: 8080},
+			noCallback:  true,
+			expectedErr: codes.FailedPrecondition,
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			s := &server{}
+			if !test.noCallback {
+				s.podPortForwardCallback = func(namespace, podName string, port int) (int, error) {
+					if test.callbackErr != nil {
+						return 0, test.callbackErr
+					}
+					return port, nil
+				}
+				s.replicaPortForwardCallback = func(namespace, owner string, ipSuffix, index, port int) (int, error) {
+					return 10000 + ipSuffix*100 + index, nil
+				}
+			}
+
+			actual, err := s.ForwardPodPort(context.Background(), test.request)
+
+			t.CheckDeepEqual(test.expectedErr, status.Code(err))
+			t.CheckDeepEqual(test.expected, actual)
+		})
+	}
+}
+
+func TestGetPortForwards(t *testing.T) {
+	testutil.Run(t, "no callback", func(t *testutil.T) {
+		s := &server{}
+
+		actual, err := s.GetPortForwards(context.Background(), &empty.Empty{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(&proto.ActivePortForwards{}, actual)
+	})
+
+	testutil.Run(t, "lists the active port forwards", func(t *testutil.T) {
+		expected := &proto.ActivePortForwards{PortForwards: []*proto.ActivePortForward{{
+			LocalPort:     9000,
+			Namespace:     "default",
+			ResourceType:  "service",
+			ResourceName:  "web",
+			State:         "Ready",
+			UptimeSeconds: 42,
+		}}}
+		s := &server{
+			portForwardsCallback: func() *proto.ActivePortForwards { return expected },
+		}
+
+		actual, err := s.GetPortForwards(context.Background(), &empty.Empty{})
+
+		t.CheckNoError(err)
+		t.CheckDeepEqual(expected, actual)
+	})
+}
+
+func TestErrorHandler(t *testing.T) {
+	tests := []struct {
+		description    string
+		err            error
+		expectedStatus int
+		expectedErr    string
+	}{
+		{
+			description:    "grpc error",
+			err:            status.Error(codes.InvalidArgument, "port is required"),
+			expectedStatus: http.StatusBadRequest,
+			expectedErr:    "port is required",
+		},
+		{
+			description:    "grpc server unreachable",
+			err:            status.Error(codes.Unavailable, `connection error: desc = "transport: Error while dialing dial tcp 127.0.0.1:50051: connect: connection refused"`),
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedErr:    "Could not connect to the API of Skaffold on port 50051: the API server isn't running, or it listens on a different port. Check that Skaffold is running with `--enable-rpc`",
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			recorder := httptest.NewRecorder()
+
+			errorHandler(context.Background(), nil, &runtime.JSONPb{}, recorder, nil, test.err)
+
+			var resp errResponse
+			t.CheckNoError(json.Unmarshal(recorder.Body.Bytes(), &resp))
+			t.CheckDeepEqual(test.expectedStatus, recorder.Code)
+			t.CheckContains(test.expectedErr, resp.Err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches files to sync that can't be watched or copied, often build outputs owned by root, e.g.
+	// failed to watch file /app/dist/main.js: permission denied
+	// copying files: open /app/dist/main.js: permission denied
+	permissionDeniedErr = regexp.MustCompile(`(?:failed to watch file|copying files:[^\n]*?(?:open|lstat|stat)) "?([^"\s]+?)"?: permission denied`)
+
+	problems = []sErrors.Problem{
+		{
+			Regexp:           permissionDeniedErr,
+			ErrCode:          proto.StatusCode_SYNC_PERMISSION_DENIED,
+			Description:      permissionDeniedDescription,
+			SuggestionForErr: permissionDeniedSuggestion,
+		},
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.Sync, problems)
+}
+
+func permissionDeniedDescription(err error) string {
+	return fmt.Sprintf("Could not sync %s: permission denied", deniedPath(err))
+}
+
+func permissionDeniedSuggestion(_ interface{}, err error) []*proto.Suggestion {
+	return []*proto.Suggestion{{
+		SuggestionCode: proto.SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS,
+		Action: fmt.Sprintf("Check the permissions and ownership of %s: Skaffold must be able to read the files it syncs."+
+			" Files written by a build running as root can be given back to you with `sudo chown -R $(id -u):$(id -g) <dir>`", deniedPath(err)),
+	}}
+}
+
+func deniedPath(err error) string {
+	if match := permissionDeniedErr.FindStringSubmatch(err.Error()); len(match) > 1 {
+		return match[1]
+	}
+	return "the files to sync"
+}
@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sync
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestSyncProblems(t *testing.T) {
+	suggestion := func(path string) string {
+		return "Check the permissions and ownership of " + path + ": Skaffold must be able to read the files it syncs." +
+			" Files written by a build running as root can be given back to you with `sudo chown -R $(id -u):$(id -g) <dir>`"
+	}
+
+	tests := []struct {
+		description string
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "watching a file",
+			err:         fmt.Errorf("failed to watch file /app/dist/main.js: permission denied"),
+			expected:    "Could not sync /app/dist/main.js: permission denied. " + suggestion("/app/dist/main.js") + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_SYNC_PERMISSION_DENIED,
+				Message: "failed to watch file /app/dist/main.js: permission denied",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS,
+					Action:         suggestion("/app/dist/main.js"),
+				}},
+			},
+		},
+		{
+			description: "reading a file",
+			err:         fmt.Errorf("copying files: open dist/index.html: permission denied"),
+			expected:    "Could not sync dist/index.html: permission denied. " + suggestion("dist/index.html") + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_SYNC_PERMISSION_DENIED,
+				Message: "copying files: open dist/index.html: permission denied",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS,
+					Action:         suggestion("dist/index.html"),
+				}},
+			},
+		},
+		{
+			description: "permission denied outside of file sync",
+			err:         fmt.Errorf("open /var/run/docker.sock: permission denied"),
+			expected:    "open /var/run/docker.sock: permission denied",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_SYNC_UNKNOWN,
+				Message:     "open /var/run/docker.sock: permission denied",
+				Suggestions: sErrors.ReportIssueSuggestion(nil),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.Sync, problems)
+				return pc
+			})
+			t.CheckDeepEqual(test.expected, sErrors.ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(test.expectedAE, sErrors.ActionableErr(nil, constants.Sync, test.err))
+		})
+	}
+}
@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"regexp"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+)
+
+var (
+	// matches the inotify limits being reached on Linux, when creating a watcher or adding a watch, e.g.
+	// failed to create fsnotify watcher: too many open files
+	inotifyLimitErr = regexp.MustCompile(`(?i)(?:fsnotify|inotify)[^\n]*: (?:too many open files|no space left on device)`)
+
+	problems = []sErrors.Problem{
+		{
+			Regexp:  inotifyLimitErr,
+			ErrCode: proto.StatusCode_DEVINIT_INOTIFY_LIMIT,
+			Description: func(error) string {
+				return "Could not watch files for changes: the inotify limits of the system were reached"
+			},
+			Suggestion: func(interface{}) []*proto.Suggestion {
+				return []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_RAISE_INOTIFY_LIMITS,
+					Action: "Raise the inotify limits with `sudo sysctl -w fs.inotify.max_user_watches=524288 fs.inotify.max_user_instances=512`" +
+						" and add them to /etc/sysctl.conf to keep them after a reboot, or watch for changes with `--trigger=polling`",
+				}}
+			},
+		},
+	}
+)
+
+func init() {
+	sErrors.AddPhaseProblems(constants.DevInit, problems)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Skaffold Authors
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/GoogleContainerTools/skaffold/pkg/skaffold/constants"
+	sErrors "github.com/GoogleContainerTools/skaffold/pkg/skaffold/errors"
+	"github.com/GoogleContainerTools/skaffold/proto/v1"
+	"github.com/GoogleContainerTools/skaffold/testutil"
+)
+
+func TestTriggerProblems(t *testing.T) {
+	suggestion := "Raise the inotify limits with `sudo sysctl -w fs.inotify.max_user_watches=524288 fs.inotify.max_user_instances=512`" +
+		" and add them to /etc/sysctl.conf to keep them after a reboot, or watch for changes with `--trigger=polling`"
+
+	tests := []struct {
+		description string
+		err         error
+		expected    string
+		expectedAE  *proto.ActionableErr
+	}{
+		{
+			description: "fsnotify watcher",
+			err:         fmt.Errorf("watching files for deployer: failed to create fsnotify watcher: too many open files"),
+			expected:    "Could not watch files for changes: the inotify limits of the system were reached. " + suggestion + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEVINIT_INOTIFY_LIMIT,
+				Message: "watching files for deployer: failed to create fsnotify watcher: too many open files",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_RAISE_INOTIFY_LIMITS,
+					Action:         suggestion,
+				}},
+			},
+		},
+		{
+			description: "inotify watches",
+			err:         fmt.Errorf("unable to start trigger: inotify_add_watch /app/node_modules: no space left on device"),
+			expected:    "Could not watch files for changes: the inotify limits of the system were reached. " + suggestion + ".",
+			expectedAE: &proto.ActionableErr{
+				ErrCode: proto.StatusCode_DEVINIT_INOTIFY_LIMIT,
+				Message: "unable to start trigger: inotify_add_watch /app/node_modules: no space left on device",
+				Suggestions: []*proto.Suggestion{{
+					SuggestionCode: proto.SuggestionCode_RAISE_INOTIFY_LIMITS,
+					Action:         suggestion,
+				}},
+			},
+		},
+		{
+			description: "other open files limit",
+			err:         fmt.Errorf("open skaffold.yaml: too many open files"),
+			expected:    "open skaffold.yaml: too many open files",
+			expectedAE: &proto.ActionableErr{
+				ErrCode:     proto.StatusCode_DEVINIT_UNKNOWN,
+				Message:     "open skaffold.yaml: too many open files",
+				Suggestions: sErrors.ReportIssueSuggestion(nil),
+			},
+		},
+	}
+	for _, test := range tests {
+		testutil.Run(t, test.description, func(t *testutil.T) {
+			t.Override(&sErrors.GetProblemCatalogCopy, func() sErrors.ProblemCatalog {
+				pc := sErrors.NewProblemCatalog()
+				pc.AddPhaseProblems(constants.DevInit, problems)
+				return pc
+			})
+			t.CheckDeepEqual(test.expected, sErrors.ShowAIError(nil, test.err).Error())
+			t.CheckDeepEqual(test.expectedAE, sErrors.ActionableErr(nil, constants.DevInit, test.err))
+		})
+	}
+}
@@ -354,6 +354,22 @@ const (
 	StatusCode_BUILD_DOCKER_GET_DIGEST_ERR StatusCode = 117
 	// Build error due no space left in docker.
 	StatusCode_BUILD_DOCKER_NO_SPACE_ERR StatusCode = 127
+	// Build error due to the registry responding with a server error (5xx) during push.
+	StatusCode_BUILD_REGISTRY_SERVER_ERR StatusCode = 128
+	// Build error due to a host name, eg. of the registry, that could not be resolved.
+	StatusCode_BUILD_HOST_NOT_FOUND StatusCode = 129
+	// Build error due to a TLS handshake timeout while reaching an image registry.
+	StatusCode_BUILD_REGISTRY_TLS_TIMEOUT StatusCode = 130
+	// Kaniko could not verify that it can push to the destination before building
+	StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR StatusCode = 131
+	// Build error due to an image name that is not a valid reference, eg. with uppercase or illegal characters.
+	StatusCode_BUILD_INVALID_IMAGE_REFERENCE StatusCode = 132
+	// Build error due to the connection with an image registry being reset while pushing or pulling an image.
+	StatusCode_BUILD_REGISTRY_CONNECTION_RESET StatusCode = 133
+	// Build error due to a source of a COPY or ADD instruction of the Dockerfile missing from the build context.
+	StatusCode_BUILD_COPY_SOURCE_NOT_FOUND StatusCode = 134
+	// Build error due to an image of the local Docker daemon whose layers are missing from the daemon's storage.
+	StatusCode_BUILD_CORRUPT_LOCAL_IMAGE StatusCode = 135
 	// Build error due to digest for built artifact could not be retrieved from registry.
 	StatusCode_BUILD_REGISTRY_GET_DIGEST_ERR StatusCode = 118
 	// Build error indicating unknown Jib plugin type. Should be one of [maven, gradle]
@@ -382,6 +398,10 @@ const (
 	StatusCode_STATUSCHECK_CONTAINER_TERMINATED StatusCode = 303
 	// Deployment waiting for rollout
 	StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING StatusCode = 304
+	// Container image manifest not found in the registry
+	StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN StatusCode = 305
+	// Container must run as non-root but its image runs as root
+	StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT StatusCode = 306
 	// Container restarting error
 	StatusCode_STATUSCHECK_CONTAINER_RESTARTING StatusCode = 356
 	// Readiness probe failed
@@ -411,6 +431,22 @@ const (
 	// Kubectl client fetch err
 	StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR StatusCode = 411
 	StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR     StatusCode = 412
+	// No node matches the pod's node selector or node affinity
+	StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH StatusCode = 413
+	// The pod does not tolerate the taints of the available nodes
+	StatusCode_STATUSCHECK_UNTOLERATED_TAINT StatusCode = 414
+	// The cluster rejected the credentials used to check the status of resources
+	StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED StatusCode = 415
+	// The pod can't be scheduled until its PersistentVolumeClaims are bound
+	StatusCode_STATUSCHECK_UNBOUND_PVC StatusCode = 416
+	// The pod was evicted by the kubelet, usually because its node ran low on resources
+	StatusCode_STATUSCHECK_POD_EVICTED StatusCode = 417
+	// The certificate of the cluster's API server is signed by an authority that isn't trusted by the kubeconfig.
+	StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY StatusCode = 418
+	// The pods of a Job failed as many times as its backoff limit allows
+	StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT StatusCode = 419
+	// A Service has no ready endpoints, so connections to it fail
+	StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS StatusCode = 420
 	// Pod Initializing
 	StatusCode_STATUSCHECK_POD_INITIALIZING StatusCode = 451
 	// Could not determine error and phase
@@ -439,8 +475,12 @@ const (
 	StatusCode_BUILD_DOCKER_UNKNOWN StatusCode = 511
 	// Test failed due to unknown reason
 	StatusCode_TEST_UNKNOWN StatusCode = 512
+	// Render failed due to unknown reason
+	StatusCode_RENDER_UNKNOWN StatusCode = 513
 	// File Sync Initialize failure
 	StatusCode_SYNC_INIT_ERROR StatusCode = 601
+	// Files to sync can't be watched or read because of their permissions
+	StatusCode_SYNC_PERMISSION_DENIED StatusCode = 602
 	// Failed to configure watcher for build dependencies in dev loop
 	StatusCode_DEVINIT_REGISTER_BUILD_DEPS StatusCode = 701
 	// Failed to configure watcher for test dependencies in dev loop
@@ -451,6 +491,14 @@ const (
 	StatusCode_DEVINIT_REGISTER_CONFIG_DEP StatusCode = 704
 	// Failed to configure watcher for build dependencies for a base image with v1 manifest.
 	StatusCode_DEVINIT_UNSUPPORTED_V1_MANIFEST StatusCode = 705
+	// Failed to watch the pods of a namespace
+	StatusCode_DEVINIT_WATCH_PODS_FAILED StatusCode = 706
+	// No local port is available to forward a resource to
+	StatusCode_DEVINIT_NO_AVAILABLE_PORT StatusCode = 707
+	// The inotify limits of the system were reached while watching files for changes
+	StatusCode_DEVINIT_INOTIFY_LIMIT StatusCode = 708
+	// A client could not connect to the gRPC or HTTP API of Skaffold
+	StatusCode_DEVINIT_RPC_CONNECTION_REFUSED StatusCode = 709
 	// User cancelled the skaffold dev run
 	StatusCode_STATUSCHECK_USER_CANCELLED StatusCode = 800
 	// Deadline for status check exceeded
@@ -481,6 +529,14 @@ const (
 	StatusCode_INIT_CREATE_WATCH_TRIGGER_ERROR StatusCode = 908
 	// Skaffold encountered an error when evaluating artifact dependencies
 	StatusCode_INIT_CREATE_ARTIFACT_DEP_ERROR StatusCode = 909
+	// Skaffold was unable to start as the Minikube profile does not exist
+	StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND StatusCode = 910
+	// Skaffold was unable to start as the kind cluster does not exist
+	StatusCode_INIT_KIND_CLUSTER_NOT_FOUND StatusCode = 911
+	// Skaffold was unable to start as a host name could not be resolved
+	StatusCode_INIT_HOST_NOT_FOUND StatusCode = 912
+	// A network referenced by the docker-compose file does not exist
+	StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND StatusCode = 913
 	// Unable to connect to cluster
 	StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR StatusCode = 1001
 	// Could not retrieve debug helpers.
@@ -529,6 +585,44 @@ const (
 	StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR StatusCode = 1023
 	// The Kptfile cannot be created via `kpt live init`.
 	StatusCode_DEPLOY_KPTFILE_INIT_ERR StatusCode = 1024
+	// A manifest failed `kubectl` schema validation.
+	StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR StatusCode = 1025
+	// The cluster rejected the credentials used to deploy.
+	StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED StatusCode = 1026
+	// An admission webhook denied a resource.
+	StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED StatusCode = 1027
+	// Helm could not find the chart, its version or its repository.
+	StatusCode_DEPLOY_HELM_CHART_NOT_FOUND StatusCode = 1028
+	// A host name, eg. of the cluster API server, could not be resolved.
+	StatusCode_DEPLOY_HOST_NOT_FOUND StatusCode = 1029
+	// A TLS handshake with an image registry timed out while pulling an image.
+	StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT StatusCode = 1030
+	// A resource's annotations, usually the `last-applied-configuration` written by `kubectl apply`, exceed 262144 bytes.
+	StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG StatusCode = 1031
+	// Helm timed out waiting for the resources of a release to be ready, when deploying with `--wait`.
+	StatusCode_DEPLOY_HELM_WAIT_TIMEOUT StatusCode = 1032
+	// An image name is not a valid reference, eg. with uppercase or illegal characters.
+	StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE StatusCode = 1033
+	// An immutable field of a resource, eg. the `spec.selector` of a Deployment, was changed since it was created.
+	StatusCode_DEPLOY_IMMUTABLE_FIELD StatusCode = 1034
+	// kubectl isn't logged in to the cluster of the kube-context, eg. because its credentials expired.
+	StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN StatusCode = 1035
+	// A Secret or ConfigMap referenced by the deployed resources doesn't exist.
+	StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND StatusCode = 1036
+	// The values of a Helm release are not valid YAML.
+	StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR StatusCode = 1037
+	// The connection with an image registry was reset while pulling an image.
+	StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET StatusCode = 1038
+	// The certificate of the cluster's API server is signed by an authority that isn't trusted by the kubeconfig.
+	StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY StatusCode = 1039
+	// Deploy error due to a built-in API version of a resource that the cluster's Kubernetes version doesn't serve anymore.
+	StatusCode_DEPLOY_REMOVED_API_VERSION StatusCode = 1040
+	// A container of a deployed resource has no image, usually because the image of the manifest wasn't replaced by a built image.
+	StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE StatusCode = 1041
+	// A resource to delete during cleanup is already gone.
+	StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND StatusCode = 1042
+	// A manifest is not valid YAML: a mapping value is misplaced, usually because of its indentation.
+	StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR StatusCode = 1043
 	// Error expanding paths
 	StatusCode_TEST_USER_CONFIG_ERR StatusCode = 1101
 	// Error running container-structure-test
@@ -562,6 +656,12 @@ const (
 	StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR StatusCode = 1401
 	// The Kptfile is not a valid API schema
 	StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR StatusCode = 1402
+	// A Helm chart template failed to render
+	StatusCode_RENDER_HELM_TEMPLATE_ERR StatusCode = 1502
+	// `kustomize build` failed to render a kustomization
+	StatusCode_RENDER_KUSTOMIZE_BUILD_ERR StatusCode = 1503
+	// The OpenAPI schema used to validate manifests couldn't be loaded from the API server
+	StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE StatusCode = 1504
 	// Catch-all configuration file parsing error
 	StatusCode_CONFIG_FILE_PARSING_ERR StatusCode = 1201
 	// Main configuration file not found
@@ -594,6 +694,8 @@ const (
 	StatusCode_CONFIG_UNKNOWN_TRANSFORMER StatusCode = 1215
 	// Manifest file not found
 	StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR StatusCode = 1216
+	// A field of the config has a value of the wrong type, eg. a string where an int is expected.
+	StatusCode_CONFIG_FIELD_TYPE_ERR StatusCode = 1217
 	// Catch-all `skaffold inspect` command error
 	StatusCode_INSPECT_UNKNOWN_ERR StatusCode = 1301
 	// Trying to add new build environment that already exists
@@ -629,6 +731,14 @@ var StatusCode_name = map[int32]string{
 	116:  "BUILD_DOCKER_CACHE_FROM_PULL_ERR",
 	117:  "BUILD_DOCKER_GET_DIGEST_ERR",
 	127:  "BUILD_DOCKER_NO_SPACE_ERR",
+	128:  "BUILD_REGISTRY_SERVER_ERR",
+	129:  "BUILD_HOST_NOT_FOUND",
+	130:  "BUILD_REGISTRY_TLS_TIMEOUT",
+	131:  "BUILD_KANIKO_PUSH_PERMISSION_ERR",
+	132:  "BUILD_INVALID_IMAGE_REFERENCE",
+	133:  "BUILD_REGISTRY_CONNECTION_RESET",
+	134:  "BUILD_COPY_SOURCE_NOT_FOUND",
+	135:  "BUILD_CORRUPT_LOCAL_IMAGE",
 	118:  "BUILD_REGISTRY_GET_DIGEST_ERR",
 	119:  "BUILD_UNKNOWN_JIB_PLUGIN_TYPE",
 	120:  "BUILD_JIB_GRADLE_DEP_ERR",
@@ -643,6 +753,8 @@ var StatusCode_name = map[int32]string{
 	302:  "STATUSCHECK_RUN_CONTAINER_ERR",
 	303:  "STATUSCHECK_CONTAINER_TERMINATED",
 	304:  "STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING",
+	305:  "STATUSCHECK_IMAGE_MANIFEST_UNKNOWN",
+	306:  "STATUSCHECK_CONTAINER_RUN_AS_ROOT",
 	356:  "STATUSCHECK_CONTAINER_RESTARTING",
 	357:  "STATUSCHECK_UNHEALTHY",
 	358:  "STATUSCHECK_CONTAINER_EXEC_ERROR",
@@ -658,6 +770,14 @@ var StatusCode_name = map[int32]string{
 	410:  "STATUSCHECK_KUBECTL_PID_KILLED",
 	411:  "STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR",
 	412:  "STATUSCHECK_DEPLOYMENT_FETCH_ERR",
+	413:  "STATUSCHECK_NODE_SELECTOR_MISMATCH",
+	414:  "STATUSCHECK_UNTOLERATED_TAINT",
+	415:  "STATUSCHECK_CLUSTER_UNAUTHORIZED",
+	416:  "STATUSCHECK_UNBOUND_PVC",
+	417:  "STATUSCHECK_POD_EVICTED",
+	418:  "STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY",
+	419:  "STATUSCHECK_JOB_BACKOFF_LIMIT",
+	420:  "STATUSCHECK_SERVICE_NO_ENDPOINTS",
 	451:  "STATUSCHECK_POD_INITIALIZING",
 	500:  "UNKNOWN_ERROR",
 	501:  "STATUSCHECK_UNKNOWN",
@@ -672,12 +792,18 @@ var StatusCode_name = map[int32]string{
 	510:  "INIT_UNKNOWN",
 	511:  "BUILD_DOCKER_UNKNOWN",
 	512:  "TEST_UNKNOWN",
+	513:  "RENDER_UNKNOWN",
 	601:  "SYNC_INIT_ERROR",
+	602:  "SYNC_PERMISSION_DENIED",
 	701:  "DEVINIT_REGISTER_BUILD_DEPS",
 	702:  "DEVINIT_REGISTER_TEST_DEPS",
 	703:  "DEVINIT_REGISTER_DEPLOY_DEPS",
 	704:  "DEVINIT_REGISTER_CONFIG_DEP",
 	705:  "DEVINIT_UNSUPPORTED_V1_MANIFEST",
+	706:  "DEVINIT_WATCH_PODS_FAILED",
+	707:  "DEVINIT_NO_AVAILABLE_PORT",
+	708:  "DEVINIT_INOTIFY_LIMIT",
+	709:  "DEVINIT_RPC_CONNECTION_REFUSED",
 	800:  "STATUSCHECK_USER_CANCELLED",
 	801:  "STATUSCHECK_DEADLINE_EXCEEDED",
 	802:  "BUILD_CANCELLED",
@@ -693,6 +819,10 @@ var StatusCode_name = map[int32]string{
 	907:  "INIT_CACHE_ERROR",
 	908:  "INIT_CREATE_WATCH_TRIGGER_ERROR",
 	909:  "INIT_CREATE_ARTIFACT_DEP_ERROR",
+	910:  "INIT_MINIKUBE_PROFILE_NOT_FOUND",
+	911:  "INIT_KIND_CLUSTER_NOT_FOUND",
+	912:  "INIT_HOST_NOT_FOUND",
+	913:  "INIT_COMPOSE_NETWORK_NOT_FOUND",
 	1001: "DEPLOY_CLUSTER_CONNECTION_ERR",
 	1002: "DEPLOY_DEBUG_HELPER_RETRIEVE_ERR",
 	1003: "DEPLOY_CLEANUP_ERR",
@@ -717,6 +847,25 @@ var StatusCode_name = map[int32]string{
 	1022: "DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE",
 	1023: "DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR",
 	1024: "DEPLOY_KPTFILE_INIT_ERR",
+	1025: "DEPLOY_MANIFEST_VALIDATION_ERR",
+	1026: "DEPLOY_CLUSTER_UNAUTHORIZED",
+	1027: "DEPLOY_ADMISSION_WEBHOOK_DENIED",
+	1028: "DEPLOY_HELM_CHART_NOT_FOUND",
+	1029: "DEPLOY_HOST_NOT_FOUND",
+	1030: "DEPLOY_REGISTRY_TLS_TIMEOUT",
+	1031: "DEPLOY_ANNOTATIONS_TOO_LONG",
+	1032: "DEPLOY_HELM_WAIT_TIMEOUT",
+	1033: "DEPLOY_INVALID_IMAGE_REFERENCE",
+	1034: "DEPLOY_IMMUTABLE_FIELD",
+	1035: "DEPLOY_KUBECTL_NOT_LOGGED_IN",
+	1036: "DEPLOY_REFERENCED_RESOURCE_NOT_FOUND",
+	1037: "DEPLOY_HELM_VALUES_PARSE_ERR",
+	1038: "DEPLOY_REGISTRY_CONNECTION_RESET",
+	1039: "DEPLOY_CLUSTER_UNKNOWN_AUTHORITY",
+	1040: "DEPLOY_REMOVED_API_VERSION",
+	1041: "DEPLOY_EMPTY_CONTAINER_IMAGE",
+	1042: "DEPLOY_CLEANUP_RESOURCE_NOT_FOUND",
+	1043: "DEPLOY_MANIFEST_YAML_SYNTAX_ERR",
 	1101: "TEST_USER_CONFIG_ERR",
 	1102: "TEST_CST_USER_ERR",
 	1103: "TEST_IMG_PULL_ERR",
@@ -733,6 +882,9 @@ var StatusCode_name = map[int32]string{
 	1501: "RENDER_KPTFILE_INIT_ERR",
 	1401: "RENDER_KPTFILE_INVALID_YAML_ERR",
 	1402: "RENDER_KPTFILE_INVALID_SCHEMA_ERR",
+	1502: "RENDER_HELM_TEMPLATE_ERR",
+	1503: "RENDER_KUSTOMIZE_BUILD_ERR",
+	1504: "RENDER_VALIDATION_SCHEMA_UNAVAILABLE",
 	1201: "CONFIG_FILE_PARSING_ERR",
 	1202: "CONFIG_FILE_NOT_FOUND_ERR",
 	1203: "CONFIG_DEPENDENCY_NOT_FOUND_ERR",
@@ -749,6 +901,7 @@ var StatusCode_name = map[int32]string{
 	1214: "CONFIG_UNKNOWN_VALIDATOR",
 	1215: "CONFIG_UNKNOWN_TRANSFORMER",
 	1216: "CONFIG_MISSING_MANIFEST_FILE_ERR",
+	1217: "CONFIG_FIELD_TYPE_ERR",
 	1301: "INSPECT_UNKNOWN_ERR",
 	1302: "INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR",
 	1303: "INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR",
@@ -780,6 +933,14 @@ var StatusCode_value = map[string]int32{
 	"BUILD_DOCKER_CACHE_FROM_PULL_ERR":             116,
 	"BUILD_DOCKER_GET_DIGEST_ERR":                  117,
 	"BUILD_DOCKER_NO_SPACE_ERR":                    127,
+	"BUILD_REGISTRY_SERVER_ERR":                    128,
+	"BUILD_HOST_NOT_FOUND":                         129,
+	"BUILD_REGISTRY_TLS_TIMEOUT":                   130,
+	"BUILD_KANIKO_PUSH_PERMISSION_ERR":             131,
+	"BUILD_INVALID_IMAGE_REFERENCE":                132,
+	"BUILD_REGISTRY_CONNECTION_RESET":              133,
+	"BUILD_COPY_SOURCE_NOT_FOUND":                  134,
+	"BUILD_CORRUPT_LOCAL_IMAGE":                    135,
 	"BUILD_REGISTRY_GET_DIGEST_ERR":                118,
 	"BUILD_UNKNOWN_JIB_PLUGIN_TYPE":                119,
 	"BUILD_JIB_GRADLE_DEP_ERR":                     120,
@@ -794,6 +955,8 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_RUN_CONTAINER_ERR":                302,
 	"STATUSCHECK_CONTAINER_TERMINATED":             303,
 	"STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING":       304,
+	"STATUSCHECK_IMAGE_MANIFEST_UNKNOWN":           305,
+	"STATUSCHECK_CONTAINER_RUN_AS_ROOT":            306,
 	"STATUSCHECK_CONTAINER_RESTARTING":             356,
 	"STATUSCHECK_UNHEALTHY":                        357,
 	"STATUSCHECK_CONTAINER_EXEC_ERROR":             358,
@@ -809,6 +972,14 @@ var StatusCode_value = map[string]int32{
 	"STATUSCHECK_KUBECTL_PID_KILLED":               410,
 	"STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR":         411,
 	"STATUSCHECK_DEPLOYMENT_FETCH_ERR":             412,
+	"STATUSCHECK_NODE_SELECTOR_MISMATCH":           413,
+	"STATUSCHECK_UNTOLERATED_TAINT":                414,
+	"STATUSCHECK_CLUSTER_UNAUTHORIZED":             415,
+	"STATUSCHECK_UNBOUND_PVC":                      416,
+	"STATUSCHECK_POD_EVICTED":                      417,
+	"STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY":        418,
+	"STATUSCHECK_JOB_BACKOFF_LIMIT":                419,
+	"STATUSCHECK_SERVICE_NO_ENDPOINTS":             420,
 	"STATUSCHECK_POD_INITIALIZING":                 451,
 	"UNKNOWN_ERROR":                                500,
 	"STATUSCHECK_UNKNOWN":                          501,
@@ -823,12 +994,18 @@ var StatusCode_value = map[string]int32{
 	"INIT_UNKNOWN":                                 510,
 	"BUILD_DOCKER_UNKNOWN":                         511,
 	"TEST_UNKNOWN":                                 512,
+	"RENDER_UNKNOWN":                               513,
 	"SYNC_INIT_ERROR":                              601,
+	"SYNC_PERMISSION_DENIED":                       602,
 	"DEVINIT_REGISTER_BUILD_DEPS":                  701,
 	"DEVINIT_REGISTER_TEST_DEPS":                   702,
 	"DEVINIT_REGISTER_DEPLOY_DEPS":                 703,
 	"DEVINIT_REGISTER_CONFIG_DEP":                  704,
 	"DEVINIT_UNSUPPORTED_V1_MANIFEST":              705,
+	"DEVINIT_WATCH_PODS_FAILED":                    706,
+	"DEVINIT_NO_AVAILABLE_PORT":                    707,
+	"DEVINIT_INOTIFY_LIMIT":                        708,
+	"DEVINIT_RPC_CONNECTION_REFUSED":               709,
 	"STATUSCHECK_USER_CANCELLED":                   800,
 	"STATUSCHECK_DEADLINE_EXCEEDED":                801,
 	"BUILD_CANCELLED":                              802,
@@ -844,6 +1021,10 @@ var StatusCode_value = map[string]int32{
 	"INIT_CACHE_ERROR":                             907,
 	"INIT_CREATE_WATCH_TRIGGER_ERROR":              908,
 	"INIT_CREATE_ARTIFACT_DEP_ERROR":               909,
+	"INIT_MINIKUBE_PROFILE_NOT_FOUND":              910,
+	"INIT_KIND_CLUSTER_NOT_FOUND":                  911,
+	"INIT_HOST_NOT_FOUND":                          912,
+	"INIT_COMPOSE_NETWORK_NOT_FOUND":               913,
 	"DEPLOY_CLUSTER_CONNECTION_ERR":                1001,
 	"DEPLOY_DEBUG_HELPER_RETRIEVE_ERR":             1002,
 	"DEPLOY_CLEANUP_ERR":                           1003,
@@ -868,6 +1049,25 @@ var StatusCode_value = map[string]int32{
 	"DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE":          1022,
 	"DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR":           1023,
 	"DEPLOY_KPTFILE_INIT_ERR":                      1024,
+	"DEPLOY_MANIFEST_VALIDATION_ERR":               1025,
+	"DEPLOY_CLUSTER_UNAUTHORIZED":                  1026,
+	"DEPLOY_ADMISSION_WEBHOOK_DENIED":              1027,
+	"DEPLOY_HELM_CHART_NOT_FOUND":                  1028,
+	"DEPLOY_HOST_NOT_FOUND":                        1029,
+	"DEPLOY_REGISTRY_TLS_TIMEOUT":                  1030,
+	"DEPLOY_ANNOTATIONS_TOO_LONG":                  1031,
+	"DEPLOY_HELM_WAIT_TIMEOUT":                     1032,
+	"DEPLOY_INVALID_IMAGE_REFERENCE":               1033,
+	"DEPLOY_IMMUTABLE_FIELD":                       1034,
+	"DEPLOY_KUBECTL_NOT_LOGGED_IN":                 1035,
+	"DEPLOY_REFERENCED_RESOURCE_NOT_FOUND":         1036,
+	"DEPLOY_HELM_VALUES_PARSE_ERR":                 1037,
+	"DEPLOY_REGISTRY_CONNECTION_RESET":             1038,
+	"DEPLOY_CLUSTER_UNKNOWN_AUTHORITY":             1039,
+	"DEPLOY_REMOVED_API_VERSION":                   1040,
+	"DEPLOY_EMPTY_CONTAINER_IMAGE":                 1041,
+	"DEPLOY_CLEANUP_RESOURCE_NOT_FOUND":            1042,
+	"DEPLOY_MANIFEST_YAML_SYNTAX_ERR":              1043,
 	"TEST_USER_CONFIG_ERR":                         1101,
 	"TEST_CST_USER_ERR":                            1102,
 	"TEST_IMG_PULL_ERR":                            1103,
@@ -884,6 +1084,9 @@ var StatusCode_value = map[string]int32{
 	"RENDER_KPTFILE_INIT_ERR":                      1501,
 	"RENDER_KPTFILE_INVALID_YAML_ERR":              1401,
 	"RENDER_KPTFILE_INVALID_SCHEMA_ERR":            1402,
+	"RENDER_HELM_TEMPLATE_ERR":                     1502,
+	"RENDER_KUSTOMIZE_BUILD_ERR":                   1503,
+	"RENDER_VALIDATION_SCHEMA_UNAVAILABLE":         1504,
 	"CONFIG_FILE_PARSING_ERR":                      1201,
 	"CONFIG_FILE_NOT_FOUND_ERR":                    1202,
 	"CONFIG_DEPENDENCY_NOT_FOUND_ERR":              1203,
@@ -900,6 +1103,7 @@ var StatusCode_value = map[string]int32{
 	"CONFIG_UNKNOWN_VALIDATOR":                     1214,
 	"CONFIG_UNKNOWN_TRANSFORMER":                   1215,
 	"CONFIG_MISSING_MANIFEST_FILE_ERR":             1216,
+	"CONFIG_FIELD_TYPE_ERR":                        1217,
 	"INSPECT_UNKNOWN_ERR":                          1301,
 	"INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR":         1302,
 	"INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR":         1303,
@@ -955,6 +1159,20 @@ const (
 	SuggestionCode_RUN_DOCKER_PRUNE SuggestionCode = 115
 	// Set Cleanup flag for skaffold command.
 	SuggestionCode_SET_CLEANUP_FLAG SuggestionCode = 116
+	// Grant the IAM role needed to push to a Google Cloud registry
+	SuggestionCode_GRANT_GCP_REGISTRY_ROLE SuggestionCode = 117
+	// Check the Workload Identity configuration of the GKE workload
+	SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY SuggestionCode = 118
+	// Registry is temporarily unavailable, retry the push
+	SuggestionCode_RETRY_REGISTRY_PUSH SuggestionCode = 119
+	// Check the kaniko destination and the credentials mounted into the kaniko pod
+	SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION SuggestionCode = 120
+	// Fix the image name of the artifact
+	SuggestionCode_FIX_IMAGE_NAME SuggestionCode = 121
+	// Fix the sources of the COPY and ADD instructions of the Dockerfile, or the .dockerignore file
+	SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE SuggestionCode = 122
+	// Remove the corrupt image from the local Docker daemon and build again
+	SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE SuggestionCode = 123
 	// Check cluster connection
 	SuggestionCode_CHECK_CLUSTER_CONNECTION SuggestionCode = 201
 	// Check minikube status
@@ -969,14 +1187,46 @@ const (
 	SuggestionCode_UPGRADE_HELM32 SuggestionCode = 206
 	// Set `releases.createNamespace` to false.
 	SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE SuggestionCode = 207
+	// Fix the invalid field in the manifest.
+	SuggestionCode_FIX_INVALID_MANIFEST_FIELD SuggestionCode = 208
+	// Check the policies enforced by the admission webhook.
+	SuggestionCode_CHECK_ADMISSION_POLICY SuggestionCode = 209
+	// Add or update the Helm chart repository with `helm repo add` or `helm repo update`
+	SuggestionCode_ADD_HELM_REPO SuggestionCode = 210
+	// Fix the version of the Helm chart
+	SuggestionCode_FIX_HELM_CHART_VERSION SuggestionCode = 211
+	// Apply with `kubectl apply --server-side`, or reduce the size of the manifest
+	SuggestionCode_USE_SERVER_SIDE_APPLY SuggestionCode = 212
+	// Increase the Helm `--timeout`, or check why the release's resources are not ready
+	SuggestionCode_INCREASE_HELM_TIMEOUT SuggestionCode = 213
+	// Delete and recreate the resource, or revert the change to its immutable field
+	SuggestionCode_RECREATE_RESOURCE SuggestionCode = 214
+	// Log in to the cluster of the kube-context again
+	SuggestionCode_LOGIN_TO_CLUSTER SuggestionCode = 215
+	// Create or deploy the referenced Secret or ConfigMap, or fix its name
+	SuggestionCode_CREATE_REFERENCED_RESOURCE SuggestionCode = 216
+	// Fix the YAML syntax of the Helm values file
+	SuggestionCode_FIX_HELM_VALUES SuggestionCode = 217
+	// Update the apiVersion of the manifest to one served by the cluster's Kubernetes version
+	SuggestionCode_UPDATE_API_VERSION SuggestionCode = 218
+	// Check that the image of the manifest matches the name of a built artifact, so that Skaffold replaces it
+	SuggestionCode_CHECK_IMAGE_SUBSTITUTION SuggestionCode = 219
 	// Install kubectl tool
 	SuggestionCode_INSTALL_KUBECTL SuggestionCode = 220
+	// Fix the indentation of the manifest, and validate its YAML
+	SuggestionCode_FIX_MANIFEST_YAML SuggestionCode = 221
 	// Container run error
 	SuggestionCode_CHECK_CONTAINER_LOGS SuggestionCode = 301
 	// Pod Health check error
 	SuggestionCode_CHECK_READINESS_PROBE SuggestionCode = 302
 	// Check Container image
 	SuggestionCode_CHECK_CONTAINER_IMAGE SuggestionCode = 303
+	// Verify that the image push succeeded and that the tag matches
+	SuggestionCode_VERIFY_IMAGE_PUSHED SuggestionCode = 304
+	// Build the image for the architecture of the cluster nodes
+	SuggestionCode_BUILD_MULTI_ARCH_IMAGE SuggestionCode = 305
+	// Set a non-root user in the image, or `runAsUser` in the container's securityContext
+	SuggestionCode_RUN_AS_NON_ROOT_USER SuggestionCode = 306
 	// Node pressure error
 	SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE SuggestionCode = 400
 	// Node disk pressure error
@@ -995,18 +1245,58 @@ const (
 	SuggestionCode_ADDRESS_FAILED_SCHEDULING SuggestionCode = 407
 	// Cluster Connectivity error
 	SuggestionCode_CHECK_HOST_CONNECTION SuggestionCode = 408
+	// Adjust the pod's node selector or node affinity
+	SuggestionCode_FIX_NODE_SELECTOR SuggestionCode = 409
+	// Add tolerations for the node taints
+	SuggestionCode_ADD_TOLERATIONS SuggestionCode = 410
+	// Refresh the cluster credentials
+	SuggestionCode_REFRESH_CLUSTER_CREDENTIALS SuggestionCode = 411
+	// Check that the host name resolves
+	SuggestionCode_CHECK_HOST_RESOLUTION SuggestionCode = 412
+	// Check the connectivity to the image registry
+	SuggestionCode_CHECK_REGISTRY_CONNECTIVITY SuggestionCode = 413
+	// Check the provisioning of the pod's PersistentVolumeClaims
+	SuggestionCode_CHECK_PVC_PROVISIONING SuggestionCode = 414
+	// Check the resource pressure of the nodes and the resource requests of the pod
+	SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE SuggestionCode = 415
+	// Configure the CA of the cluster in the kubeconfig
+	SuggestionCode_CONFIGURE_CLUSTER_CA SuggestionCode = 416
+	// Check the logs of the pods of the Job, and fix the command that fails
+	SuggestionCode_CHECK_JOB_LOGS SuggestionCode = 417
+	// Check that the pods selected by the Service are ready, and that its selector matches their labels
+	SuggestionCode_CHECK_SERVICE_ENDPOINTS SuggestionCode = 418
 	// Minikube is stopped: use `minikube start`
 	SuggestionCode_START_MINIKUBE SuggestionCode = 501
 	// Minikube is paused: use `minikube unpause`
 	SuggestionCode_UNPAUSE_MINIKUBE SuggestionCode = 502
+	// Kind cluster does not exist: use `kind create cluster`
+	SuggestionCode_CREATE_KIND_CLUSTER SuggestionCode = 503
 	// Run Docker pull for the image with v1 manifest and try again.
 	SuggestionCode_RUN_DOCKER_PULL SuggestionCode = 551
+	// Check the permissions to list and watch pods in the namespace
+	SuggestionCode_CHECK_POD_WATCH_PERMISSIONS SuggestionCode = 552
+	// Create the network referenced by the docker-compose file, or fix the compose file
+	SuggestionCode_CREATE_COMPOSE_NETWORK SuggestionCode = 553
+	// Free local ports, or forward fewer resources
+	SuggestionCode_FREE_LOCAL_PORTS SuggestionCode = 554
+	// Raise the inotify limits of the system, eg. `fs.inotify.max_user_watches`
+	SuggestionCode_RAISE_INOTIFY_LIMITS SuggestionCode = 555
+	// Check that the API of Skaffold is enabled, and the port it listens on
+	SuggestionCode_CHECK_RPC_PORT SuggestionCode = 556
+	// Check the permissions and ownership of the files to sync
+	SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS SuggestionCode = 557
 	// Rerun with correct offline flag value.
 	SuggestionCode_SET_RENDER_FLAG_OFFLINE_FALSE SuggestionCode = 600
 	// Manually run `kpt pkg init` or `kpt live init`
 	SuggestionCode_KPTFILE_MANUAL_INIT SuggestionCode = 601
 	// Check if the Kptfile is correct.
 	SuggestionCode_KPTFILE_CHECK_YAML SuggestionCode = 602
+	// Fix the Helm chart template or the values it's rendered with
+	SuggestionCode_FIX_HELM_TEMPLATE SuggestionCode = 603
+	// Fix the kustomization
+	SuggestionCode_FIX_KUSTOMIZATION SuggestionCode = 604
+	// Turn client-side validation off, or check the connection to the API server
+	SuggestionCode_DISABLE_CLIENT_VALIDATION SuggestionCode = 605
 	// Check configuration file path
 	SuggestionCode_CONFIG_CHECK_FILE_PATH SuggestionCode = 700
 	// Check dependency config definition
@@ -1029,6 +1319,8 @@ const (
 	SuggestionCode_CONFIG_ALLOWLIST_transformers SuggestionCode = 709
 	// Check mising manifest file section of config and fix as needed.
 	SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE SuggestionCode = 710
+	// Fix the value of a config field to be of the expected type.
+	SuggestionCode_CONFIG_FIX_FIELD_TYPE SuggestionCode = 711
 	// Create new build env in a profile instead, or use the 'modify' command
 	SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE SuggestionCode = 800
 	// Check profile selection, or use the 'add' command instead
@@ -1064,6 +1356,13 @@ var SuggestionCode_name = map[int32]string{
 	114:  "FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME",
 	115:  "RUN_DOCKER_PRUNE",
 	116:  "SET_CLEANUP_FLAG",
+	117:  "GRANT_GCP_REGISTRY_ROLE",
+	118:  "CHECK_GKE_WORKLOAD_IDENTITY",
+	119:  "RETRY_REGISTRY_PUSH",
+	120:  "CHECK_KANIKO_PUSH_DESTINATION",
+	121:  "FIX_IMAGE_NAME",
+	122:  "FIX_DOCKERFILE_COPY_SOURCE",
+	123:  "REMOVE_CORRUPT_LOCAL_IMAGE",
 	201:  "CHECK_CLUSTER_CONNECTION",
 	202:  "CHECK_MINIKUBE_STATUS",
 	203:  "INSTALL_HELM",
@@ -1071,10 +1370,26 @@ var SuggestionCode_name = map[int32]string{
 	205:  "FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES",
 	206:  "UPGRADE_HELM32",
 	207:  "FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE",
+	208:  "FIX_INVALID_MANIFEST_FIELD",
+	209:  "CHECK_ADMISSION_POLICY",
+	210:  "ADD_HELM_REPO",
+	211:  "FIX_HELM_CHART_VERSION",
+	212:  "USE_SERVER_SIDE_APPLY",
+	213:  "INCREASE_HELM_TIMEOUT",
+	214:  "RECREATE_RESOURCE",
+	215:  "LOGIN_TO_CLUSTER",
+	216:  "CREATE_REFERENCED_RESOURCE",
+	217:  "FIX_HELM_VALUES",
+	218:  "UPDATE_API_VERSION",
+	219:  "CHECK_IMAGE_SUBSTITUTION",
 	220:  "INSTALL_KUBECTL",
+	221:  "FIX_MANIFEST_YAML",
 	301:  "CHECK_CONTAINER_LOGS",
 	302:  "CHECK_READINESS_PROBE",
 	303:  "CHECK_CONTAINER_IMAGE",
+	304:  "VERIFY_IMAGE_PUSHED",
+	305:  "BUILD_MULTI_ARCH_IMAGE",
+	306:  "RUN_AS_NON_ROOT_USER",
 	400:  "ADDRESS_NODE_MEMORY_PRESSURE",
 	401:  "ADDRESS_NODE_DISK_PRESSURE",
 	402:  "ADDRESS_NODE_NETWORK_UNAVAILABLE",
@@ -1084,12 +1399,32 @@ var SuggestionCode_name = map[int32]string{
 	406:  "ADDRESS_NODE_NOT_READY",
 	407:  "ADDRESS_FAILED_SCHEDULING",
 	408:  "CHECK_HOST_CONNECTION",
+	409:  "FIX_NODE_SELECTOR",
+	410:  "ADD_TOLERATIONS",
+	411:  "REFRESH_CLUSTER_CREDENTIALS",
+	412:  "CHECK_HOST_RESOLUTION",
+	413:  "CHECK_REGISTRY_CONNECTIVITY",
+	414:  "CHECK_PVC_PROVISIONING",
+	415:  "CHECK_NODE_RESOURCE_PRESSURE",
+	416:  "CONFIGURE_CLUSTER_CA",
+	417:  "CHECK_JOB_LOGS",
+	418:  "CHECK_SERVICE_ENDPOINTS",
 	501:  "START_MINIKUBE",
 	502:  "UNPAUSE_MINIKUBE",
+	503:  "CREATE_KIND_CLUSTER",
 	551:  "RUN_DOCKER_PULL",
+	552:  "CHECK_POD_WATCH_PERMISSIONS",
+	553:  "CREATE_COMPOSE_NETWORK",
+	554:  "FREE_LOCAL_PORTS",
+	555:  "RAISE_INOTIFY_LIMITS",
+	556:  "CHECK_RPC_PORT",
+	557:  "CHECK_SYNC_FILE_PERMISSIONS",
 	600:  "SET_RENDER_FLAG_OFFLINE_FALSE",
 	601:  "KPTFILE_MANUAL_INIT",
 	602:  "KPTFILE_CHECK_YAML",
+	603:  "FIX_HELM_TEMPLATE",
+	604:  "FIX_KUSTOMIZATION",
+	605:  "DISABLE_CLIENT_VALIDATION",
 	700:  "CONFIG_CHECK_FILE_PATH",
 	701:  "CONFIG_CHECK_DEPENDENCY_DEFINITION",
 	702:  "CONFIG_CHANGE_NAMES",
@@ -1101,6 +1436,7 @@ var SuggestionCode_name = map[int32]string{
 	708:  "CONFIG_ALLOWLIST_VALIDATORS",
 	709:  "CONFIG_ALLOWLIST_transformers",
 	710:  "CONFIG_FIX_MISSING_MANIFEST_FILE",
+	711:  "CONFIG_FIX_FIELD_TYPE",
 	800:  "INSPECT_USE_MODIFY_OR_NEW_PROFILE",
 	801:  "INSPECT_USE_ADD_BUILD_ENV",
 	802:  "INSPECT_CHECK_INPUT_PROFILE",
@@ -1131,6 +1467,13 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME": 114,
 	"RUN_DOCKER_PRUNE":                                       115,
 	"SET_CLEANUP_FLAG":                                       116,
+	"GRANT_GCP_REGISTRY_ROLE":                                117,
+	"CHECK_GKE_WORKLOAD_IDENTITY":                            118,
+	"RETRY_REGISTRY_PUSH":                                    119,
+	"CHECK_KANIKO_PUSH_DESTINATION":                          120,
+	"FIX_IMAGE_NAME":                                         121,
+	"FIX_DOCKERFILE_COPY_SOURCE":                             122,
+	"REMOVE_CORRUPT_LOCAL_IMAGE":                             123,
 	"CHECK_CLUSTER_CONNECTION":                               201,
 	"CHECK_MINIKUBE_STATUS":                                  202,
 	"INSTALL_HELM":                                           203,
@@ -1138,10 +1481,26 @@ var SuggestionCode_value = map[string]int32{
 	"FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES":            205,
 	"UPGRADE_HELM32":                                         206,
 	"FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE":              207,
+	"FIX_INVALID_MANIFEST_FIELD":                             208,
+	"CHECK_ADMISSION_POLICY":                                 209,
+	"ADD_HELM_REPO":                                          210,
+	"FIX_HELM_CHART_VERSION":                                 211,
+	"USE_SERVER_SIDE_APPLY":                                  212,
+	"INCREASE_HELM_TIMEOUT":                                  213,
+	"RECREATE_RESOURCE":                                      214,
+	"LOGIN_TO_CLUSTER":                                       215,
+	"CREATE_REFERENCED_RESOURCE":                             216,
+	"FIX_HELM_VALUES":                                        217,
+	"UPDATE_API_VERSION":                                     218,
+	"CHECK_IMAGE_SUBSTITUTION":                               219,
 	"INSTALL_KUBECTL":                                        220,
+	"FIX_MANIFEST_YAML":                                      221,
 	"CHECK_CONTAINER_LOGS":                                   301,
 	"CHECK_READINESS_PROBE":                                  302,
 	"CHECK_CONTAINER_IMAGE":                                  303,
+	"VERIFY_IMAGE_PUSHED":                                    304,
+	"BUILD_MULTI_ARCH_IMAGE":                                 305,
+	"RUN_AS_NON_ROOT_USER":                                   306,
 	"ADDRESS_NODE_MEMORY_PRESSURE":                           400,
 	"ADDRESS_NODE_DISK_PRESSURE":                             401,
 	"ADDRESS_NODE_NETWORK_UNAVAILABLE":                       402,
@@ -1151,12 +1510,32 @@ var SuggestionCode_value = map[string]int32{
 	"ADDRESS_NODE_NOT_READY":                                 406,
 	"ADDRESS_FAILED_SCHEDULING":                              407,
 	"CHECK_HOST_CONNECTION":                                  408,
+	"FIX_NODE_SELECTOR":                                      409,
+	"ADD_TOLERATIONS":                                        410,
+	"REFRESH_CLUSTER_CREDENTIALS":                            411,
+	"CHECK_HOST_RESOLUTION":                                  412,
+	"CHECK_REGISTRY_CONNECTIVITY":                            413,
+	"CHECK_PVC_PROVISIONING":                                 414,
+	"CHECK_NODE_RESOURCE_PRESSURE":                           415,
+	"CONFIGURE_CLUSTER_CA":                                   416,
+	"CHECK_JOB_LOGS":                                         417,
+	"CHECK_SERVICE_ENDPOINTS":                                418,
 	"START_MINIKUBE":                                         501,
 	"UNPAUSE_MINIKUBE":                                       502,
+	"CREATE_KIND_CLUSTER":                                    503,
 	"RUN_DOCKER_PULL":                                        551,
+	"CHECK_POD_WATCH_PERMISSIONS":                            552,
+	"CREATE_COMPOSE_NETWORK":                                 553,
+	"FREE_LOCAL_PORTS":                                       554,
+	"RAISE_INOTIFY_LIMITS":                                   555,
+	"CHECK_RPC_PORT":                                         556,
+	"CHECK_SYNC_FILE_PERMISSIONS":                            557,
 	"SET_RENDER_FLAG_OFFLINE_FALSE":                          600,
 	"KPTFILE_MANUAL_INIT":                                    601,
 	"KPTFILE_CHECK_YAML":                                     602,
+	"FIX_HELM_TEMPLATE":                                      603,
+	"FIX_KUSTOMIZATION":                                      604,
+	"DISABLE_CLIENT_VALIDATION":                              605,
 	"CONFIG_CHECK_FILE_PATH":                                 700,
 	"CONFIG_CHECK_DEPENDENCY_DEFINITION":                     701,
 	"CONFIG_CHANGE_NAMES":                                    702,
@@ -1168,6 +1547,7 @@ var SuggestionCode_value = map[string]int32{
 	"CONFIG_ALLOWLIST_VALIDATORS":                            708,
 	"CONFIG_ALLOWLIST_transformers":                          709,
 	"CONFIG_FIX_MISSING_MANIFEST_FILE":                       710,
+	"CONFIG_FIX_FIELD_TYPE":                                  711,
 	"INSPECT_USE_MODIFY_OR_NEW_PROFILE":                      800,
 	"INSPECT_USE_ADD_BUILD_ENV":                              801,
 	"INSPECT_CHECK_INPUT_PROFILE":                            802,
@@ -1202,200 +1582,270 @@ func init() {
 func init() { proto.RegisterFile("enums.proto", fileDescriptor_888b6bd9597961ff) }
 
 var fileDescriptor_888b6bd9597961ff = []byte{
-	// 3115 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x59, 0x69, 0x90, 0x1c, 0xc5,
-	0xb1, 0x66, 0x77, 0x76, 0x76, 0x66, 0x4a, 0x02, 0x8a, 0x42, 0xf7, 0x2d, 0x81, 0x04, 0x2c, 0x3c,
-	0x89, 0xf7, 0x78, 0x41, 0xbc, 0x78, 0xff, 0x6a, 0xba, 0x6b, 0x66, 0x4a, 0xd3, 0x5d, 0xdd, 0x51,
-	0x55, 0xbd, 0xab, 0xd5, 0x9f, 0x0e, 0xf1, 0x34, 0x12, 0x82, 0xd5, 0x8e, 0xd8, 0x5d, 0xf1, 0x8c,
-	0x4f, 0x7e, 0xf8, 0x3e, 0x22, 0x7c, 0x71, 0xf8, 0xf8, 0x01, 0xd8, 0x0e, 0xff, 0x31, 0xe0, 0xfb,
-	0xc0, 0x9c, 0xc6, 0x76, 0x18, 0x73, 0xf9, 0x36, 0x10, 0xf6, 0x3f, 0xdb, 0x61, 0x63, 0x7c, 0x84,
-	0xcd, 0x7d, 0x3a, 0xb2, 0xaa, 0xab, 0xbb, 0xe7, 0x58, 0xfc, 0x83, 0x60, 0x54, 0xf9, 0x75, 0x66,
-	0x56, 0x56, 0x56, 0xe6, 0x97, 0xb5, 0x68, 0x4d, 0x6f, 0xf1, 0xf4, 0xc9, 0xe5, 0xfd, 0xa7, 0x96,
-	0xfa, 0x2b, 0x7d, 0xb2, 0xc6, 0xfc, 0x6f, 0xbf, 0x59, 0x9a, 0xe9, 0xa3, 0x35, 0xcd, 0xd3, 0x27,
-	0x16, 0x8e, 0xf6, 0x96, 0xf4, 0x75, 0xa7, 0x7a, 0x64, 0x13, 0x5a, 0x97, 0x88, 0xae, 0x88, 0xe6,
-	0x44, 0xda, 0x4c, 0x78, 0xe0, 0x33, 0x99, 0xea, 0xf9, 0x98, 0xe1, 0x33, 0x48, 0x0d, 0x55, 0x0e,
-	0xf2, 0x26, 0x9e, 0x20, 0x0d, 0x54, 0x6d, 0xd2, 0xc3, 0x2c, 0xc0, 0x93, 0xe4, 0x2c, 0x84, 0x0c,
-	0x2a, 0xa6, 0x5e, 0x57, 0xe1, 0x0a, 0x41, 0x68, 0xda, 0x4b, 0x94, 0x8e, 0x42, 0x3c, 0x05, 0xbf,
-	0xbb, 0x54, 0xf0, 0x6e, 0x84, 0xab, 0xf0, 0xdb, 0x8f, 0xbc, 0x2e, 0x93, 0x78, 0x7a, 0xc6, 0x47,
-	0x0d, 0x63, 0xd0, 0x98, 0xdb, 0x80, 0xc8, 0x80, 0x39, 0x67, 0x6c, 0x0d, 0xaa, 0x79, 0x41, 0xa2,
-	0x34, 0x93, 0x78, 0x02, 0x2c, 0xb7, 0xbd, 0x26, 0x9e, 0x04, 0xcb, 0x41, 0xe4, 0xd1, 0x00, 0x57,
-	0x66, 0xba, 0x08, 0xe9, 0xde, 0xf2, 0x4a, 0xe6, 0xf5, 0x7a, 0x74, 0x8e, 0x53, 0xa3, 0x99, 0xd2,
-	0x4e, 0x4b, 0x1d, 0x4d, 0x25, 0x82, 0x6b, 0x3c, 0x41, 0xb6, 0xa1, 0x4d, 0x5e, 0x24, 0x34, 0xe5,
-	0x82, 0xc9, 0x54, 0x69, 0x99, 0x78, 0x3a, 0x91, 0xcc, 0x80, 0xf1, 0xe4, 0xcc, 0xd5, 0x08, 0xc9,
-	0xde, 0xa2, 0x0b, 0xc1, 0x46, 0x74, 0xae, 0x53, 0x26, 0x99, 0x28, 0x45, 0x00, 0xa1, 0x69, 0x49,
-	0xe7, 0xba, 0xff, 0xa3, 0xf0, 0x04, 0x38, 0xde, 0x35, 0x3b, 0xe5, 0x87, 0x59, 0x1a, 0x52, 0xc1,
-	0x5b, 0x46, 0x15, 0x44, 0xa4, 0xc3, 0x82, 0x30, 0xf5, 0x3a, 0x54, 0x6a, 0x5c, 0x21, 0x18, 0xad,
-	0xed, 0xc6, 0xba, 0x40, 0x4c, 0xcd, 0x1c, 0x42, 0x6b, 0xfd, 0xde, 0xa9, 0x85, 0xfe, 0x75, 0x99,
-	0xb9, 0xcd, 0x68, 0xbd, 0x33, 0xe7, 0xb3, 0x38, 0x88, 0xe6, 0x0b, 0x83, 0x75, 0x34, 0x05, 0xca,
-	0xf0, 0x04, 0x39, 0x13, 0x35, 0x72, 0x73, 0x78, 0x12, 0xc2, 0xd3, 0x4d, 0x9a, 0xcc, 0xd3, 0x01,
-	0xae, 0x40, 0x78, 0xba, 0x31, 0x68, 0xe6, 0x68, 0x8d, 0xb7, 0x70, 0x3a, 0x0f, 0x4a, 0xe9, 0x28,
-	0xb3, 0x58, 0x3a, 0xbd, 0x6b, 0x51, 0x3d, 0xe4, 0x82, 0x83, 0x8a, 0x2c, 0xbc, 0x5d, 0x66, 0xc3,
-	0x1b, 0xe9, 0x0e, 0x93, 0xb8, 0x32, 0x73, 0x10, 0xd5, 0x83, 0xfe, 0xf1, 0xa0, 0x77, 0x6d, 0x6f,
-	0x01, 0x96, 0x7d, 0xd6, 0x4c, 0xda, 0xd6, 0x21, 0x2e, 0x5a, 0x11, 0x9e, 0x80, 0x5f, 0x73, 0x54,
-	0x0a, 0xfb, 0x15, 0x93, 0x32, 0x92, 0xb8, 0x02, 0x3f, 0x5b, 0x54, 0xd3, 0x00, 0x4f, 0xc1, 0xcf,
-	0x98, 0x0a, 0xee, 0xe1, 0xea, 0xcc, 0x5d, 0xfb, 0x10, 0x52, 0x2b, 0x47, 0x56, 0x4e, 0x2f, 0x7b,
-	0xfd, 0xa3, 0x3d, 0x32, 0x8d, 0x26, 0xa3, 0x2e, 0x3e, 0x83, 0x6c, 0x42, 0xe7, 0x2a, 0x4d, 0x75,
-	0xa2, 0xbc, 0x0e, 0xf3, 0xba, 0xa9, 0x4a, 0x3c, 0x8f, 0x29, 0x85, 0x7f, 0x34, 0x41, 0x08, 0x3a,
-	0xd3, 0x26, 0x83, 0x5b, 0x7b, 0x68, 0x82, 0x9c, 0x8b, 0xce, 0xca, 0x0e, 0xc3, 0x2d, 0x3e, 0x62,
-	0x16, 0x6d, 0xc8, 0xf2, 0xc5, 0x1f, 0x4f, 0x90, 0x73, 0xd0, 0x5a, 0x93, 0x03, 0x6e, 0xe9, 0x61,
-	0x73, 0xfa, 0x56, 0x61, 0x9c, 0xa8, 0x4e, 0x4a, 0xcd, 0x7a, 0xea, 0x33, 0xc1, 0x99, 0x8f, 0x7b,
-	0x64, 0x2b, 0xda, 0x98, 0x49, 0x65, 0x74, 0x90, 0x79, 0x3a, 0x15, 0x91, 0x4e, 0x5b, 0x51, 0x22,
-	0x7c, 0x7c, 0x8c, 0x9c, 0x87, 0x76, 0x5a, 0xa1, 0xcd, 0xdf, 0xd4, 0xa7, 0x2c, 0x8c, 0x84, 0x81,
-	0xc8, 0x44, 0x08, 0x2e, 0xda, 0xf8, 0x38, 0x59, 0x87, 0xb0, 0x05, 0x25, 0x8a, 0xc9, 0xd4, 0x46,
-	0xe3, 0xca, 0xc2, 0x6a, 0xf6, 0x69, 0x22, 0xe8, 0x2c, 0xe5, 0x01, 0x6d, 0x06, 0x0c, 0x9f, 0x20,
-	0xdb, 0xd1, 0xe6, 0x61, 0x69, 0xa2, 0x3b, 0x91, 0xe4, 0x87, 0x99, 0x8f, 0xaf, 0x2a, 0x9c, 0xca,
-	0xc4, 0x6a, 0x5e, 0x69, 0x16, 0x82, 0x6e, 0x7c, 0x35, 0xd9, 0x8d, 0xb6, 0x0f, 0x08, 0xc1, 0x9b,
-	0x30, 0xf2, 0x79, 0x8b, 0x33, 0xdf, 0x40, 0x16, 0xc8, 0xf9, 0x68, 0xd7, 0x08, 0x84, 0x87, 0x71,
-	0xc0, 0x42, 0x26, 0x74, 0x86, 0x3a, 0x49, 0x76, 0xa0, 0x2d, 0x43, 0xbb, 0xd3, 0x34, 0x0d, 0x22,
-	0xa5, 0x8c, 0x7c, 0x71, 0x44, 0xde, 0x8a, 0x64, 0x93, 0xfb, 0x3e, 0x13, 0x46, 0xde, 0x1f, 0xd9,
-	0x84, 0x17, 0x89, 0x56, 0xc0, 0x3d, 0x6d, 0xc4, 0xa7, 0xc8, 0x2e, 0xb4, 0x6d, 0x40, 0x6c, 0x22,
-	0x53, 0x0a, 0xef, 0x35, 0x64, 0x0f, 0xda, 0x31, 0x80, 0xe0, 0x62, 0x96, 0x06, 0xdc, 0x4f, 0x63,
-	0x2a, 0xa9, 0xdd, 0xed, 0xd2, 0xb0, 0x13, 0x2d, 0x1e, 0xb0, 0x92, 0x8e, 0xe5, 0x91, 0xad, 0x7a,
-	0xd4, 0xeb, 0xb0, 0xb4, 0x25, 0xa3, 0x30, 0x8d, 0x93, 0x20, 0x30, 0x5a, 0x56, 0xc8, 0x4e, 0xb4,
-	0x75, 0x00, 0xd5, 0x66, 0x3a, 0xf5, 0x79, 0x1b, 0x32, 0x05, 0x00, 0xa7, 0x47, 0xf6, 0x22, 0xa2,
-	0x54, 0xc5, 0xd4, 0x63, 0x46, 0xfc, 0xae, 0x22, 0xe6, 0x92, 0xb5, 0xb9, 0xd2, 0x72, 0x7e, 0x58,
-	0xc3, 0xb5, 0x05, 0xc4, 0x5d, 0xbb, 0x83, 0xbc, 0x99, 0xc6, 0x41, 0xd2, 0xe6, 0xc2, 0xde, 0xbc,
-	0xff, 0x2f, 0x72, 0x02, 0x44, 0x6d, 0x49, 0xfd, 0x80, 0xc1, 0xad, 0x37, 0x0a, 0xde, 0x52, 0x1c,
-	0x3a, 0x48, 0x43, 0x3a, 0xcb, 0x44, 0x2e, 0xbc, 0x8e, 0xcc, 0xa0, 0x7d, 0x5c, 0x70, 0x9d, 0xbb,
-	0xc7, 0xf4, 0x5c, 0x24, 0xbb, 0x69, 0xc0, 0x95, 0xe6, 0xa2, 0x9d, 0xe6, 0xe5, 0x4d, 0xe1, 0xb7,
-	0x92, 0xfd, 0x68, 0x66, 0x1c, 0xd6, 0x45, 0xb7, 0x28, 0x85, 0x82, 0x86, 0x0c, 0xbf, 0x8d, 0x5c,
-	0x8a, 0x2e, 0x19, 0x87, 0x2f, 0x70, 0x7e, 0xc4, 0x94, 0x09, 0x3a, 0x3b, 0xc4, 0x95, 0xc6, 0x6f,
-	0x87, 0xa0, 0xbf, 0x99, 0x85, 0x30, 0xf2, 0x19, 0x7e, 0x07, 0x44, 0x64, 0x1c, 0x2a, 0xa6, 0x52,
-	0xd9, 0xb8, 0xbe, 0x93, 0xec, 0x44, 0x5b, 0xca, 0x65, 0x80, 0x87, 0xb4, 0xcd, 0x8a, 0x73, 0xfb,
-	0xd2, 0x24, 0x39, 0x0f, 0xed, 0x28, 0x03, 0x0a, 0x9f, 0x3c, 0xc9, 0x28, 0x6c, 0x1d, 0xdf, 0x3e,
-	0x49, 0xf6, 0xa0, 0xed, 0x65, 0x90, 0x4c, 0x44, 0x09, 0x08, 0x8a, 0xee, 0x98, 0x24, 0x7b, 0xd1,
-	0xae, 0xf1, 0x8a, 0x34, 0x93, 0x21, 0x17, 0x54, 0x33, 0x1f, 0xdf, 0x39, 0x49, 0x2e, 0x46, 0xfb,
-	0xca, 0x30, 0x5b, 0x60, 0xe0, 0xd6, 0xa4, 0x32, 0x0a, 0x82, 0x28, 0xd1, 0x69, 0xcc, 0x84, 0x0f,
-	0x76, 0xbf, 0xfc, 0x26, 0x3a, 0x25, 0x53, 0x9a, 0x4a, 0xe3, 0xde, 0xef, 0x26, 0xc9, 0x16, 0xb4,
-	0xbe, 0x0c, 0x4b, 0x44, 0x87, 0xd1, 0x40, 0x77, 0xe6, 0xf1, 0xef, 0xdf, 0x44, 0x05, 0x3b, 0xc4,
-	0xbc, 0xac, 0x98, 0xfc, 0x61, 0x04, 0x26, 0x22, 0x9f, 0xa5, 0x21, 0x0b, 0x23, 0x39, 0x9f, 0xc6,
-	0x92, 0x29, 0x95, 0x48, 0x86, 0x3f, 0x5a, 0x19, 0x8e, 0x96, 0x81, 0xf9, 0x5c, 0x75, 0x0b, 0xd0,
-	0xc7, 0x2a, 0xe4, 0x22, 0x74, 0xfe, 0x08, 0xc8, 0x9d, 0x4d, 0xb9, 0x4a, 0x7d, 0xbc, 0x32, 0x1c,
-	0x58, 0x03, 0x8d, 0xe1, 0x82, 0x3a, 0x75, 0x9f, 0x18, 0x6f, 0x33, 0x11, 0xf0, 0x2f, 0x3f, 0xb1,
-	0x8a, 0x3e, 0x59, 0x21, 0xbb, 0xd1, 0xb6, 0x31, 0x20, 0xc9, 0xa8, 0xd7, 0x31, 0x90, 0x1b, 0x2a,
-	0xc3, 0xa9, 0x60, 0xdd, 0x82, 0x42, 0xcb, 0xa8, 0x3f, 0x8f, 0x6f, 0x1c, 0x71, 0xa6, 0x45, 0x79,
-	0xc0, 0xfc, 0x34, 0x33, 0x04, 0xa1, 0xbe, 0xa9, 0x42, 0x2e, 0x40, 0x7b, 0xca, 0x98, 0xac, 0x4d,
-	0x42, 0x58, 0x05, 0xf3, 0x34, 0x8f, 0x6c, 0xe9, 0xfa, 0xd4, 0x88, 0xd7, 0x0e, 0x08, 0x9b, 0xeb,
-	0xf2, 0x20, 0x60, 0x3e, 0xfe, 0xf4, 0x48, 0xa4, 0x72, 0x6d, 0x01, 0x87, 0x84, 0x68, 0x31, 0xed,
-	0x75, 0x8c, 0xbe, 0xcf, 0x54, 0x86, 0x0f, 0xa8, 0x94, 0x37, 0x05, 0xec, 0xb3, 0x23, 0x71, 0x88,
-	0x23, 0x3f, 0x85, 0x2b, 0xc2, 0x69, 0xc0, 0x0f, 0xc3, 0x16, 0x1e, 0xac, 0x40, 0xff, 0x73, 0x15,
-	0xc4, 0x1e, 0xff, 0xb3, 0x95, 0xe1, 0x6e, 0x99, 0xc9, 0xf1, 0x73, 0x15, 0xb2, 0x0f, 0xed, 0x1e,
-	0x23, 0x19, 0x3a, 0x80, 0xe7, 0x2b, 0x64, 0x06, 0xed, 0x1d, 0x9f, 0x67, 0x73, 0x94, 0x9b, 0x0a,
-	0xe2, 0x74, 0xbe, 0x50, 0x21, 0x3b, 0xd0, 0xe6, 0x71, 0x3a, 0xd9, 0x2c, 0x13, 0x1a, 0xbf, 0x56,
-	0x29, 0x35, 0x5e, 0xf7, 0xd1, 0x8b, 0x15, 0x68, 0xbc, 0x6a, 0x5e, 0x78, 0xf9, 0xd2, 0x4b, 0x95,
-	0xa2, 0x93, 0xbb, 0xb5, 0x97, 0x2b, 0x64, 0x1d, 0x3a, 0xdb, 0x67, 0xb3, 0xa6, 0x2c, 0xb8, 0xd5,
-	0x57, 0xcc, 0xaa, 0x17, 0x30, 0x2a, 0x92, 0x38, 0x5f, 0x7d, 0xd5, 0xa8, 0x1c, 0x00, 0xbe, 0x5e,
-	0x21, 0x9b, 0xd1, 0xba, 0xa1, 0xbe, 0x69, 0x45, 0x6f, 0x54, 0xf2, 0xce, 0xef, 0x96, 0xae, 0x9f,
-	0x02, 0xb5, 0xc6, 0x27, 0xa3, 0xc5, 0x06, 0xf3, 0xc9, 0x29, 0xb2, 0x0b, 0x6d, 0x75, 0x2e, 0xd8,
-	0x6a, 0xce, 0x64, 0x46, 0x3f, 0x7d, 0x16, 0x2b, 0x7c, 0x4f, 0x15, 0x52, 0x71, 0x04, 0x61, 0x74,
-	0x1b, 0xc0, 0xbd, 0x55, 0x38, 0xc6, 0x11, 0x40, 0x16, 0x12, 0x03, 0xb9, 0xaf, 0x3a, 0xd6, 0x0a,
-	0x34, 0x48, 0xde, 0x06, 0x08, 0xbe, 0xbf, 0x4a, 0xce, 0x47, 0x3b, 0x8b, 0x50, 0xa8, 0x24, 0x8e,
-	0x23, 0x09, 0xbd, 0x79, 0xf6, 0x3f, 0x0b, 0xbe, 0xf8, 0x40, 0x75, 0xf8, 0x5a, 0x18, 0x8e, 0xe1,
-	0x51, 0xe1, 0x31, 0x93, 0xa4, 0xb7, 0x4c, 0x0f, 0x5f, 0x0b, 0x9f, 0x51, 0x3f, 0xe0, 0x82, 0xa5,
-	0xec, 0x90, 0xc7, 0x98, 0xcf, 0x7c, 0x7c, 0xeb, 0x34, 0x04, 0xc2, 0xee, 0xb0, 0xf8, 0xf2, 0xb6,
-	0x69, 0xb2, 0x1e, 0xe1, 0xcc, 0xe9, 0x62, 0xf9, 0x73, 0xd3, 0x64, 0x2b, 0xda, 0x30, 0xd4, 0x51,
-	0x9d, 0xf0, 0xf3, 0xd3, 0x50, 0xcb, 0x06, 0x39, 0x43, 0x66, 0x0e, 0x7f, 0x61, 0x9a, 0x6c, 0x47,
-	0x9b, 0xcc, 0x6e, 0x4c, 0x69, 0x66, 0xa9, 0xa6, 0xed, 0x76, 0x4e, 0x88, 0xde, 0x53, 0x83, 0x9d,
-	0x18, 0xb1, 0x23, 0x9f, 0x69, 0x4c, 0x13, 0x65, 0xc9, 0x48, 0x24, 0xf1, 0x7b, 0x6b, 0x10, 0x90,
-	0x41, 0x40, 0x89, 0x67, 0x65, 0xa8, 0xf7, 0xd5, 0x20, 0x3b, 0xcb, 0x56, 0xdc, 0x9c, 0x62, 0xe5,
-	0xef, 0x2f, 0xcc, 0x64, 0xf2, 0x9c, 0x55, 0x5b, 0xc0, 0x07, 0x46, 0x00, 0xee, 0x60, 0x33, 0xc0,
-	0x07, 0x6b, 0x10, 0x17, 0x0b, 0x30, 0x54, 0xc2, 0x2e, 0x7f, 0xa8, 0x70, 0x2f, 0xfb, 0x6e, 0x8e,
-	0xc2, 0xbd, 0xd6, 0x92, 0x97, 0x76, 0xf9, 0xe1, 0x1a, 0x14, 0x96, 0x32, 0x0a, 0xba, 0x40, 0x8b,
-	0x7a, 0x65, 0x0b, 0x1f, 0xa9, 0xc1, 0x99, 0xb9, 0xc8, 0x67, 0xdc, 0x7c, 0xa8, 0x42, 0xfd, 0xa9,
-	0x06, 0x15, 0x25, 0x4f, 0xa9, 0x66, 0xd2, 0x4e, 0x3b, 0x2c, 0x88, 0x4d, 0x6b, 0xd1, 0x92, 0xb3,
-	0x59, 0xdb, 0x40, 0x9f, 0xae, 0x91, 0x8d, 0x88, 0xe4, 0xaa, 0xec, 0x0d, 0x02, 0xc1, 0x9f, 0x6b,
-	0x70, 0x1a, 0x99, 0xc0, 0x8c, 0x24, 0x34, 0x8e, 0x83, 0xf9, 0x34, 0xa0, 0x4d, 0x16, 0x28, 0xfc,
-	0x4c, 0x0d, 0x6e, 0x52, 0x59, 0xec, 0xb8, 0x2b, 0xfe, 0x4b, 0xf9, 0x4b, 0x11, 0xa5, 0x21, 0x6c,
-	0x13, 0x0e, 0xc0, 0x04, 0x1a, 0xff, 0xb5, 0x46, 0xb6, 0xa1, 0x8d, 0xe5, 0x2f, 0x67, 0x99, 0x54,
-	0xce, 0xed, 0xbf, 0xd5, 0x6c, 0xde, 0x17, 0xd2, 0x90, 0x8b, 0x01, 0xc4, 0xdf, 0x6b, 0xf6, 0x76,
-	0x19, 0x84, 0x2b, 0xa8, 0x65, 0xc0, 0x2f, 0xeb, 0xf6, 0x62, 0x0c, 0x00, 0xa2, 0x56, 0xcb, 0xe4,
-	0x34, 0x10, 0x0b, 0x83, 0xfa, 0x47, 0xad, 0x84, 0x62, 0xb2, 0x28, 0x63, 0xad, 0x08, 0x72, 0x32,
-	0x60, 0x10, 0x49, 0xfc, 0xcf, 0xf2, 0x5e, 0xa0, 0x8f, 0xe4, 0x37, 0xcb, 0x28, 0x79, 0xb6, 0xac,
-	0xc4, 0x88, 0x25, 0x0b, 0x23, 0xcd, 0x06, 0x51, 0xcf, 0x95, 0x95, 0x00, 0xdf, 0x1a, 0x14, 0x3f,
-	0x5f, 0x0e, 0x88, 0xf3, 0x37, 0x8f, 0xe6, 0x0b, 0x26, 0x5f, 0x73, 0xa9, 0x1b, 0x19, 0x73, 0xf9,
-	0x8b, 0x83, 0x1e, 0xc6, 0x01, 0x50, 0x4e, 0xcb, 0x82, 0x40, 0xfc, 0x52, 0x39, 0x55, 0xb4, 0xa4,
-	0x42, 0xb5, 0x22, 0x19, 0x0e, 0x3a, 0xf0, 0x72, 0xf9, 0x2c, 0x15, 0xd3, 0xf6, 0x8c, 0x8d, 0xe8,
-	0x95, 0xb2, 0xf5, 0xfc, 0xa3, 0x39, 0xc9, 0xb5, 0x55, 0xff, 0x6a, 0x39, 0xcb, 0x2c, 0x2d, 0xcb,
-	0x51, 0xc6, 0x09, 0x3b, 0x09, 0xbc, 0x56, 0x23, 0x17, 0xa2, 0xf3, 0xca, 0xa7, 0x9a, 0x25, 0xb7,
-	0xb0, 0xac, 0xb0, 0xa0, 0x0c, 0xaf, 0xd7, 0xa0, 0x03, 0x0f, 0xa5, 0x36, 0x17, 0x9a, 0x49, 0x41,
-	0x83, 0xf2, 0x14, 0xf3, 0xc6, 0x40, 0xd4, 0x62, 0x6d, 0x68, 0xbd, 0x2b, 0xd3, 0xf8, 0xfa, 0x3a,
-	0x6c, 0xc9, 0x56, 0x73, 0x55, 0xd4, 0x4d, 0x10, 0x3d, 0x5a, 0x27, 0x1b, 0xd0, 0x39, 0x46, 0xe4,
-	0x39, 0x31, 0xac, 0x3f, 0x56, 0xac, 0xf3, 0xb0, 0x5d, 0x50, 0xc8, 0xc7, 0xeb, 0x10, 0x02, 0x8b,
-	0x37, 0xe1, 0x4f, 0xbd, 0xd0, 0x2f, 0x51, 0xd0, 0x9f, 0xd4, 0xa1, 0x35, 0x0e, 0xcb, 0x81, 0x41,
-	0x8a, 0x48, 0xa4, 0x87, 0x99, 0x8c, 0x80, 0xf4, 0x5a, 0xb7, 0x7e, 0x5a, 0x87, 0x70, 0x8d, 0xc3,
-	0x6a, 0x1e, 0x32, 0x1f, 0xc8, 0x21, 0xc0, 0x7e, 0x56, 0x87, 0xae, 0x3c, 0x0e, 0x96, 0x57, 0x52,
-	0x83, 0xfb, 0xf9, 0xaa, 0x38, 0xe0, 0x7e, 0x49, 0x5e, 0x0b, 0x7e, 0x51, 0x87, 0xa2, 0x32, 0x1e,
-	0xc7, 0xdd, 0x34, 0xf7, 0xab, 0x3a, 0x04, 0x74, 0x2c, 0x48, 0x4a, 0xfc, 0xeb, 0x11, 0xcf, 0x7d,
-	0x06, 0x3c, 0x96, 0x09, 0x8f, 0x33, 0x65, 0xa0, 0x00, 0x7b, 0xa2, 0x4e, 0x2e, 0x41, 0x17, 0xac,
-	0x0a, 0x4b, 0x44, 0x48, 0xa5, 0xea, 0xd0, 0x2c, 0xb4, 0x4f, 0xd6, 0xa1, 0x0f, 0x8e, 0x98, 0x2c,
-	0xd7, 0xa7, 0xa7, 0x8c, 0x57, 0xd9, 0xe8, 0x3e, 0x72, 0xcc, 0xbf, 0x5d, 0x03, 0xf7, 0x6f, 0x44,
-	0x6a, 0x67, 0x88, 0x79, 0x1a, 0x5a, 0x33, 0x2f, 0x21, 0x08, 0xd3, 0x2a, 0x28, 0x68, 0x7a, 0x21,
-	0xb5, 0xf7, 0x00, 0x81, 0xad, 0x2c, 0x55, 0x0c, 0x08, 0x4e, 0x39, 0x6b, 0x1d, 0xf8, 0x2b, 0x0d,
-	0xc8, 0x83, 0xb2, 0x34, 0x1f, 0x22, 0x8d, 0xfc, 0xab, 0x0d, 0xf0, 0xa5, 0x68, 0xd0, 0x76, 0xd7,
-	0xf3, 0x43, 0xa8, 0xaf, 0x35, 0x80, 0x13, 0x3a, 0x54, 0x12, 0x07, 0xdc, 0x33, 0xf7, 0x80, 0x86,
-	0x4c, 0xa5, 0x8a, 0x86, 0xcc, 0xaa, 0x06, 0xe8, 0xd7, 0x1b, 0x10, 0xcb, 0x55, 0xa0, 0xd4, 0x93,
-	0x30, 0x66, 0x03, 0xd8, 0x5e, 0xb1, 0x6f, 0x34, 0xa0, 0xb3, 0x66, 0xe8, 0x26, 0xf5, 0x41, 0xa4,
-	0xb3, 0xd4, 0xfe, 0x66, 0x59, 0x66, 0x32, 0xb2, 0x70, 0xe8, 0x5b, 0xe5, 0x6d, 0xd9, 0x12, 0x1f,
-	0xcb, 0xa8, 0xd0, 0xfb, 0xed, 0xb2, 0xdc, 0x67, 0x2d, 0x9a, 0x04, 0x3a, 0x9d, 0xa5, 0x41, 0x92,
-	0xc9, 0xbf, 0xd3, 0x80, 0x0b, 0x3b, 0x18, 0x34, 0xdd, 0x51, 0xa9, 0x4a, 0x9a, 0x4a, 0x73, 0x5d,
-	0x24, 0xe1, 0x5d, 0x0d, 0xf2, 0x1f, 0xe8, 0xc2, 0x0c, 0x18, 0x26, 0x81, 0xe6, 0x29, 0x0f, 0x81,
-	0xb1, 0x38, 0x7b, 0x83, 0xd3, 0xff, 0x77, 0x1b, 0x50, 0xb8, 0x32, 0x78, 0xee, 0xd1, 0x60, 0x30,
-	0xef, 0x6e, 0x40, 0x5e, 0x67, 0x18, 0x47, 0x32, 0x69, 0xcc, 0x07, 0xda, 0xc1, 0x3d, 0x0d, 0x28,
-	0x90, 0x43, 0x20, 0x73, 0xf6, 0x54, 0x47, 0x12, 0xdf, 0xdb, 0x80, 0x76, 0x32, 0x24, 0xce, 0x0b,
-	0x25, 0x93, 0xf8, 0xbe, 0x06, 0x64, 0xbe, 0xf3, 0x9b, 0x2b, 0x93, 0x10, 0x79, 0x91, 0xcb, 0x4f,
-	0xeb, 0xfe, 0x06, 0x70, 0x6c, 0x2e, 0x54, 0xcc, 0x3c, 0x9d, 0x96, 0xf8, 0x37, 0xbe, 0x01, 0xc1,
-	0x91, 0x3b, 0x89, 0xe5, 0x3e, 0x4c, 0xcc, 0xa6, 0x34, 0x30, 0x83, 0x89, 0x1d, 0x89, 0x6d, 0x30,
-	0x6f, 0x5c, 0x05, 0xca, 0x85, 0x17, 0x49, 0x09, 0x6b, 0x7a, 0x3e, 0xb6, 0xf6, 0x6e, 0x42, 0x10,
-	0x1f, 0x07, 0x75, 0x21, 0x1c, 0x8c, 0xcf, 0xcd, 0x68, 0xe6, 0xe9, 0xb3, 0xd1, 0x59, 0xea, 0xf4,
-	0xf1, 0xe3, 0xbd, 0xe5, 0x95, 0x13, 0xfd, 0x45, 0xf3, 0x80, 0x56, 0x43, 0x15, 0xc1, 0x03, 0x7c,
-	0x06, 0x59, 0x87, 0x30, 0xf5, 0xfd, 0xfc, 0x50, 0x25, 0x8b, 0x23, 0x7c, 0x94, 0x6c, 0x40, 0xc4,
-	0xf1, 0xc0, 0xd2, 0x7a, 0x0f, 0x26, 0xf6, 0xd1, 0xf5, 0xb4, 0x1d, 0x44, 0x4d, 0x1a, 0x64, 0xf5,
-	0x15, 0x1f, 0x23, 0xbb, 0xd0, 0xb6, 0xb6, 0x17, 0x44, 0x49, 0x4e, 0xef, 0x68, 0xa2, 0x3b, 0x99,
-	0x18, 0xc6, 0xbd, 0xe3, 0x64, 0x33, 0x5a, 0x3f, 0x5e, 0x74, 0x25, 0xd9, 0x84, 0xd6, 0x59, 0x13,
-	0x99, 0x8a, 0xec, 0x41, 0x0d, 0x9f, 0x28, 0x24, 0xd9, 0xa7, 0xee, 0xed, 0xec, 0x2a, 0x70, 0xb7,
-	0xc5, 0x0f, 0xd9, 0x32, 0x9e, 0x05, 0xcc, 0xbc, 0x71, 0x6d, 0x40, 0x24, 0xc3, 0xba, 0x67, 0x17,
-	0x2d, 0xe7, 0xf1, 0x02, 0xd9, 0x83, 0x76, 0x00, 0xbe, 0xf4, 0xc8, 0x93, 0x13, 0xac, 0x6c, 0x13,
-	0x27, 0x1d, 0x46, 0x75, 0x69, 0xab, 0x15, 0x05, 0x7e, 0xce, 0xba, 0xf3, 0xf7, 0x23, 0xbc, 0x08,
-	0x1b, 0x05, 0x4c, 0xe9, 0x89, 0xc6, 0xed, 0x84, 0x1a, 0xe6, 0xd0, 0x27, 0x7b, 0xd1, 0x6e, 0x40,
-	0xac, 0xfa, 0x26, 0x62, 0xde, 0x4e, 0x4e, 0x91, 0x19, 0xb4, 0x6f, 0x60, 0x6b, 0xa3, 0x40, 0xb7,
-	0xd9, 0x6b, 0xc8, 0xff, 0xa2, 0xcb, 0xc7, 0xa8, 0x34, 0x9c, 0x66, 0xae, 0xc3, 0xa0, 0x9c, 0x6b,
-	0x49, 0xbd, 0xc1, 0xf7, 0x1c, 0x6b, 0x67, 0x09, 0x4e, 0x1b, 0x8a, 0x79, 0xf6, 0x6d, 0x2c, 0x13,
-	0xc1, 0xf0, 0x32, 0xac, 0x42, 0xc7, 0x77, 0xcc, 0xaf, 0x15, 0xd0, 0x36, 0x5e, 0x31, 0x17, 0xc6,
-	0x4e, 0x79, 0x23, 0xe4, 0x12, 0x3f, 0x34, 0x61, 0x8a, 0x89, 0x11, 0xe7, 0x3c, 0xdb, 0xce, 0x0f,
-	0xd9, 0xf3, 0x29, 0x17, 0x4a, 0x43, 0x89, 0x37, 0x6f, 0xcf, 0x0f, 0x9b, 0xa5, 0x24, 0x6e, 0x4b,
-	0xea, 0x33, 0xbb, 0xf4, 0xc8, 0x04, 0xb9, 0x14, 0x5d, 0x3c, 0x2e, 0xc2, 0x96, 0x67, 0xba, 0xf3,
-	0x88, 0x66, 0x99, 0x94, 0xdc, 0x67, 0x0a, 0x3f, 0x6a, 0xde, 0x6a, 0xcb, 0x4a, 0x2e, 0xfb, 0x2f,
-	0xfc, 0xd8, 0x04, 0xd9, 0x8f, 0x2e, 0x5a, 0x55, 0x8d, 0x63, 0x18, 0x50, 0x2e, 0x63, 0xea, 0x31,
-	0xfc, 0xf8, 0x04, 0x4c, 0x31, 0xce, 0x39, 0xf7, 0xfe, 0xfd, 0x9b, 0x09, 0x60, 0x0a, 0xc3, 0x33,
-	0x6d, 0x10, 0xb5, 0x15, 0xbe, 0x7d, 0xb2, 0xd8, 0x29, 0x5c, 0x55, 0x2e, 0x98, 0x52, 0x90, 0x94,
-	0x4d, 0x86, 0xef, 0x28, 0xc9, 0x8a, 0xcf, 0x0c, 0xe5, 0xc1, 0x77, 0x4e, 0x42, 0x5b, 0xa3, 0xbe,
-	0x2f, 0x01, 0xbf, 0xda, 0x4b, 0xcb, 0x4e, 0xb4, 0x65, 0x00, 0x32, 0xf2, 0xca, 0xb2, 0x17, 0xed,
-	0x1a, 0x00, 0xac, 0xf2, 0xc2, 0xb2, 0x03, 0x6d, 0x1e, 0x80, 0x0d, 0xbf, 0xae, 0x0c, 0xdb, 0x19,
-	0x79, 0x59, 0xd9, 0x8e, 0x36, 0x0d, 0x01, 0x06, 0x5e, 0x55, 0xb6, 0xa2, 0x0d, 0x83, 0x6e, 0x94,
-	0x5f, 0x54, 0x4a, 0xc6, 0xc7, 0xbe, 0xa6, 0xe4, 0x31, 0xea, 0x44, 0x4a, 0x97, 0xb3, 0xe8, 0x66,
-	0xf3, 0x08, 0x60, 0xde, 0xb8, 0xf2, 0x2c, 0xc2, 0xcf, 0x55, 0x60, 0x72, 0x4a, 0x84, 0x19, 0xeb,
-	0x8a, 0xe5, 0xe7, 0xcd, 0x78, 0x5f, 0x4e, 0xde, 0x24, 0x08, 0xf0, 0x17, 0xa7, 0xcc, 0xe0, 0xca,
-	0xb4, 0xfb, 0x2b, 0x0b, 0xe4, 0x6e, 0xce, 0xf3, 0x5b, 0x34, 0x50, 0x0c, 0x3f, 0x31, 0x05, 0x45,
-	0xd9, 0xb5, 0xfc, 0x90, 0x8a, 0x84, 0x06, 0x86, 0x3d, 0xc0, 0x14, 0xbf, 0x11, 0x11, 0x27, 0xb1,
-	0x3e, 0x02, 0x61, 0xc0, 0x4f, 0x4d, 0xc1, 0x8e, 0xb3, 0x44, 0xca, 0xde, 0x89, 0x5c, 0x57, 0xc3,
-	0x77, 0x57, 0x4b, 0xcd, 0x2e, 0x7f, 0xa7, 0x71, 0x9d, 0xde, 0x67, 0x2d, 0xf3, 0x14, 0x13, 0x09,
-	0x7c, 0x4f, 0x15, 0x0c, 0xe7, 0x40, 0x2a, 0xda, 0x59, 0x26, 0xc2, 0xec, 0x5f, 0x96, 0x64, 0xfa,
-	0x35, 0x34, 0x9a, 0x6a, 0x89, 0x40, 0x64, 0xaf, 0x3b, 0x59, 0x59, 0x2f, 0x69, 0xbe, 0xbf, 0x4a,
-	0x0e, 0xa0, 0x99, 0xd5, 0x5c, 0xc8, 0x1b, 0xa5, 0x62, 0x41, 0x16, 0xe9, 0x07, 0xaa, 0xa5, 0x26,
-	0x39, 0xa8, 0xb6, 0x00, 0x7d, 0xaf, 0x5a, 0xda, 0x35, 0x5c, 0xa9, 0x52, 0x17, 0xc5, 0x0f, 0x9a,
-	0xb7, 0x08, 0x47, 0x11, 0x82, 0x20, 0x9a, 0x33, 0x43, 0x4c, 0xde, 0x43, 0x15, 0xfe, 0x7e, 0xb5,
-	0xd4, 0xac, 0x0b, 0xc4, 0xca, 0xd2, 0x91, 0xc5, 0xe5, 0x63, 0xfd, 0xa5, 0x93, 0xbd, 0xa5, 0x65,
-	0xfc, 0x83, 0x6a, 0xa9, 0x8f, 0x82, 0x89, 0xb1, 0xbd, 0x14, 0xff, 0xb0, 0x0a, 0x64, 0x2d, 0xef,
-	0xa3, 0x8a, 0xd9, 0xbf, 0x4c, 0xcc, 0xa7, 0x11, 0x14, 0xbc, 0x39, 0xe7, 0x3b, 0xbe, 0x65, 0xda,
-	0xce, 0xf1, 0x05, 0x0e, 0x7a, 0x59, 0xde, 0x32, 0xf1, 0xad, 0xd3, 0xe0, 0xb4, 0x93, 0x67, 0x8f,
-	0xc3, 0x22, 0x4e, 0xf2, 0x5e, 0x89, 0x6f, 0x9b, 0x26, 0x67, 0x23, 0x14, 0xc5, 0x4c, 0xa4, 0x5c,
-	0xa9, 0x84, 0xe1, 0x77, 0xd7, 0x4a, 0xa5, 0x20, 0xe3, 0xa3, 0x51, 0x18, 0x52, 0xe1, 0xe3, 0x3f,
-	0x9a, 0xa1, 0xd3, 0x34, 0x8e, 0x01, 0x81, 0xe1, 0xed, 0x51, 0xa2, 0x61, 0xdc, 0x9e, 0x41, 0x7b,
-	0xc7, 0x7d, 0x3b, 0x42, 0x94, 0x61, 0xe6, 0x06, 0x62, 0xf7, 0x6f, 0xb1, 0x86, 0x48, 0xc1, 0x20,
-	0xbe, 0x0f, 0xed, 0xb6, 0x68, 0x4b, 0x95, 0x33, 0x2c, 0xfc, 0x67, 0x07, 0x3d, 0x53, 0xe1, 0x9f,
-	0xa9, 0x35, 0x2f, 0x3f, 0xfc, 0xdf, 0xc7, 0x4f, 0xac, 0x5c, 0x79, 0xfa, 0x8a, 0xfd, 0xff, 0xd7,
-	0x3f, 0x79, 0xa0, 0xdd, 0xef, 0x1f, 0x5f, 0xe8, 0x79, 0xfd, 0xc5, 0x95, 0x23, 0x27, 0x16, 0x7b,
-	0x4b, 0xba, 0xdf, 0x5f, 0x58, 0x3e, 0xb0, 0x7c, 0xf5, 0x91, 0x63, 0xc7, 0xfa, 0x0b, 0x47, 0x0f,
-	0x98, 0xbf, 0xe1, 0x1e, 0x30, 0x7f, 0xc3, 0xbd, 0x62, 0xda, 0xfc, 0xe3, 0xb2, 0x7f, 0x05, 0x00,
-	0x00, 0xff, 0xff, 0xe5, 0x8b, 0x9c, 0x07, 0xe6, 0x1d, 0x00, 0x00,
+	// 4238 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x5a, 0x79, 0x94, 0x1c, 0x47,
+	0x79, 0xf7, 0xec, 0xec, 0xec, 0xcc, 0x96, 0x8c, 0x5d, 0x2e, 0x4b, 0x96, 0x64, 0xd9, 0xb2, 0x7c,
+	0x02, 0x0b, 0x91, 0x48, 0xe0, 0xf1, 0xf2, 0xf2, 0x5f, 0x4d, 0x77, 0xcd, 0x4c, 0x69, 0xba, 0xab,
+	0xfa, 0x55, 0x55, 0xef, 0x6a, 0xf5, 0x4f, 0x3f, 0x13, 0xaf, 0x64, 0x61, 0x49, 0x2b, 0xb4, 0x2b,
+	0x83, 0x21, 0x87, 0x0d, 0x3e, 0xb8, 0xb1, 0x21, 0xdc, 0x97, 0x0f, 0xf2, 0x72, 0x61, 0xc0, 0xce,
+	0x9d, 0x60, 0x6c, 0x63, 0x48, 0x42, 0xb8, 0x6f, 0xdb, 0xe0, 0x24, 0x7f, 0x24, 0x79, 0xe1, 0xc8,
+	0xf1, 0x12, 0x6c, 0x73, 0x93, 0xf7, 0x55, 0x75, 0x75, 0x57, 0xcf, 0xcc, 0x9a, 0x3f, 0xfc, 0x3c,
+	0xaa, 0xef, 0xd7, 0x5f, 0x7d, 0xf5, 0xd5, 0x77, 0xd6, 0xb7, 0x68, 0xdb, 0xda, 0xc9, 0x33, 0x27,
+	0x36, 0xf6, 0x9f, 0x3a, 0xbd, 0xbe, 0xb9, 0x4e, 0xb6, 0xd9, 0xff, 0xed, 0xb7, 0x4b, 0x4b, 0xeb,
+	0x68, 0x5b, 0xff, 0xcc, 0xb1, 0xe3, 0xd7, 0xac, 0x9d, 0x36, 0x37, 0x9c, 0x5a, 0x23, 0xbb, 0xd0,
+	0xf6, 0x5c, 0x8c, 0x85, 0x5c, 0x11, 0x45, 0x3f, 0xe7, 0x49, 0xcc, 0x54, 0x61, 0x56, 0x33, 0x86,
+	0xcf, 0x22, 0x5d, 0xd4, 0x3e, 0xc8, 0xfb, 0xb8, 0x45, 0x16, 0x51, 0xa7, 0x4f, 0x0f, 0xb3, 0x04,
+	0xcf, 0x91, 0x73, 0x10, 0xb2, 0xa8, 0x8c, 0x46, 0x63, 0x8d, 0xdb, 0x04, 0xa1, 0x85, 0x28, 0xd7,
+	0x46, 0xa6, 0x78, 0x1e, 0x7e, 0x8f, 0xa9, 0xe0, 0x63, 0x89, 0x3b, 0xf0, 0x3b, 0x96, 0xd1, 0x98,
+	0x29, 0xbc, 0xb0, 0x14, 0xa3, 0x45, 0xbb, 0xa1, 0xdd, 0xee, 0x02, 0x44, 0x1a, 0xdb, 0xf9, 0xcd,
+	0xb6, 0xa1, 0x6e, 0x94, 0xe4, 0xda, 0x30, 0x85, 0x5b, 0xb0, 0xf3, 0x30, 0xea, 0xe3, 0x39, 0xd8,
+	0x39, 0x91, 0x11, 0x4d, 0x70, 0x7b, 0x69, 0x8c, 0x90, 0x59, 0xdb, 0xd8, 0x2c, 0xa5, 0xde, 0x81,
+	0xce, 0xf3, 0x6c, 0x0c, 0xd3, 0xc6, 0x73, 0xe9, 0xa1, 0xf9, 0x5c, 0x70, 0x83, 0x5b, 0xe4, 0x22,
+	0xb4, 0x2b, 0x92, 0xc2, 0x50, 0x2e, 0x98, 0x2a, 0xb4, 0x51, 0x79, 0x64, 0x72, 0xc5, 0x2c, 0x18,
+	0xcf, 0x2d, 0x5d, 0x87, 0x90, 0x5a, 0x3b, 0xe9, 0x55, 0xb0, 0x13, 0x9d, 0xef, 0x99, 0x29, 0x26,
+	0x02, 0x0d, 0x20, 0xb4, 0xa0, 0xe8, 0xca, 0xf8, 0xd7, 0x35, 0x6e, 0x81, 0xe0, 0x63, 0x7b, 0x52,
+	0x7e, 0x98, 0x15, 0x29, 0x15, 0x7c, 0x60, 0x59, 0x81, 0x46, 0x46, 0x2c, 0x49, 0x8b, 0x68, 0x44,
+	0x95, 0xc1, 0x6d, 0x82, 0xd1, 0xd9, 0xe3, 0xcc, 0xd4, 0x88, 0xf9, 0xa5, 0x43, 0xe8, 0xec, 0x78,
+	0xed, 0xd4, 0xf1, 0xf5, 0x1b, 0xca, 0xed, 0x76, 0xa3, 0x1d, 0x7e, 0xbb, 0x98, 0x65, 0x89, 0x5c,
+	0xad, 0x37, 0xec, 0xa1, 0x79, 0x60, 0x86, 0x5b, 0xe4, 0x59, 0x68, 0xb1, 0xda, 0x0e, 0xcf, 0x81,
+	0x7a, 0xc6, 0x79, 0x9f, 0x45, 0x26, 0xc1, 0x6d, 0x50, 0xcf, 0x38, 0x03, 0xce, 0x1c, 0x6d, 0x8b,
+	0x8e, 0x9f, 0xa9, 0x94, 0x12, 0x5c, 0x65, 0xa9, 0x4b, 0xcf, 0xf7, 0x6c, 0xd4, 0x4b, 0xb9, 0xe0,
+	0xc0, 0xa2, 0x54, 0xef, 0x98, 0x39, 0xf5, 0x4a, 0x33, 0x62, 0x0a, 0xb7, 0x97, 0x0e, 0xa2, 0x5e,
+	0xb2, 0x7e, 0x34, 0x59, 0xbb, 0x7e, 0xed, 0x38, 0x2c, 0xc7, 0xac, 0x9f, 0x0f, 0x9d, 0x40, 0x5c,
+	0x0c, 0x24, 0x6e, 0xc1, 0xaf, 0x15, 0xaa, 0x84, 0xfb, 0x8a, 0x29, 0x25, 0x15, 0x6e, 0xc3, 0xcf,
+	0x01, 0x35, 0x34, 0xc1, 0xf3, 0xf0, 0x33, 0xa3, 0x82, 0x47, 0xb8, 0xb3, 0x74, 0xcf, 0x8b, 0x10,
+	0xd2, 0x9b, 0x57, 0x6f, 0x9e, 0xd9, 0x88, 0xd6, 0xaf, 0x59, 0x23, 0x0b, 0x68, 0x4e, 0x8e, 0xf1,
+	0x59, 0x64, 0x17, 0x3a, 0x5f, 0x1b, 0x6a, 0x72, 0x1d, 0x8d, 0x58, 0x34, 0x2e, 0x74, 0x1e, 0x45,
+	0x4c, 0x6b, 0xfc, 0x0f, 0x2d, 0x42, 0xd0, 0xb3, 0x9c, 0x31, 0xf8, 0xb5, 0xcf, 0xb4, 0xc8, 0xf9,
+	0xe8, 0x9c, 0xf2, 0x32, 0xfc, 0xe2, 0xe7, 0xec, 0xa2, 0x53, 0x59, 0xb5, 0xf8, 0x8f, 0x2d, 0x72,
+	0x1e, 0x3a, 0xdb, 0xda, 0x80, 0x5f, 0xfa, 0xac, 0xbd, 0x7d, 0xc7, 0x30, 0xcb, 0xf5, 0xa8, 0xa0,
+	0x76, 0xbd, 0x88, 0x99, 0xe0, 0x2c, 0xc6, 0x6b, 0x64, 0x0f, 0xda, 0x59, 0x52, 0x95, 0x3c, 0xc8,
+	0x22, 0x53, 0x08, 0x69, 0x8a, 0x81, 0xcc, 0x45, 0x8c, 0x8f, 0x90, 0xcb, 0xd1, 0x25, 0x8e, 0xe8,
+	0xec, 0xb7, 0x88, 0x29, 0x4b, 0xa5, 0xb0, 0x10, 0x95, 0x0b, 0xc1, 0xc5, 0x10, 0x1f, 0x25, 0xdb,
+	0x11, 0x76, 0xa0, 0x5c, 0x33, 0x55, 0x38, 0x6d, 0x5c, 0x5b, 0xef, 0x5a, 0x7e, 0x9a, 0x0b, 0xba,
+	0x4c, 0x79, 0x42, 0xfb, 0x09, 0xc3, 0xc7, 0xc8, 0xc5, 0x68, 0xf7, 0x24, 0x35, 0x37, 0x23, 0xa9,
+	0xf8, 0x61, 0x16, 0xe3, 0x97, 0xd6, 0x42, 0x95, 0x64, 0xbd, 0xaa, 0x0d, 0x4b, 0x81, 0x37, 0xbe,
+	0x8e, 0x5c, 0x8a, 0x2e, 0x6e, 0x10, 0x41, 0x9a, 0x54, 0xc6, 0x7c, 0xc0, 0x59, 0x6c, 0x21, 0xc7,
+	0xc9, 0x15, 0x68, 0xdf, 0x14, 0x84, 0xa7, 0x59, 0xc2, 0x52, 0x26, 0x4c, 0x89, 0x3a, 0x41, 0xf6,
+	0xa2, 0x0b, 0x27, 0x4e, 0x67, 0x68, 0x91, 0x48, 0xad, 0x2d, 0xfd, 0xe4, 0x14, 0x7d, 0x20, 0x55,
+	0x9f, 0xc7, 0x31, 0x13, 0x96, 0xbe, 0x3e, 0x75, 0x88, 0x48, 0x8a, 0x41, 0xc2, 0x23, 0x63, 0xc9,
+	0xa7, 0xc8, 0x3e, 0x74, 0x51, 0x83, 0x6c, 0x35, 0x13, 0xa8, 0xf7, 0x65, 0xe4, 0x32, 0xb4, 0xb7,
+	0x81, 0xe0, 0x62, 0x99, 0x26, 0x3c, 0x2e, 0x32, 0xaa, 0xa8, 0x3b, 0xed, 0xe9, 0x49, 0x21, 0x06,
+	0x3c, 0x61, 0x01, 0x8f, 0x8d, 0xa9, 0xa3, 0x46, 0x34, 0x1a, 0xb1, 0x62, 0xa0, 0x64, 0x5a, 0x64,
+	0x79, 0x92, 0x58, 0x2e, 0x9b, 0xe4, 0x12, 0xb4, 0xa7, 0x81, 0x1a, 0x32, 0x53, 0xc4, 0x7c, 0x08,
+	0x96, 0x02, 0x80, 0x33, 0x53, 0x67, 0x11, 0xb2, 0xd0, 0x19, 0x8d, 0x98, 0x25, 0xff, 0x2e, 0xd9,
+	0xeb, 0xc9, 0x8a, 0x0d, 0xb9, 0x36, 0x6a, 0xb5, 0xd0, 0x4c, 0x2d, 0xbb, 0x43, 0xe1, 0x1b, 0x5b,
+	0x64, 0x37, 0xda, 0xee, 0xe8, 0x23, 0xa9, 0x43, 0x13, 0xba, 0xa9, 0x45, 0x2e, 0xf1, 0x07, 0xa8,
+	0x3e, 0x35, 0x89, 0x2e, 0x0c, 0x4f, 0x99, 0xcc, 0x0d, 0x7e, 0x75, 0x8b, 0x5c, 0xe9, 0x4f, 0xe0,
+	0x02, 0xa6, 0x33, 0xd3, 0x8c, 0xa9, 0x94, 0x6b, 0xcd, 0xa5, 0x53, 0xf6, 0x6b, 0x5a, 0xe4, 0x32,
+	0x7f, 0xed, 0x5e, 0x4b, 0x3c, 0xa5, 0x43, 0x56, 0x28, 0x36, 0x60, 0x8a, 0x89, 0x88, 0xe1, 0x9b,
+	0x5b, 0xe4, 0x0a, 0x6f, 0xaf, 0xd5, 0x5e, 0x91, 0x14, 0x82, 0x45, 0x06, 0xf8, 0x28, 0xa6, 0x99,
+	0xc1, 0xb7, 0xb4, 0xc8, 0x3e, 0xaf, 0x8c, 0x48, 0x66, 0xab, 0x85, 0x96, 0xb9, 0x8a, 0x42, 0x9d,
+	0xde, 0xda, 0xaa, 0x8f, 0x1b, 0x49, 0xa5, 0xf2, 0xcc, 0x14, 0x36, 0xf0, 0xba, 0x1d, 0xf1, 0x6d,
+	0xad, 0xda, 0x04, 0xab, 0x7d, 0x26, 0x14, 0x7a, 0x7d, 0x0d, 0xf1, 0x51, 0xe8, 0x20, 0xef, 0x17,
+	0x59, 0x92, 0x0f, 0xb9, 0x70, 0x81, 0xe8, 0xe5, 0xb5, 0x8b, 0x00, 0x69, 0xa8, 0x68, 0x9c, 0x30,
+	0x08, 0x82, 0x96, 0xc1, 0x2b, 0x6a, 0x1f, 0x00, 0x6a, 0x4a, 0x97, 0x99, 0xa8, 0x88, 0x37, 0x90,
+	0x25, 0x74, 0x15, 0x17, 0xdc, 0x54, 0xb7, 0xc5, 0xcc, 0x8a, 0x54, 0xe3, 0x22, 0xe1, 0xda, 0x70,
+	0x31, 0x2c, 0xaa, 0x68, 0xaf, 0xf1, 0x2b, 0xc9, 0x7e, 0xb4, 0x34, 0x0b, 0xeb, 0xd5, 0x58, 0x67,
+	0x06, 0x41, 0x53, 0x86, 0x5f, 0x45, 0x5e, 0x80, 0x9e, 0x3f, 0x0b, 0x5f, 0xe3, 0x62, 0xc9, 0xb4,
+	0xd5, 0x17, 0x3b, 0xc4, 0xb5, 0xc1, 0xbf, 0x05, 0x36, 0xf8, 0x4c, 0x3b, 0xa4, 0x32, 0x66, 0xf8,
+	0xb7, 0x41, 0x23, 0xb3, 0x50, 0x19, 0x55, 0xda, 0x99, 0xd9, 0xef, 0x80, 0xad, 0x84, 0x51, 0xd1,
+	0xdd, 0x70, 0x65, 0xc6, 0x1f, 0x9e, 0x23, 0x97, 0xa3, 0xbd, 0x21, 0xa0, 0x96, 0x29, 0x52, 0x8c,
+	0xc2, 0xd1, 0xf1, 0xbd, 0x73, 0x60, 0x29, 0x21, 0x48, 0xe5, 0x22, 0x00, 0x02, 0xa3, 0x8f, 0xcc,
+	0x81, 0xd1, 0xcd, 0x66, 0x64, 0xc0, 0xf0, 0x04, 0x35, 0x2c, 0xc6, 0x1f, 0x9d, 0x23, 0xcf, 0x43,
+	0x57, 0x85, 0x30, 0x17, 0x6f, 0x21, 0x88, 0x14, 0x4a, 0x26, 0x89, 0xcc, 0x4d, 0x91, 0x31, 0x11,
+	0xc3, 0xbe, 0x1f, 0x9b, 0x23, 0xcf, 0x46, 0x97, 0x4d, 0x4b, 0xef, 0x73, 0x9f, 0xb7, 0x03, 0x7c,
+	0xdf, 0x1c, 0xb9, 0x0a, 0x5d, 0x3a, 0x7b, 0x73, 0x10, 0x95, 0xea, 0x42, 0x49, 0x69, 0xf0, 0xfd,
+	0xcf, 0x20, 0xa4, 0x62, 0xda, 0x50, 0x65, 0xcf, 0xfb, 0xaf, 0x73, 0xe4, 0x42, 0xb4, 0x23, 0x84,
+	0xe5, 0x62, 0xc4, 0x68, 0x62, 0x46, 0xab, 0xf8, 0xdf, 0x9e, 0x81, 0x05, 0x3b, 0xc4, 0xa2, 0x32,
+	0x58, 0xff, 0xfb, 0x14, 0x4c, 0xc8, 0x98, 0x15, 0x29, 0x4b, 0xa5, 0x5a, 0x2d, 0x32, 0xc5, 0xb4,
+	0xce, 0x15, 0xc3, 0xb7, 0xb7, 0x27, 0xd5, 0x6f, 0x61, 0x31, 0xd7, 0xe3, 0x1a, 0x74, 0x47, 0x9b,
+	0x3c, 0x17, 0x5d, 0x31, 0x05, 0xf2, 0x97, 0x1d, 0x66, 0x81, 0xb7, 0xb6, 0x27, 0x6f, 0xca, 0x42,
+	0x33, 0x08, 0x80, 0x9e, 0xdd, 0xdb, 0x66, 0xef, 0x99, 0x0b, 0xf8, 0x57, 0x9c, 0x3b, 0x46, 0xbf,
+	0xd7, 0x26, 0x97, 0xa2, 0x8b, 0x66, 0x80, 0x14, 0xa3, 0xd1, 0xc8, 0x42, 0xde, 0xde, 0x9e, 0xb4,
+	0x2d, 0x27, 0x16, 0x24, 0x32, 0x46, 0xe3, 0x55, 0xfc, 0x8e, 0x29, 0x61, 0x06, 0x94, 0x27, 0x2c,
+	0x2e, 0xca, 0x8d, 0x40, 0xd5, 0xef, 0x6c, 0x4f, 0x5e, 0x71, 0x59, 0x86, 0x84, 0x51, 0x06, 0xec,
+	0xeb, 0xdd, 0x53, 0x52, 0x7b, 0x20, 0x1c, 0x6e, 0xcc, 0x93, 0x84, 0xc5, 0xf8, 0x3d, 0x53, 0x9a,
+	0xaa, 0xb8, 0x25, 0x1c, 0x2c, 0x6c, 0xc0, 0x4c, 0x34, 0xb2, 0xfc, 0xde, 0xdb, 0x9e, 0xbc, 0xa0,
+	0xc0, 0x10, 0x6b, 0xd8, 0xfb, 0xa6, 0xe4, 0xb3, 0x87, 0xd4, 0x2c, 0x61, 0x91, 0x91, 0xaa, 0x48,
+	0xb9, 0x4e, 0xa9, 0x89, 0x46, 0xf8, 0xfd, 0x53, 0x87, 0xcd, 0x85, 0x91, 0x09, 0x53, 0x60, 0xf6,
+	0x05, 0x18, 0x88, 0xc1, 0x1f, 0x98, 0xda, 0xd3, 0x97, 0x51, 0x8d, 0x5c, 0xfd, 0xc1, 0x36, 0xb9,
+	0x08, 0xed, 0x6c, 0xb2, 0xea, 0x43, 0x18, 0x2d, 0xb2, 0xe5, 0x08, 0xdf, 0x39, 0x45, 0xcd, 0x64,
+	0x5c, 0xb0, 0x65, 0x1e, 0x81, 0x7f, 0xdd, 0xd5, 0x26, 0x4b, 0xe8, 0xca, 0xd9, 0x5b, 0xb8, 0x98,
+	0x59, 0x6e, 0x64, 0x56, 0xf1, 0xdd, 0x53, 0x22, 0x1f, 0x94, 0xfd, 0xa2, 0x4f, 0xa3, 0xb1, 0x1c,
+	0x0c, 0x8a, 0x84, 0xa7, 0xdc, 0xe0, 0x7b, 0xa6, 0x44, 0x86, 0x24, 0xc5, 0x6d, 0x70, 0x2f, 0x98,
+	0x88, 0x33, 0xc9, 0x85, 0xd1, 0xf8, 0x43, 0x53, 0xe6, 0x02, 0x42, 0x41, 0x68, 0xe2, 0x34, 0xe1,
+	0x87, 0xe1, 0xa6, 0x1f, 0x69, 0x43, 0x19, 0xe6, 0xa5, 0x70, 0x5e, 0xf2, 0x83, 0xf6, 0x64, 0xd1,
+	0xe6, 0x3d, 0xfa, 0xc9, 0xf6, 0xa4, 0x47, 0xfb, 0x2f, 0x9b, 0x76, 0xfa, 0xd4, 0xf4, 0x79, 0x2b,
+	0x77, 0x5c, 0xa1, 0xdc, 0x46, 0x6e, 0xcf, 0xf3, 0xe9, 0x36, 0x24, 0xa1, 0x59, 0x3c, 0xd9, 0x32,
+	0x13, 0x06, 0xff, 0xac, 0x1d, 0xd4, 0x7f, 0xfe, 0xa3, 0x1f, 0xb6, 0xa1, 0xfe, 0xd3, 0xab, 0x22,
+	0xaa, 0x96, 0x7e, 0xd4, 0xae, 0x0b, 0x4a, 0xbf, 0xf6, 0xe3, 0x36, 0xd9, 0x8e, 0xce, 0x8d, 0xd9,
+	0xb2, 0x0d, 0xc7, 0x7e, 0xf5, 0x27, 0x76, 0x35, 0x4a, 0x18, 0x15, 0x79, 0x56, 0xad, 0xfe, 0xd4,
+	0xb2, 0x6c, 0x00, 0x7f, 0xde, 0xae, 0xd3, 0x7d, 0x55, 0xbe, 0x39, 0xd2, 0x2f, 0xda, 0x55, 0x01,
+	0xea, 0x97, 0x6e, 0x9c, 0x0f, 0xaa, 0x57, 0xbf, 0x78, 0xd3, 0x3c, 0xec, 0x65, 0x05, 0xb5, 0xac,
+	0x9d, 0x86, 0x1f, 0x9b, 0x27, 0x7b, 0xd0, 0x05, 0x76, 0x35, 0x48, 0xff, 0x65, 0xa5, 0xfa, 0xf8,
+	0x3c, 0xe4, 0x6d, 0x2f, 0xb4, 0xcb, 0xbb, 0x4c, 0x95, 0x7d, 0x53, 0xcc, 0x32, 0x8d, 0x1f, 0xe8,
+	0x80, 0x8f, 0x4f, 0x21, 0xac, 0x34, 0x16, 0xf0, 0x89, 0x0e, 0x5c, 0xfc, 0x14, 0xa0, 0x54, 0xa2,
+	0x85, 0x3c, 0xd8, 0x99, 0xb9, 0x0b, 0x54, 0x76, 0x7c, 0x08, 0x10, 0xfc, 0x50, 0x07, 0xaa, 0x8c,
+	0x5a, 0x79, 0x3a, 0xcf, 0x32, 0xa9, 0xc0, 0x6f, 0x96, 0x7f, 0xb5, 0x6e, 0x74, 0x1e, 0xee, 0xc0,
+	0xf5, 0x79, 0xd4, 0x0a, 0x78, 0x1d, 0x58, 0x99, 0x2e, 0xa3, 0x0a, 0xfe, 0x64, 0x83, 0x2e, 0x64,
+	0x51, 0x85, 0xc5, 0x02, 0xb8, 0xe1, 0x47, 0x3a, 0x10, 0xd5, 0x3d, 0x9d, 0x0b, 0x69, 0xf8, 0x60,
+	0xb5, 0x34, 0xf3, 0x4f, 0x75, 0x20, 0xba, 0x54, 0x32, 0x66, 0x51, 0xb3, 0xc8, 0x19, 0xe4, 0x9a,
+	0xc5, 0xf8, 0xd3, 0x9d, 0xc9, 0x80, 0x67, 0xab, 0xf3, 0x88, 0x8a, 0x88, 0xd9, 0xf0, 0x73, 0xe7,
+	0xc2, 0xa4, 0x43, 0xc5, 0x8c, 0xc6, 0x09, 0x17, 0xac, 0x60, 0x87, 0x22, 0xc6, 0x62, 0x70, 0xd0,
+	0x05, 0xb8, 0xa6, 0xb2, 0x12, 0xaa, 0xbe, 0xbc, 0x7b, 0x81, 0xec, 0x40, 0xb8, 0xd4, 0x5a, 0xbd,
+	0x7c, 0xcf, 0x02, 0xdc, 0xde, 0x44, 0x2d, 0xea, 0x89, 0x1f, 0x5a, 0x80, 0xf3, 0x34, 0xab, 0xed,
+	0x72, 0x3b, 0xfc, 0xfb, 0x0b, 0xe4, 0x62, 0xb4, 0xcb, 0x1e, 0xc6, 0x66, 0x71, 0x56, 0x18, 0x3a,
+	0x1c, 0x56, 0xad, 0xc4, 0x2d, 0x5d, 0x38, 0x89, 0x25, 0xfb, 0xb6, 0xad, 0xc8, 0x28, 0x1c, 0xb2,
+	0x04, 0xdc, 0xda, 0x85, 0x1b, 0x69, 0x02, 0x82, 0x0e, 0xa5, 0x44, 0xdd, 0xd6, 0x05, 0x8d, 0x87,
+	0xbb, 0xf8, 0x0e, 0xdf, 0xd1, 0x5f, 0x5b, 0x6f, 0x53, 0xd2, 0xab, 0x7e, 0xd4, 0x01, 0x5e, 0x37,
+	0x05, 0xf0, 0x96, 0x55, 0x02, 0x5e, 0xdf, 0x05, 0xbd, 0x38, 0x80, 0x2d, 0xc2, 0xdd, 0xf2, 0x1b,
+	0x6a, 0xf1, 0xca, 0xef, 0x9c, 0x39, 0x18, 0xc5, 0x83, 0x53, 0xbe, 0xb1, 0x0b, 0x97, 0x1a, 0xa2,
+	0x20, 0xbf, 0x0f, 0x68, 0x14, 0xee, 0xf0, 0xa6, 0x19, 0x27, 0xcd, 0x94, 0x9c, 0xe8, 0x09, 0xde,
+	0xdc, 0x05, 0x1b, 0xb6, 0xa8, 0x31, 0x17, 0x71, 0x15, 0x54, 0x6b, 0xc4, 0x5b, 0xba, 0x10, 0xca,
+	0x2c, 0x62, 0xa2, 0x5e, 0xbf, 0x3d, 0x10, 0x43, 0xa6, 0x99, 0xd4, 0x75, 0xea, 0xae, 0x41, 0x77,
+	0x74, 0xc1, 0x74, 0xbc, 0x01, 0x94, 0xdc, 0x27, 0x52, 0xe0, 0x77, 0xba, 0x10, 0x8b, 0x2b, 0xd7,
+	0xea, 0xe7, 0xc3, 0x62, 0xc4, 0x92, 0xcc, 0xd6, 0x2e, 0x46, 0x71, 0xb6, 0xec, 0x4a, 0xbe, 0xef,
+	0x76, 0xc9, 0x4e, 0x44, 0x2a, 0x56, 0x2e, 0xf6, 0x00, 0xe1, 0x7b, 0x5d, 0x30, 0x8a, 0x92, 0x60,
+	0xdf, 0x14, 0x68, 0x96, 0x25, 0xab, 0x45, 0x42, 0xfb, 0x2c, 0xd1, 0xf8, 0xfb, 0x5d, 0x88, 0x41,
+	0x21, 0xd9, 0x37, 0x9f, 0xf8, 0x3f, 0xc3, 0x2f, 0x85, 0x2c, 0x6c, 0xca, 0x03, 0x3b, 0xb0, 0xf7,
+	0x8d, 0xff, 0xab, 0x0b, 0x29, 0x29, 0xfc, 0x72, 0x99, 0xa9, 0xaa, 0xcf, 0xf8, 0xef, 0xae, 0xf3,
+	0xff, 0x9a, 0x9a, 0x72, 0xd1, 0x40, 0xfc, 0x4f, 0xd7, 0x45, 0x19, 0x8b, 0xf0, 0x19, 0x3b, 0x04,
+	0x7c, 0xbd, 0xe7, 0x02, 0x44, 0x03, 0x20, 0x07, 0x03, 0xeb, 0x5a, 0x50, 0x0a, 0x5b, 0xd4, 0xff,
+	0x76, 0x03, 0x14, 0x53, 0x75, 0x02, 0x18, 0x48, 0x70, 0x8d, 0x84, 0x81, 0x26, 0xf1, 0xff, 0x85,
+	0x67, 0x81, 0x42, 0xa5, 0x2e, 0x27, 0x81, 0xc9, 0x0f, 0x42, 0x26, 0x96, 0xac, 0x58, 0x2a, 0x0d,
+	0x6b, 0xa2, 0x9e, 0x0c, 0x99, 0x40, 0x87, 0xd0, 0x24, 0x3f, 0x15, 0x2a, 0xc4, 0xcb, 0x5b, 0x69,
+	0xf3, 0xe9, 0xae, 0x0b, 0x54, 0x25, 0xd5, 0xbf, 0xf9, 0x54, 0xf4, 0x1f, 0x36, 0x25, 0xcc, 0x12,
+	0xe8, 0x19, 0x5d, 0xe5, 0x0b, 0xe4, 0x1f, 0x85, 0xa6, 0x62, 0x14, 0x15, 0x7a, 0x20, 0x55, 0xda,
+	0x14, 0xe0, 0xc7, 0xe1, 0x5d, 0x6a, 0x66, 0xdc, 0x1d, 0x5b, 0xd2, 0x4f, 0xc2, 0xdd, 0xab, 0x8f,
+	0x56, 0x14, 0x37, 0x8e, 0xfd, 0x4f, 0x43, 0x2b, 0x73, 0x8d, 0x44, 0x85, 0xb2, 0x42, 0xb8, 0x56,
+	0xfe, 0x67, 0x5d, 0xf2, 0x1c, 0x74, 0x79, 0x78, 0xab, 0xa5, 0x8f, 0x09, 0xd7, 0xc7, 0xd4, 0x35,
+	0xe9, 0xcf, 0xbb, 0x50, 0x42, 0x4d, 0x98, 0x36, 0x17, 0x86, 0x29, 0x41, 0x93, 0xf0, 0x19, 0xe2,
+	0x17, 0x0d, 0xad, 0x65, 0xc6, 0xfa, 0xa0, 0xcf, 0x65, 0xf8, 0xc6, 0x9e, 0x0b, 0xd1, 0x4d, 0xb9,
+	0x6d, 0x37, 0x44, 0x2b, 0x17, 0xb9, 0xa9, 0x17, 0xd8, 0xda, 0xcc, 0xe2, 0xea, 0xd5, 0xa1, 0x29,
+	0xd1, 0xd8, 0x67, 0xc4, 0x15, 0xd6, 0x1f, 0x49, 0x39, 0xf6, 0x99, 0xf1, 0x35, 0xbd, 0x49, 0x9b,
+	0xb5, 0xcf, 0x6f, 0x81, 0xc3, 0xde, 0xdc, 0x73, 0xd9, 0xc4, 0x21, 0x9a, 0x1e, 0x7f, 0x4b, 0xf8,
+	0xf5, 0xcc, 0x16, 0xfd, 0xd6, 0x10, 0x41, 0x85, 0x90, 0xc6, 0x9e, 0x41, 0x17, 0x46, 0xca, 0x22,
+	0x91, 0x62, 0x88, 0x6f, 0xeb, 0x4d, 0x3a, 0x2b, 0x58, 0x73, 0xc5, 0xe0, 0xb5, 0xa1, 0x36, 0xb6,
+	0xea, 0xde, 0x5f, 0xd7, 0x83, 0xf4, 0xe1, 0x41, 0x69, 0x9a, 0x1b, 0x9b, 0x0d, 0x07, 0x9c, 0x25,
+	0x31, 0x7e, 0x7d, 0xcf, 0x65, 0xee, 0x86, 0x8d, 0xc2, 0x19, 0x12, 0x39, 0x1c, 0x32, 0x28, 0xde,
+	0xf0, 0x1b, 0x7a, 0x50, 0x4e, 0x57, 0xe7, 0x28, 0xd9, 0x82, 0x47, 0x4c, 0x35, 0xf8, 0x6f, 0x0c,
+	0xb9, 0xb9, 0x10, 0x40, 0x93, 0x9c, 0xe9, 0xa0, 0x15, 0x7d, 0x53, 0x2f, 0x30, 0xac, 0xad, 0x1f,
+	0x13, 0xde, 0x1c, 0xc2, 0xb6, 0x2e, 0x5e, 0xdf, 0xd2, 0x0b, 0x62, 0x06, 0xb8, 0xe8, 0x32, 0x8b,
+	0x0b, 0x9a, 0x71, 0x1f, 0x37, 0xf0, 0xed, 0xa1, 0x44, 0x2c, 0xcd, 0xcc, 0x6a, 0x50, 0x1a, 0xba,
+	0x57, 0x87, 0x3b, 0x7a, 0x50, 0x64, 0x4e, 0x44, 0xca, 0x19, 0x87, 0x7b, 0x6b, 0x68, 0x33, 0x95,
+	0xe9, 0xad, 0xd2, 0x14, 0xcc, 0x57, 0x18, 0x7a, 0xc8, 0x9e, 0xef, 0x6d, 0x3d, 0xf0, 0x39, 0x57,
+	0xa8, 0xe9, 0xba, 0xc0, 0x01, 0xd2, 0xe7, 0x7b, 0xe4, 0x02, 0x74, 0x9e, 0x25, 0x45, 0x9e, 0x0c,
+	0xeb, 0x5f, 0xa8, 0xd7, 0x79, 0x3a, 0xac, 0xbb, 0xf2, 0x2f, 0xf6, 0xc0, 0x47, 0x1d, 0xde, 0xc6,
+	0x87, 0x22, 0x4a, 0xe3, 0x40, 0x95, 0x5f, 0xea, 0x41, 0xd5, 0x3b, 0x49, 0x87, 0x4e, 0x57, 0x48,
+	0x51, 0x1c, 0x66, 0x4a, 0x16, 0xec, 0x50, 0xe9, 0x37, 0x5f, 0xb6, 0xfa, 0x9c, 0x85, 0x05, 0x5b,
+	0x8a, 0xa1, 0xdf, 0x06, 0xd8, 0x57, 0xac, 0x2e, 0x66, 0xc1, 0xaa, 0x8a, 0xc3, 0xe2, 0xbe, 0xba,
+	0x25, 0x0e, 0xba, 0xdf, 0xbc, 0xf2, 0xc4, 0xaf, 0x59, 0x03, 0x9d, 0x8d, 0xe3, 0xfe, 0xbd, 0xf0,
+	0x1b, 0x3d, 0xf0, 0xf8, 0x99, 0x20, 0xa5, 0xf0, 0x37, 0xa7, 0x24, 0x8f, 0x59, 0x06, 0x45, 0xaf,
+	0x88, 0x38, 0xd3, 0x16, 0x0a, 0xb0, 0x47, 0x7b, 0xe4, 0xf9, 0xe8, 0xd9, 0x5b, 0xc2, 0x72, 0x91,
+	0x52, 0xa5, 0x47, 0xb4, 0x54, 0xed, 0x63, 0xd6, 0x2c, 0xa6, 0xb6, 0x0c, 0x13, 0xe8, 0xe3, 0x56,
+	0xaa, 0xb2, 0xbc, 0x9e, 0x8a, 0x43, 0x4f, 0x6c, 0x03, 0x63, 0x98, 0xa2, 0x3a, 0x0f, 0xb4, 0x36,
+	0x61, 0x83, 0x34, 0x02, 0x35, 0x6d, 0x81, 0x82, 0xe2, 0x30, 0xa5, 0x2e, 0x50, 0x23, 0x70, 0xf3,
+	0x12, 0x67, 0xfd, 0xc6, 0xb0, 0x34, 0x4b, 0x68, 0x19, 0x8c, 0xff, 0x69, 0x1b, 0x58, 0xb9, 0x67,
+	0x53, 0xa5, 0x0a, 0x57, 0xf4, 0x01, 0xe0, 0x9f, 0xb7, 0x81, 0x8b, 0x96, 0x80, 0x20, 0x18, 0x96,
+	0x5b, 0x84, 0x6f, 0x03, 0xff, 0xb2, 0x0d, 0x8e, 0x55, 0x5a, 0xa5, 0x95, 0x07, 0x0c, 0xaa, 0xac,
+	0xe6, 0xf0, 0x7d, 0x8b, 0x60, 0x72, 0x21, 0xb5, 0xb2, 0x7f, 0x4b, 0xbf, 0x7f, 0x11, 0x8e, 0x5d,
+	0x17, 0xed, 0x4e, 0xc1, 0xab, 0x13, 0xa8, 0x3f, 0x5d, 0x04, 0x71, 0x3c, 0x2a, 0xcf, 0x12, 0x1e,
+	0xd9, 0x9c, 0x40, 0x53, 0xa6, 0x0b, 0x4d, 0x53, 0xe6, 0x58, 0x03, 0xf4, 0xcf, 0x16, 0xe1, 0xda,
+	0xb6, 0x80, 0xd2, 0x48, 0x49, 0xad, 0x2d, 0xd8, 0xa5, 0x9b, 0x3f, 0x5f, 0x84, 0x70, 0x5b, 0xa2,
+	0xfb, 0x34, 0x06, 0x92, 0x29, 0xbd, 0xe8, 0x2f, 0x42, 0x9a, 0x35, 0xfe, 0x5a, 0xa0, 0xbf, 0x0c,
+	0x8f, 0xe5, 0xca, 0x9d, 0xb2, 0xba, 0x73, 0x7c, 0xff, 0x2a, 0xa4, 0xc7, 0x6c, 0x40, 0xf3, 0xc4,
+	0xf8, 0xd0, 0x05, 0xf4, 0xbf, 0x5e, 0x84, 0xe4, 0xd5, 0x54, 0x9a, 0x19, 0xe9, 0x42, 0xe7, 0x7d,
+	0x6d, 0xb8, 0xa9, 0xed, 0xfd, 0x6f, 0x16, 0xc9, 0xaf, 0xa0, 0xe7, 0x94, 0xc0, 0x34, 0x4f, 0x0c,
+	0x2f, 0x78, 0x0a, 0x7d, 0x47, 0x55, 0x4d, 0x36, 0x9e, 0xb2, 0xff, 0x76, 0x11, 0x92, 0x78, 0x09,
+	0xaf, 0x24, 0x6a, 0x2a, 0xf3, 0xe3, 0x8b, 0xe0, 0x42, 0x25, 0xa6, 0x8a, 0x80, 0x75, 0x88, 0xb3,
+	0xa0, 0x07, 0x16, 0xc1, 0x80, 0x26, 0x40, 0xa5, 0x21, 0x48, 0x85, 0x3f, 0xb1, 0x08, 0x06, 0x34,
+	0x41, 0xae, 0x8a, 0x06, 0xa6, 0xf0, 0x83, 0x8b, 0xe0, 0x64, 0x5e, 0x6e, 0xc8, 0x86, 0x62, 0x58,
+	0xc7, 0xb8, 0xea, 0xb6, 0x1e, 0x0a, 0x75, 0x6c, 0x13, 0x88, 0x7d, 0x71, 0xb5, 0xb4, 0x87, 0x17,
+	0x5d, 0xe9, 0xab, 0x33, 0x16, 0x99, 0x22, 0xe8, 0xf0, 0xf1, 0xdb, 0x11, 0x98, 0x83, 0xa7, 0x94,
+	0x56, 0x2b, 0x96, 0x0b, 0x9a, 0xd8, 0x17, 0x22, 0xf7, 0xd8, 0xe9, 0x14, 0xfd, 0x8e, 0x2d, 0xa0,
+	0x5c, 0x44, 0x52, 0x29, 0x58, 0xab, 0xf6, 0x7b, 0x27, 0x02, 0xdd, 0x79, 0xe8, 0x54, 0xb1, 0x6e,
+	0x31, 0xef, 0x42, 0x4b, 0xf7, 0xed, 0x46, 0xe7, 0xe8, 0x33, 0x47, 0x8f, 0xae, 0x6d, 0x6c, 0x1e,
+	0x5b, 0x3f, 0x69, 0x27, 0x45, 0x5d, 0xd4, 0x16, 0x3c, 0xc1, 0x67, 0x91, 0xed, 0x08, 0xd3, 0x38,
+	0xae, 0x2e, 0x5c, 0xb1, 0x4c, 0xe2, 0x6b, 0xc8, 0x05, 0x88, 0xf8, 0xb6, 0x2d, 0x58, 0x5f, 0x23,
+	0x57, 0xa0, 0x7d, 0xd3, 0xeb, 0xc5, 0x30, 0x91, 0x7d, 0x9a, 0x94, 0x61, 0x1e, 0x1f, 0x21, 0xfb,
+	0xd0, 0x45, 0xc3, 0x28, 0x91, 0x79, 0xd5, 0x8d, 0x41, 0xb2, 0x2a, 0xc9, 0xb9, 0x62, 0xf8, 0x28,
+	0xd9, 0x8d, 0x76, 0xcc, 0x26, 0x5d, 0x4b, 0x76, 0xa1, 0xed, 0x6e, 0x8b, 0x92, 0x45, 0x39, 0x39,
+	0xc2, 0xc7, 0x6a, 0x4a, 0xf9, 0xa9, 0x1f, 0x12, 0xbd, 0x14, 0xc4, 0x1d, 0xf0, 0x43, 0x2e, 0x9b,
+	0xd4, 0x11, 0xe1, 0x3a, 0x58, 0x2f, 0xb1, 0xfe, 0x41, 0xdd, 0xa8, 0x55, 0x7c, 0x9c, 0x5c, 0x86,
+	0xf6, 0x02, 0x3e, 0x98, 0x66, 0x54, 0xfd, 0x50, 0x79, 0x88, 0x13, 0x1e, 0xa3, 0xc7, 0x74, 0x30,
+	0x90, 0xf6, 0xb1, 0xde, 0x79, 0x46, 0x35, 0x28, 0xc1, 0x27, 0xe1, 0xa0, 0x80, 0x09, 0x1e, 0xdf,
+	0xfd, 0x49, 0x6c, 0xec, 0xc1, 0xeb, 0xe4, 0x4a, 0x74, 0x29, 0x20, 0xb6, 0x7c, 0xed, 0xb6, 0xaf,
+	0xe2, 0xa7, 0xc8, 0x12, 0xba, 0xaa, 0x71, 0xb4, 0x69, 0xa0, 0x3f, 0xec, 0xcb, 0xc8, 0x6f, 0xa0,
+	0x17, 0xcf, 0x60, 0x69, 0x6b, 0xff, 0x95, 0x11, 0x83, 0xac, 0x62, 0x14, 0x8d, 0x9a, 0x2f, 0xf5,
+	0x6e, 0x9f, 0xd3, 0x70, 0xdb, 0x90, 0x53, 0xca, 0x6f, 0x33, 0x95, 0x0b, 0x86, 0x37, 0x60, 0x15,
+	0x2a, 0x63, 0x9f, 0xf7, 0x07, 0x09, 0x1d, 0xe2, 0x4d, 0xb2, 0x07, 0xed, 0x1c, 0x2a, 0x2a, 0x4c,
+	0x31, 0x8c, 0xb2, 0xba, 0x4a, 0x51, 0x32, 0x61, 0xf8, 0x0c, 0xb9, 0x04, 0xed, 0x29, 0x6f, 0x69,
+	0xcc, 0x0a, 0x10, 0x20, 0x91, 0x34, 0x2e, 0x78, 0xcc, 0x84, 0x81, 0x9a, 0xe4, 0x7a, 0xb2, 0x13,
+	0x9d, 0x6f, 0xb5, 0x5d, 0x7f, 0x99, 0xe5, 0x7a, 0x84, 0x5f, 0x4e, 0x2e, 0x45, 0x17, 0x97, 0x0f,
+	0x92, 0xc1, 0x40, 0x26, 0x66, 0xda, 0x70, 0xe1, 0x94, 0xf6, 0x0a, 0x42, 0xd0, 0x39, 0x70, 0x42,
+	0x57, 0xc4, 0x59, 0xc9, 0x6f, 0x20, 0x7b, 0xd1, 0x85, 0xf5, 0xa9, 0xcb, 0x28, 0x52, 0xcd, 0x57,
+	0xf0, 0x2b, 0x81, 0xee, 0x6a, 0x9f, 0x99, 0x53, 0x95, 0x57, 0xd9, 0xd0, 0xd0, 0x78, 0x05, 0xac,
+	0xcb, 0x2d, 0xfc, 0x99, 0x96, 0x75, 0x69, 0x4b, 0xae, 0x5a, 0x5f, 0xf7, 0x78, 0x51, 0x4e, 0x3d,
+	0xb9, 0xd0, 0x06, 0xf2, 0xa6, 0x1d, 0x19, 0x7f, 0xd6, 0x2e, 0xe5, 0xd9, 0x50, 0xd1, 0x98, 0xb9,
+	0xa5, 0xcf, 0xb5, 0xc8, 0x0b, 0xd0, 0xf3, 0x66, 0xd9, 0x8b, 0xeb, 0x2e, 0xbd, 0x75, 0xc9, 0x65,
+	0xa6, 0x14, 0x8f, 0x99, 0xc6, 0x9f, 0xb7, 0x23, 0xd6, 0x90, 0xc9, 0x0b, 0x7f, 0x0d, 0x7f, 0xa1,
+	0x45, 0xf6, 0xa3, 0xe7, 0x6e, 0xc9, 0xc6, 0xf7, 0x15, 0x90, 0x18, 0x32, 0x1a, 0x31, 0xfc, 0x45,
+	0x3b, 0x00, 0xb3, 0xba, 0xf2, 0xd3, 0x90, 0x3a, 0x5e, 0x41, 0x69, 0xfb, 0xa5, 0x16, 0xd4, 0xbd,
+	0xee, 0x64, 0x75, 0x89, 0x9f, 0xc9, 0x84, 0x47, 0xab, 0xf8, 0xcb, 0x76, 0x1c, 0x0c, 0xde, 0x6f,
+	0xb9, 0x5b, 0x17, 0xff, 0x8a, 0xfd, 0x00, 0x38, 0x06, 0xb5, 0xbe, 0x2f, 0x24, 0xbf, 0x6a, 0xf5,
+	0x94, 0x6b, 0xe6, 0xe7, 0x73, 0x9a, 0xc7, 0xcc, 0xe5, 0x12, 0xfc, 0x35, 0x4b, 0xe3, 0x02, 0x64,
+	0xd4, 0xac, 0x4c, 0xe0, 0x65, 0x89, 0xfe, 0xf5, 0x16, 0x14, 0x77, 0x8a, 0x95, 0xf2, 0xfb, 0xba,
+	0x12, 0x7f, 0xa3, 0x45, 0x76, 0x20, 0x9c, 0x48, 0x3b, 0xb5, 0x92, 0xfe, 0x66, 0xf0, 0x37, 0xed,
+	0xa9, 0x2a, 0xf0, 0x54, 0xb1, 0x8d, 0x1f, 0x6d, 0x91, 0xed, 0xe8, 0xdc, 0x4a, 0x48, 0x97, 0xa4,
+	0xf0, 0x63, 0x2d, 0xe8, 0xf6, 0xf3, 0x2c, 0xb6, 0xef, 0x1b, 0x41, 0xfd, 0xfb, 0x78, 0xab, 0xbe,
+	0x7d, 0x67, 0x53, 0x61, 0xce, 0xc2, 0xdf, 0xb2, 0xdc, 0xfc, 0x0d, 0xfb, 0xd9, 0xff, 0xb7, 0xad,
+	0xcc, 0xb0, 0x47, 0xa3, 0xcc, 0xc5, 0x4f, 0xd8, 0x71, 0xe4, 0xe4, 0x03, 0x6b, 0x22, 0x87, 0x1a,
+	0xdf, 0x3b, 0x57, 0x9b, 0x11, 0x44, 0x75, 0x2e, 0x98, 0xd6, 0x10, 0xbf, 0xfa, 0x0c, 0x7f, 0x24,
+	0xa0, 0x4d, 0x16, 0xdf, 0x1f, 0x9d, 0x83, 0xac, 0xb1, 0xcc, 0x14, 0x1f, 0xac, 0x56, 0x53, 0x29,
+	0x3d, 0x62, 0x31, 0xfe, 0xd8, 0x5c, 0xfd, 0xea, 0xe5, 0x32, 0x29, 0x55, 0xd1, 0xa8, 0xfc, 0xec,
+	0xbe, 0x39, 0x90, 0xa4, 0x1c, 0xea, 0x40, 0xb5, 0xab, 0xa4, 0x74, 0x15, 0x35, 0xbe, 0x7f, 0x0e,
+	0x4a, 0x3b, 0x1a, 0xc7, 0x0a, 0x24, 0xd8, 0x6a, 0xde, 0x72, 0x09, 0xba, 0xb0, 0x01, 0x99, 0x9a,
+	0xb5, 0x5c, 0x89, 0xf6, 0x35, 0x00, 0x5b, 0xcc, 0x59, 0xf6, 0xa2, 0xdd, 0x0d, 0xd8, 0xe4, 0x8c,
+	0x65, 0x72, 0x9f, 0xa9, 0xf9, 0xca, 0xc5, 0x68, 0xd7, 0x04, 0xa0, 0x31, 0x5b, 0xd9, 0x83, 0x2e,
+	0x68, 0x8a, 0x11, 0xce, 0x55, 0x82, 0xcd, 0x67, 0xce, 0x54, 0x2a, 0xad, 0xdb, 0xce, 0x34, 0x70,
+	0xfa, 0x77, 0xb5, 0xfd, 0x05, 0x37, 0xe6, 0x18, 0xf8, 0xdd, 0xf6, 0xa5, 0x1a, 0xbc, 0xa2, 0x1c,
+	0x5a, 0x40, 0x37, 0x8a, 0xdf, 0xd3, 0x86, 0x36, 0x55, 0xb1, 0x81, 0x62, 0x7a, 0x54, 0xc7, 0x10,
+	0xc5, 0x6c, 0xc4, 0xa3, 0x89, 0xc6, 0xef, 0x9d, 0xdc, 0x0b, 0xcc, 0x35, 0x71, 0x26, 0xf6, 0x3e,
+	0xfb, 0xb5, 0xb7, 0x8c, 0x89, 0x7e, 0x6f, 0x19, 0x02, 0xe6, 0xfb, 0xdb, 0xb5, 0xa3, 0x66, 0xcb,
+	0x11, 0x58, 0xcd, 0x32, 0x07, 0xeb, 0x85, 0x63, 0x7c, 0xc0, 0xce, 0x14, 0x82, 0xa1, 0x4b, 0xd5,
+	0x99, 0x55, 0x6a, 0xfe, 0xa0, 0x7d, 0x36, 0xaf, 0xf2, 0x68, 0x2d, 0x21, 0xc5, 0x77, 0xda, 0xc7,
+	0xfc, 0x7a, 0xac, 0x61, 0x6d, 0xf5, 0x2e, 0x3b, 0x3b, 0x69, 0xce, 0x31, 0xea, 0x21, 0xc6, 0xdd,
+	0xf6, 0x13, 0x3b, 0x05, 0xac, 0x02, 0x22, 0x7e, 0xb2, 0x0d, 0xde, 0x9a, 0x0b, 0xfb, 0x3c, 0x5a,
+	0x2f, 0x3f, 0x65, 0x27, 0x17, 0xa5, 0xb7, 0x86, 0x4f, 0x82, 0xf8, 0x69, 0xab, 0xc9, 0x30, 0xdf,
+	0xe4, 0x49, 0x82, 0xff, 0x60, 0xbe, 0xd6, 0x45, 0x26, 0x63, 0xff, 0x7c, 0x5d, 0x3d, 0xc9, 0x6b,
+	0xfc, 0x87, 0xf6, 0xa5, 0xbe, 0xe4, 0x38, 0xf1, 0x50, 0x88, 0xff, 0x68, 0x1e, 0xa4, 0x18, 0x28,
+	0xc6, 0xca, 0x00, 0x0f, 0x95, 0xa5, 0xc6, 0x7f, 0x3c, 0x6f, 0x9d, 0x81, 0x72, 0xcd, 0x9a, 0x0f,
+	0xda, 0x1a, 0xff, 0xc9, 0x7c, 0x7d, 0x7e, 0x95, 0x45, 0xee, 0x09, 0xfc, 0xc3, 0x81, 0x14, 0x76,
+	0x26, 0xe0, 0x2a, 0xda, 0x40, 0x8a, 0x7b, 0xe7, 0xed, 0x13, 0x36, 0x33, 0xfe, 0x2f, 0x95, 0x20,
+	0x2d, 0x56, 0x4f, 0x6d, 0x03, 0x9a, 0x68, 0x86, 0x1f, 0x9d, 0x87, 0xb3, 0xfb, 0xa6, 0x26, 0xa5,
+	0x22, 0x87, 0x8c, 0x23, 0xb8, 0xc1, 0x8f, 0xcd, 0x43, 0x30, 0xf2, 0x14, 0xb7, 0x8f, 0x8d, 0x1f,
+	0x8f, 0xcf, 0x7b, 0xb3, 0x6b, 0xf4, 0x38, 0xf8, 0x5b, 0xd5, 0xba, 0x6f, 0x6e, 0x5c, 0x36, 0xfc,
+	0xf6, 0xbc, 0x7d, 0xa4, 0xe2, 0xda, 0x3e, 0x58, 0x94, 0xc3, 0xbb, 0xba, 0xbd, 0xc1, 0x4f, 0x38,
+	0x65, 0xb9, 0x2c, 0x51, 0xce, 0x16, 0x7d, 0x71, 0x8e, 0x3f, 0xde, 0x09, 0x6a, 0xf6, 0x6a, 0xb6,
+	0xe7, 0x1b, 0x96, 0x98, 0x0d, 0xec, 0x5c, 0x4a, 0x0a, 0xfc, 0x40, 0xc7, 0x5e, 0xa2, 0x07, 0x52,
+	0x51, 0x26, 0x5e, 0x3b, 0xd6, 0x08, 0x29, 0x25, 0x7f, 0xb8, 0xde, 0x07, 0x3b, 0x41, 0x1f, 0x54,
+	0xde, 0x67, 0x59, 0x81, 0x06, 0x9c, 0x1f, 0xea, 0x90, 0x03, 0x68, 0x69, 0x2b, 0x11, 0xaa, 0x7a,
+	0xdf, 0xb9, 0x1f, 0x7c, 0xf0, 0x70, 0x27, 0xa8, 0xf5, 0x9b, 0x6c, 0x6b, 0xd0, 0x27, 0x3b, 0xc1,
+	0xa9, 0x41, 0x69, 0x61, 0xbc, 0x7f, 0xc4, 0x8e, 0x59, 0x7c, 0xa7, 0x93, 0x24, 0x72, 0xc5, 0xbe,
+	0x4b, 0x56, 0xad, 0x80, 0xc6, 0x9f, 0xea, 0x04, 0x3d, 0x47, 0x8d, 0xd8, 0x3c, 0x7d, 0xf5, 0xc9,
+	0x8d, 0x23, 0xeb, 0xa7, 0x4f, 0xac, 0x9d, 0xde, 0xc0, 0x9f, 0xee, 0x04, 0xed, 0x80, 0xcd, 0x03,
+	0xb3, 0x5a, 0x02, 0xfc, 0x77, 0x9d, 0x46, 0x3b, 0x70, 0x28, 0x68, 0x09, 0xf0, 0xdf, 0x77, 0xa0,
+	0xf5, 0xad, 0xda, 0x01, 0xcd, 0xdc, 0x5f, 0x12, 0xad, 0x16, 0x12, 0xea, 0xb6, 0x15, 0x7f, 0x2e,
+	0x7c, 0xe7, 0x82, 0x9b, 0x1e, 0xd4, 0x38, 0x08, 0x3f, 0x55, 0xe5, 0x8f, 0xef, 0x5a, 0x70, 0x6f,
+	0xee, 0x8e, 0x5e, 0x26, 0x32, 0x91, 0xe5, 0x55, 0xc9, 0x8f, 0xef, 0x5e, 0x20, 0xe7, 0x22, 0x24,
+	0x33, 0x26, 0x0a, 0xae, 0x75, 0xce, 0xf0, 0xcd, 0xdd, 0x20, 0x4d, 0x95, 0xdd, 0xbd, 0x4c, 0x53,
+	0x2a, 0x62, 0xfc, 0x1f, 0x5d, 0x5f, 0x34, 0x34, 0x09, 0x55, 0xba, 0xfe, 0x4e, 0x97, 0x2c, 0xa1,
+	0x2b, 0x67, 0x7d, 0x3b, 0xf5, 0xec, 0x80, 0xbf, 0xdb, 0xb5, 0xbd, 0xeb, 0x2f, 0xc5, 0xda, 0x5e,
+	0x11, 0x7f, 0xaf, 0x0b, 0x0a, 0x71, 0x68, 0xf7, 0xf0, 0x50, 0x62, 0xe1, 0xbf, 0xa0, 0xdc, 0xfb,
+	0x7e, 0xb7, 0xff, 0xe2, 0xc3, 0x2f, 0x3a, 0x7a, 0x6c, 0xf3, 0xda, 0x33, 0x2f, 0xd9, 0xff, 0x9b,
+	0xeb, 0x27, 0x0e, 0x0c, 0xd7, 0xd7, 0x8f, 0x1e, 0x5f, 0x8b, 0xd6, 0x4f, 0x6e, 0x5e, 0x7d, 0xec,
+	0xe4, 0xda, 0x69, 0xb3, 0xbe, 0x7e, 0x7c, 0xe3, 0xc0, 0xc6, 0x75, 0x57, 0x1f, 0x39, 0xb2, 0x7e,
+	0xfc, 0x9a, 0x03, 0xf6, 0x6f, 0x2e, 0x0f, 0xd8, 0xbf, 0xb9, 0x7c, 0xc9, 0x82, 0xfd, 0xc7, 0x0b,
+	0xff, 0x3f, 0x00, 0x00, 0xff, 0xff, 0x6a, 0x24, 0xcc, 0xde, 0x96, 0x29, 0x00, 0x00,
 }
@@ -141,6 +141,14 @@ const StatusCode_BUILD_DOCKERFILE_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_
 const StatusCode_BUILD_DOCKER_CACHE_FROM_PULL_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_CACHE_FROM_PULL_ERR)
 const StatusCode_BUILD_DOCKER_GET_DIGEST_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_GET_DIGEST_ERR)
 const StatusCode_BUILD_DOCKER_NO_SPACE_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_NO_SPACE_ERR)
+const StatusCode_BUILD_REGISTRY_SERVER_ERR = StatusCode(enums.StatusCode_BUILD_REGISTRY_SERVER_ERR)
+const StatusCode_BUILD_HOST_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_HOST_NOT_FOUND)
+const StatusCode_BUILD_REGISTRY_TLS_TIMEOUT = StatusCode(enums.StatusCode_BUILD_REGISTRY_TLS_TIMEOUT)
+const StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR = StatusCode(enums.StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR)
+const StatusCode_BUILD_INVALID_IMAGE_REFERENCE = StatusCode(enums.StatusCode_BUILD_INVALID_IMAGE_REFERENCE)
+const StatusCode_BUILD_REGISTRY_CONNECTION_RESET = StatusCode(enums.StatusCode_BUILD_REGISTRY_CONNECTION_RESET)
+const StatusCode_BUILD_COPY_SOURCE_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_COPY_SOURCE_NOT_FOUND)
+const StatusCode_BUILD_CORRUPT_LOCAL_IMAGE = StatusCode(enums.StatusCode_BUILD_CORRUPT_LOCAL_IMAGE)
 const StatusCode_BUILD_REGISTRY_GET_DIGEST_ERR = StatusCode(enums.StatusCode_BUILD_REGISTRY_GET_DIGEST_ERR)
 const StatusCode_BUILD_UNKNOWN_JIB_PLUGIN_TYPE = StatusCode(enums.StatusCode_BUILD_UNKNOWN_JIB_PLUGIN_TYPE)
 const StatusCode_BUILD_JIB_GRADLE_DEP_ERR = StatusCode(enums.StatusCode_BUILD_JIB_GRADLE_DEP_ERR)
@@ -155,6 +163,8 @@ const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_ST
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN = StatusCode(enums.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN)
+const StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR)
@@ -170,6 +180,14 @@ const StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR = StatusCode(enums.StatusCod
 const StatusCode_STATUSCHECK_KUBECTL_PID_KILLED = StatusCode(enums.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED)
 const StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR = StatusCode(enums.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR)
 const StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR)
+const StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH = StatusCode(enums.StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH)
+const StatusCode_STATUSCHECK_UNTOLERATED_TAINT = StatusCode(enums.StatusCode_STATUSCHECK_UNTOLERATED_TAINT)
+const StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED = StatusCode(enums.StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED)
+const StatusCode_STATUSCHECK_UNBOUND_PVC = StatusCode(enums.StatusCode_STATUSCHECK_UNBOUND_PVC)
+const StatusCode_STATUSCHECK_POD_EVICTED = StatusCode(enums.StatusCode_STATUSCHECK_POD_EVICTED)
+const StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY = StatusCode(enums.StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY)
+const StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT = StatusCode(enums.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT)
+const StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS = StatusCode(enums.StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS)
 const StatusCode_STATUSCHECK_POD_INITIALIZING = StatusCode(enums.StatusCode_STATUSCHECK_POD_INITIALIZING)
 const StatusCode_UNKNOWN_ERROR = StatusCode(enums.StatusCode_UNKNOWN_ERROR)
 const StatusCode_STATUSCHECK_UNKNOWN = StatusCode(enums.StatusCode_STATUSCHECK_UNKNOWN)
@@ -184,12 +202,18 @@ const StatusCode_CLEANUP_UNKNOWN = StatusCode(enums.StatusCode_CLEANUP_UNKNOWN)
 const StatusCode_INIT_UNKNOWN = StatusCode(enums.StatusCode_INIT_UNKNOWN)
 const StatusCode_BUILD_DOCKER_UNKNOWN = StatusCode(enums.StatusCode_BUILD_DOCKER_UNKNOWN)
 const StatusCode_TEST_UNKNOWN = StatusCode(enums.StatusCode_TEST_UNKNOWN)
+const StatusCode_RENDER_UNKNOWN = StatusCode(enums.StatusCode_RENDER_UNKNOWN)
 const StatusCode_SYNC_INIT_ERROR = StatusCode(enums.StatusCode_SYNC_INIT_ERROR)
+const StatusCode_SYNC_PERMISSION_DENIED = StatusCode(enums.StatusCode_SYNC_PERMISSION_DENIED)
 const StatusCode_DEVINIT_REGISTER_BUILD_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_BUILD_DEPS)
 const StatusCode_DEVINIT_REGISTER_TEST_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_TEST_DEPS)
 const StatusCode_DEVINIT_REGISTER_DEPLOY_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_DEPLOY_DEPS)
 const StatusCode_DEVINIT_REGISTER_CONFIG_DEP = StatusCode(enums.StatusCode_DEVINIT_REGISTER_CONFIG_DEP)
 const StatusCode_DEVINIT_UNSUPPORTED_V1_MANIFEST = StatusCode(enums.StatusCode_DEVINIT_UNSUPPORTED_V1_MANIFEST)
+const StatusCode_DEVINIT_WATCH_PODS_FAILED = StatusCode(enums.StatusCode_DEVINIT_WATCH_PODS_FAILED)
+const StatusCode_DEVINIT_NO_AVAILABLE_PORT = StatusCode(enums.StatusCode_DEVINIT_NO_AVAILABLE_PORT)
+const StatusCode_DEVINIT_INOTIFY_LIMIT = StatusCode(enums.StatusCode_DEVINIT_INOTIFY_LIMIT)
+const StatusCode_DEVINIT_RPC_CONNECTION_REFUSED = StatusCode(enums.StatusCode_DEVINIT_RPC_CONNECTION_REFUSED)
 const StatusCode_STATUSCHECK_USER_CANCELLED = StatusCode(enums.StatusCode_STATUSCHECK_USER_CANCELLED)
 const StatusCode_STATUSCHECK_DEADLINE_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED)
 const StatusCode_BUILD_CANCELLED = StatusCode(enums.StatusCode_BUILD_CANCELLED)
@@ -205,6 +229,10 @@ const StatusCode_INIT_CREATE_TEST_DEP_ERROR = StatusCode(enums.StatusCode_INIT_C
 const StatusCode_INIT_CACHE_ERROR = StatusCode(enums.StatusCode_INIT_CACHE_ERROR)
 const StatusCode_INIT_CREATE_WATCH_TRIGGER_ERROR = StatusCode(enums.StatusCode_INIT_CREATE_WATCH_TRIGGER_ERROR)
 const StatusCode_INIT_CREATE_ARTIFACT_DEP_ERROR = StatusCode(enums.StatusCode_INIT_CREATE_ARTIFACT_DEP_ERROR)
+const StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND = StatusCode(enums.StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND)
+const StatusCode_INIT_KIND_CLUSTER_NOT_FOUND = StatusCode(enums.StatusCode_INIT_KIND_CLUSTER_NOT_FOUND)
+const StatusCode_INIT_HOST_NOT_FOUND = StatusCode(enums.StatusCode_INIT_HOST_NOT_FOUND)
+const StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND = StatusCode(enums.StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND)
 const StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR)
 const StatusCode_DEPLOY_DEBUG_HELPER_RETRIEVE_ERR = StatusCode(enums.StatusCode_DEPLOY_DEBUG_HELPER_RETRIEVE_ERR)
 const StatusCode_DEPLOY_CLEANUP_ERR = StatusCode(enums.StatusCode_DEPLOY_CLEANUP_ERR)
@@ -229,6 +257,25 @@ const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
 const StatusCode_DEPLOY_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_DEPLOY_KPTFILE_INIT_ERR)
+const StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR = StatusCode(enums.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR)
+const StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED)
+const StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED = StatusCode(enums.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED)
+const StatusCode_DEPLOY_HELM_CHART_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND)
+const StatusCode_DEPLOY_HOST_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_HOST_NOT_FOUND)
+const StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT = StatusCode(enums.StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT)
+const StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG = StatusCode(enums.StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG)
+const StatusCode_DEPLOY_HELM_WAIT_TIMEOUT = StatusCode(enums.StatusCode_DEPLOY_HELM_WAIT_TIMEOUT)
+const StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE = StatusCode(enums.StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE)
+const StatusCode_DEPLOY_IMMUTABLE_FIELD = StatusCode(enums.StatusCode_DEPLOY_IMMUTABLE_FIELD)
+const StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN = StatusCode(enums.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN)
+const StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND)
+const StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR = StatusCode(enums.StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR)
+const StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET = StatusCode(enums.StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET)
+const StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY)
+const StatusCode_DEPLOY_REMOVED_API_VERSION = StatusCode(enums.StatusCode_DEPLOY_REMOVED_API_VERSION)
+const StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE = StatusCode(enums.StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE)
+const StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND)
+const StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR = StatusCode(enums.StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -245,6 +292,9 @@ const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST
 const StatusCode_RENDER_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INIT_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR)
+const StatusCode_RENDER_HELM_TEMPLATE_ERR = StatusCode(enums.StatusCode_RENDER_HELM_TEMPLATE_ERR)
+const StatusCode_RENDER_KUSTOMIZE_BUILD_ERR = StatusCode(enums.StatusCode_RENDER_KUSTOMIZE_BUILD_ERR)
+const StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE = StatusCode(enums.StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -261,6 +311,7 @@ const StatusCode_CONFIG_UNKNOWN_API_VERSION_ERR = StatusCode(enums.StatusCode_CO
 const StatusCode_CONFIG_UNKNOWN_VALIDATOR = StatusCode(enums.StatusCode_CONFIG_UNKNOWN_VALIDATOR)
 const StatusCode_CONFIG_UNKNOWN_TRANSFORMER = StatusCode(enums.StatusCode_CONFIG_UNKNOWN_TRANSFORMER)
 const StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR = StatusCode(enums.StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR)
+const StatusCode_CONFIG_FIELD_TYPE_ERR = StatusCode(enums.StatusCode_CONFIG_FIELD_TYPE_ERR)
 const StatusCode_INSPECT_UNKNOWN_ERR = StatusCode(enums.StatusCode_INSPECT_UNKNOWN_ERR)
 const StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR = StatusCode(enums.StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR)
 const StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR = StatusCode(enums.StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR)
@@ -290,6 +341,13 @@ const SuggestionCode_CHECK_DOCKER_NETWORK_CONTAINER_RUNNING = SuggestionCode(enu
 const SuggestionCode_FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME = SuggestionCode(enums.SuggestionCode_FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME)
 const SuggestionCode_RUN_DOCKER_PRUNE = SuggestionCode(enums.SuggestionCode_RUN_DOCKER_PRUNE)
 const SuggestionCode_SET_CLEANUP_FLAG = SuggestionCode(enums.SuggestionCode_SET_CLEANUP_FLAG)
+const SuggestionCode_GRANT_GCP_REGISTRY_ROLE = SuggestionCode(enums.SuggestionCode_GRANT_GCP_REGISTRY_ROLE)
+const SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY = SuggestionCode(enums.SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY)
+const SuggestionCode_RETRY_REGISTRY_PUSH = SuggestionCode(enums.SuggestionCode_RETRY_REGISTRY_PUSH)
+const SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION = SuggestionCode(enums.SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION)
+const SuggestionCode_FIX_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_FIX_IMAGE_NAME)
+const SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE = SuggestionCode(enums.SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE)
+const SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE = SuggestionCode(enums.SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE)
 const SuggestionCode_CHECK_CLUSTER_CONNECTION = SuggestionCode(enums.SuggestionCode_CHECK_CLUSTER_CONNECTION)
 const SuggestionCode_CHECK_MINIKUBE_STATUS = SuggestionCode(enums.SuggestionCode_CHECK_MINIKUBE_STATUS)
 const SuggestionCode_INSTALL_HELM = SuggestionCode(enums.SuggestionCode_INSTALL_HELM)
@@ -297,10 +355,26 @@ const SuggestionCode_UPGRADE_HELM = SuggestionCode(enums.SuggestionCode_UPGRADE_
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES)
 const SuggestionCode_UPGRADE_HELM32 = SuggestionCode(enums.SuggestionCode_UPGRADE_HELM32)
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE)
+const SuggestionCode_FIX_INVALID_MANIFEST_FIELD = SuggestionCode(enums.SuggestionCode_FIX_INVALID_MANIFEST_FIELD)
+const SuggestionCode_CHECK_ADMISSION_POLICY = SuggestionCode(enums.SuggestionCode_CHECK_ADMISSION_POLICY)
+const SuggestionCode_ADD_HELM_REPO = SuggestionCode(enums.SuggestionCode_ADD_HELM_REPO)
+const SuggestionCode_FIX_HELM_CHART_VERSION = SuggestionCode(enums.SuggestionCode_FIX_HELM_CHART_VERSION)
+const SuggestionCode_USE_SERVER_SIDE_APPLY = SuggestionCode(enums.SuggestionCode_USE_SERVER_SIDE_APPLY)
+const SuggestionCode_INCREASE_HELM_TIMEOUT = SuggestionCode(enums.SuggestionCode_INCREASE_HELM_TIMEOUT)
+const SuggestionCode_RECREATE_RESOURCE = SuggestionCode(enums.SuggestionCode_RECREATE_RESOURCE)
+const SuggestionCode_LOGIN_TO_CLUSTER = SuggestionCode(enums.SuggestionCode_LOGIN_TO_CLUSTER)
+const SuggestionCode_CREATE_REFERENCED_RESOURCE = SuggestionCode(enums.SuggestionCode_CREATE_REFERENCED_RESOURCE)
+const SuggestionCode_FIX_HELM_VALUES = SuggestionCode(enums.SuggestionCode_FIX_HELM_VALUES)
+const SuggestionCode_UPDATE_API_VERSION = SuggestionCode(enums.SuggestionCode_UPDATE_API_VERSION)
+const SuggestionCode_CHECK_IMAGE_SUBSTITUTION = SuggestionCode(enums.SuggestionCode_CHECK_IMAGE_SUBSTITUTION)
 const SuggestionCode_INSTALL_KUBECTL = SuggestionCode(enums.SuggestionCode_INSTALL_KUBECTL)
+const SuggestionCode_FIX_MANIFEST_YAML = SuggestionCode(enums.SuggestionCode_FIX_MANIFEST_YAML)
 const SuggestionCode_CHECK_CONTAINER_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_LOGS)
 const SuggestionCode_CHECK_READINESS_PROBE = SuggestionCode(enums.SuggestionCode_CHECK_READINESS_PROBE)
 const SuggestionCode_CHECK_CONTAINER_IMAGE = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_IMAGE)
+const SuggestionCode_VERIFY_IMAGE_PUSHED = SuggestionCode(enums.SuggestionCode_VERIFY_IMAGE_PUSHED)
+const SuggestionCode_BUILD_MULTI_ARCH_IMAGE = SuggestionCode(enums.SuggestionCode_BUILD_MULTI_ARCH_IMAGE)
+const SuggestionCode_RUN_AS_NON_ROOT_USER = SuggestionCode(enums.SuggestionCode_RUN_AS_NON_ROOT_USER)
 const SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE)
 const SuggestionCode_ADDRESS_NODE_DISK_PRESSURE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_DISK_PRESSURE)
 const SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE)
@@ -310,12 +384,32 @@ const SuggestionCode_ADDRESS_NODE_UNREACHABLE = SuggestionCode(enums.SuggestionC
 const SuggestionCode_ADDRESS_NODE_NOT_READY = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_NOT_READY)
 const SuggestionCode_ADDRESS_FAILED_SCHEDULING = SuggestionCode(enums.SuggestionCode_ADDRESS_FAILED_SCHEDULING)
 const SuggestionCode_CHECK_HOST_CONNECTION = SuggestionCode(enums.SuggestionCode_CHECK_HOST_CONNECTION)
+const SuggestionCode_FIX_NODE_SELECTOR = SuggestionCode(enums.SuggestionCode_FIX_NODE_SELECTOR)
+const SuggestionCode_ADD_TOLERATIONS = SuggestionCode(enums.SuggestionCode_ADD_TOLERATIONS)
+const SuggestionCode_REFRESH_CLUSTER_CREDENTIALS = SuggestionCode(enums.SuggestionCode_REFRESH_CLUSTER_CREDENTIALS)
+const SuggestionCode_CHECK_HOST_RESOLUTION = SuggestionCode(enums.SuggestionCode_CHECK_HOST_RESOLUTION)
+const SuggestionCode_CHECK_REGISTRY_CONNECTIVITY = SuggestionCode(enums.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY)
+const SuggestionCode_CHECK_PVC_PROVISIONING = SuggestionCode(enums.SuggestionCode_CHECK_PVC_PROVISIONING)
+const SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE = SuggestionCode(enums.SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE)
+const SuggestionCode_CONFIGURE_CLUSTER_CA = SuggestionCode(enums.SuggestionCode_CONFIGURE_CLUSTER_CA)
+const SuggestionCode_CHECK_JOB_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_JOB_LOGS)
+const SuggestionCode_CHECK_SERVICE_ENDPOINTS = SuggestionCode(enums.SuggestionCode_CHECK_SERVICE_ENDPOINTS)
 const SuggestionCode_START_MINIKUBE = SuggestionCode(enums.SuggestionCode_START_MINIKUBE)
 const SuggestionCode_UNPAUSE_MINIKUBE = SuggestionCode(enums.SuggestionCode_UNPAUSE_MINIKUBE)
+const SuggestionCode_CREATE_KIND_CLUSTER = SuggestionCode(enums.SuggestionCode_CREATE_KIND_CLUSTER)
 const SuggestionCode_RUN_DOCKER_PULL = SuggestionCode(enums.SuggestionCode_RUN_DOCKER_PULL)
+const SuggestionCode_CHECK_POD_WATCH_PERMISSIONS = SuggestionCode(enums.SuggestionCode_CHECK_POD_WATCH_PERMISSIONS)
+const SuggestionCode_CREATE_COMPOSE_NETWORK = SuggestionCode(enums.SuggestionCode_CREATE_COMPOSE_NETWORK)
+const SuggestionCode_FREE_LOCAL_PORTS = SuggestionCode(enums.SuggestionCode_FREE_LOCAL_PORTS)
+const SuggestionCode_RAISE_INOTIFY_LIMITS = SuggestionCode(enums.SuggestionCode_RAISE_INOTIFY_LIMITS)
+const SuggestionCode_CHECK_RPC_PORT = SuggestionCode(enums.SuggestionCode_CHECK_RPC_PORT)
+const SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS = SuggestionCode(enums.SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS)
 const SuggestionCode_SET_RENDER_FLAG_OFFLINE_FALSE = SuggestionCode(enums.SuggestionCode_SET_RENDER_FLAG_OFFLINE_FALSE)
 const SuggestionCode_KPTFILE_MANUAL_INIT = SuggestionCode(enums.SuggestionCode_KPTFILE_MANUAL_INIT)
 const SuggestionCode_KPTFILE_CHECK_YAML = SuggestionCode(enums.SuggestionCode_KPTFILE_CHECK_YAML)
+const SuggestionCode_FIX_HELM_TEMPLATE = SuggestionCode(enums.SuggestionCode_FIX_HELM_TEMPLATE)
+const SuggestionCode_FIX_KUSTOMIZATION = SuggestionCode(enums.SuggestionCode_FIX_KUSTOMIZATION)
+const SuggestionCode_DISABLE_CLIENT_VALIDATION = SuggestionCode(enums.SuggestionCode_DISABLE_CLIENT_VALIDATION)
 const SuggestionCode_CONFIG_CHECK_FILE_PATH = SuggestionCode(enums.SuggestionCode_CONFIG_CHECK_FILE_PATH)
 const SuggestionCode_CONFIG_CHECK_DEPENDENCY_DEFINITION = SuggestionCode(enums.SuggestionCode_CONFIG_CHECK_DEPENDENCY_DEFINITION)
 const SuggestionCode_CONFIG_CHANGE_NAMES = SuggestionCode(enums.SuggestionCode_CONFIG_CHANGE_NAMES)
@@ -327,6 +421,7 @@ const SuggestionCode_CONFIG_FIX_API_VERSION = SuggestionCode(enums.SuggestionCod
 const SuggestionCode_CONFIG_ALLOWLIST_VALIDATORS = SuggestionCode(enums.SuggestionCode_CONFIG_ALLOWLIST_VALIDATORS)
 const SuggestionCode_CONFIG_ALLOWLIST_transformers = SuggestionCode(enums.SuggestionCode_CONFIG_ALLOWLIST_transformers)
 const SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE = SuggestionCode(enums.SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE)
+const SuggestionCode_CONFIG_FIX_FIELD_TYPE = SuggestionCode(enums.SuggestionCode_CONFIG_FIX_FIELD_TYPE)
 const SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE = SuggestionCode(enums.SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE)
 const SuggestionCode_INSPECT_USE_ADD_BUILD_ENV = SuggestionCode(enums.SuggestionCode_INSPECT_USE_ADD_BUILD_ENV)
 const SuggestionCode_INSPECT_CHECK_INPUT_PROFILE = SuggestionCode(enums.SuggestionCode_INSPECT_CHECK_INPUT_PROFILE)
@@ -2378,6 +2473,336 @@ func (m *TriggerRequest) GetState() *TriggerState {
 	return nil
 }
 
+// PortForwardRequest enables or disables the port forward on a local port.
+type PortForwardRequest struct {
+	LocalPort            int32         `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	State                *TriggerState `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PortForwardRequest) Reset()         { *m = PortForwardRequest{} }
+func (m *PortForwardRequest) String() string { return proto.CompactTextString(m) }
+func (*PortForwardRequest) ProtoMessage()    {}
+func (*PortForwardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{29}
+}
+
+func (m *PortForwardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortForwardRequest.Unmarshal(m, b)
+}
+func (m *PortForwardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortForwardRequest.Marshal(b, m, deterministic)
+}
+func (m *PortForwardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortForwardRequest.Merge(m, src)
+}
+func (m *PortForwardRequest) XXX_Size() int {
+	return xxx_messageInfo_PortForwardRequest.Size(m)
+}
+func (m *PortForwardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortForwardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortForwardRequest proto.InternalMessageInfo
+
+func (m *PortForwardRequest) GetLocalPort() int32 {
+	if m != nil {
+		return m.LocalPort
+	}
+	return 0
+}
+
+func (m *PortForwardRequest) GetState() *TriggerState {
+	if m != nil {
+		return m.State
+	}
+	return nil
+}
+
+// PodPortForwardRequest asks for a container port of a pod to be forwarded, when pods are forwarded on demand with `--port-forward-on-demand`.
+// Instead of a pod, it can target a replica of a workload, picked by the last octet of its IP or by its index among the Ready replicas.
+// The port is then forwarded from the replica picked by the same criterion when replicas are replaced.
+type PodPortForwardRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PodName              string   `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	Port                 int32    `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	Owner                string   `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	PodIPSuffix          int32    `protobuf:"varint,5,opt,name=podIPSuffix,proto3" json:"podIPSuffix,omitempty"`
+	ReplicaIndex         int32    `protobuf:"varint,6,opt,name=replicaIndex,proto3" json:"replicaIndex,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodPortForwardRequest) Reset()         { *m = PodPortForwardRequest{} }
+func (m *PodPortForwardRequest) String() string { return proto.CompactTextString(m) }
+func (*PodPortForwardRequest) ProtoMessage()    {}
+func (*PodPortForwardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{30}
+}
+
+func (m *PodPortForwardRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodPortForwardRequest.Unmarshal(m, b)
+}
+func (m *PodPortForwardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodPortForwardRequest.Marshal(b, m, deterministic)
+}
+func (m *PodPortForwardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodPortForwardRequest.Merge(m, src)
+}
+func (m *PodPortForwardRequest) XXX_Size() int {
+	return xxx_messageInfo_PodPortForwardRequest.Size(m)
+}
+func (m *PodPortForwardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodPortForwardRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodPortForwardRequest proto.InternalMessageInfo
+
+func (m *PodPortForwardRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *PodPortForwardRequest) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *PodPortForwardRequest) GetPort() int32 {
+	if m != nil {
+		return m.Port
+	}
+	return 0
+}
+
+func (m *PodPortForwardRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PodPortForwardRequest) GetPodIPSuffix() int32 {
+	if m != nil {
+		return m.PodIPSuffix
+	}
+	return 0
+}
+
+func (m *PodPortForwardRequest) GetReplicaIndex() int32 {
+	if m != nil {
+		return m.ReplicaIndex
+	}
+	return 0
+}
+
+// PodPortForwardResponse describes the port forward of a pod requested on demand.
+type PodPortForwardResponse struct {
+	LocalPort            int32    `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PodPortForwardResponse) Reset()         { *m = PodPortForwardResponse{} }
+func (m *PodPortForwardResponse) String() string { return proto.CompactTextString(m) }
+func (*PodPortForwardResponse) ProtoMessage()    {}
+func (*PodPortForwardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{31}
+}
+
+func (m *PodPortForwardResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PodPortForwardResponse.Unmarshal(m, b)
+}
+func (m *PodPortForwardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PodPortForwardResponse.Marshal(b, m, deterministic)
+}
+func (m *PodPortForwardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PodPortForwardResponse.Merge(m, src)
+}
+func (m *PodPortForwardResponse) XXX_Size() int {
+	return xxx_messageInfo_PodPortForwardResponse.Size(m)
+}
+func (m *PodPortForwardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PodPortForwardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PodPortForwardResponse proto.InternalMessageInfo
+
+func (m *PodPortForwardResponse) GetLocalPort() int32 {
+	if m != nil {
+		return m.LocalPort
+	}
+	return 0
+}
+
+// ActivePortForward describes a port forward currently managed by Skaffold.
+type ActivePortForward struct {
+	LocalPort            int32        `protobuf:"varint,1,opt,name=localPort,proto3" json:"localPort,omitempty"`
+	PodName              string       `protobuf:"bytes,2,opt,name=podName,proto3" json:"podName,omitempty"`
+	ContainerName        string       `protobuf:"bytes,3,opt,name=containerName,proto3" json:"containerName,omitempty"`
+	Namespace            string       `protobuf:"bytes,4,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	PortName             string       `protobuf:"bytes,5,opt,name=portName,proto3" json:"portName,omitempty"`
+	ResourceType         string       `protobuf:"bytes,6,opt,name=resourceType,proto3" json:"resourceType,omitempty"`
+	ResourceName         string       `protobuf:"bytes,7,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
+	Address              string       `protobuf:"bytes,8,opt,name=address,proto3" json:"address,omitempty"`
+	TargetPort           *IntOrString `protobuf:"bytes,9,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	State                string       `protobuf:"bytes,10,opt,name=state,proto3" json:"state,omitempty"`
+	UptimeSeconds        int64        `protobuf:"varint,11,opt,name=uptimeSeconds,proto3" json:"uptimeSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ActivePortForward) Reset()         { *m = ActivePortForward{} }
+func (m *ActivePortForward) String() string { return proto.CompactTextString(m) }
+func (*ActivePortForward) ProtoMessage()    {}
+func (*ActivePortForward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{32}
+}
+
+func (m *ActivePortForward) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivePortForward.Unmarshal(m, b)
+}
+func (m *ActivePortForward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivePortForward.Marshal(b, m, deterministic)
+}
+func (m *ActivePortForward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivePortForward.Merge(m, src)
+}
+func (m *ActivePortForward) XXX_Size() int {
+	return xxx_messageInfo_ActivePortForward.Size(m)
+}
+func (m *ActivePortForward) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivePortForward.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivePortForward proto.InternalMessageInfo
+
+func (m *ActivePortForward) GetLocalPort() int32 {
+	if m != nil {
+		return m.LocalPort
+	}
+	return 0
+}
+
+func (m *ActivePortForward) GetPodName() string {
+	if m != nil {
+		return m.PodName
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetContainerName() string {
+	if m != nil {
+		return m.ContainerName
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetPortName() string {
+	if m != nil {
+		return m.PortName
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetResourceType() string {
+	if m != nil {
+		return m.ResourceType
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetResourceName() string {
+	if m != nil {
+		return m.ResourceName
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetTargetPort() *IntOrString {
+	if m != nil {
+		return m.TargetPort
+	}
+	return nil
+}
+
+func (m *ActivePortForward) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ActivePortForward) GetUptimeSeconds() int64 {
+	if m != nil {
+		return m.UptimeSeconds
+	}
+	return 0
+}
+
+// ActivePortForwards lists the port forwards currently managed by Skaffold.
+type ActivePortForwards struct {
+	PortForwards         []*ActivePortForward `protobuf:"bytes,1,rep,name=portForwards,proto3" json:"portForwards,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ActivePortForwards) Reset()         { *m = ActivePortForwards{} }
+func (m *ActivePortForwards) String() string { return proto.CompactTextString(m) }
+func (*ActivePortForwards) ProtoMessage()    {}
+func (*ActivePortForwards) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9ef8072bea85606e, []int{33}
+}
+
+func (m *ActivePortForwards) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ActivePortForwards.Unmarshal(m, b)
+}
+func (m *ActivePortForwards) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ActivePortForwards.Marshal(b, m, deterministic)
+}
+func (m *ActivePortForwards) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActivePortForwards.Merge(m, src)
+}
+func (m *ActivePortForwards) XXX_Size() int {
+	return xxx_messageInfo_ActivePortForwards.Size(m)
+}
+func (m *ActivePortForwards) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActivePortForwards.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActivePortForwards proto.InternalMessageInfo
+
+func (m *ActivePortForwards) GetPortForwards() []*ActivePortForward {
+	if m != nil {
+		return m.PortForwards
+	}
+	return nil
+}
+
 // TriggerState represents trigger state for a given phase.
 type TriggerState struct {
 	// Types that are valid to be assigned to Val:
@@ -2392,7 +2817,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{29}
+	return fileDescriptor_9ef8072bea85606e, []int{34}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2458,7 +2883,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{30}
+	return fileDescriptor_9ef8072bea85606e, []int{35}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2513,7 +2938,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{31}
+	return fileDescriptor_9ef8072bea85606e, []int{36}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2562,7 +2987,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9ef8072bea85606e, []int{32}
+	return fileDescriptor_9ef8072bea85606e, []int{37}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -2643,6 +3068,11 @@ func init() {
 	proto.RegisterType((*LogEntry)(nil), "proto.LogEntry")
 	proto.RegisterType((*UserIntentRequest)(nil), "proto.UserIntentRequest")
 	proto.RegisterType((*TriggerRequest)(nil), "proto.TriggerRequest")
+	proto.RegisterType((*PortForwardRequest)(nil), "proto.PortForwardRequest")
+	proto.RegisterType((*PodPortForwardRequest)(nil), "proto.PodPortForwardRequest")
+	proto.RegisterType((*PodPortForwardResponse)(nil), "proto.PodPortForwardResponse")
+	proto.RegisterType((*ActivePortForward)(nil), "proto.ActivePortForward")
+	proto.RegisterType((*ActivePortForwards)(nil), "proto.ActivePortForwards")
 	proto.RegisterType((*TriggerState)(nil), "proto.TriggerState")
 	proto.RegisterType((*Intent)(nil), "proto.Intent")
 	proto.RegisterType((*Suggestion)(nil), "proto.Suggestion")
@@ -2652,137 +3082,154 @@ func init() {
 func init() { proto.RegisterFile("v1/skaffold.proto", fileDescriptor_9ef8072bea85606e) }
 
 var fileDescriptor_9ef8072bea85606e = []byte{
-	// 2066 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4f, 0x73, 0x1b, 0x49,
-	0x15, 0xf7, 0x48, 0x1a, 0x49, 0xf3, 0x24, 0xd9, 0x72, 0x27, 0xb6, 0xc5, 0x24, 0x24, 0x66, 0x2a,
-	0x1b, 0x42, 0x76, 0x57, 0x8a, 0x9d, 0x2d, 0x58, 0x4c, 0x02, 0x95, 0xd8, 0xde, 0x38, 0x4b, 0x48,
-	0x42, 0xcb, 0x50, 0x14, 0x05, 0x95, 0x1a, 0x4b, 0xed, 0xd9, 0x29, 0x4b, 0x33, 0x62, 0x66, 0xe4,
-	0x45, 0x17, 0x0a, 0xf8, 0x00, 0x54, 0x6d, 0xf1, 0x1d, 0xf8, 0x0e, 0x1c, 0x39, 0xec, 0x27, 0xd8,
-	0x13, 0x14, 0x47, 0x28, 0x8e, 0x5c, 0xf8, 0x00, 0x54, 0xff, 0x9b, 0xe9, 0x96, 0x66, 0x2c, 0x3b,
-	0x29, 0x8a, 0x4b, 0xa2, 0x7e, 0xfd, 0x7b, 0x7f, 0xfb, 0xf5, 0x7b, 0x6f, 0xda, 0xb0, 0x7e, 0xbe,
-	0xd3, 0x8b, 0xcf, 0xdc, 0xd3, 0xd3, 0x70, 0x34, 0xec, 0x4e, 0xa2, 0x30, 0x09, 0x91, 0xc9, 0xfe,
-	0xb3, 0x6f, 0x7a, 0x61, 0xe8, 0x8d, 0x48, 0xcf, 0x9d, 0xf8, 0x3d, 0x37, 0x08, 0xc2, 0xc4, 0x4d,
-	0xfc, 0x30, 0x88, 0x39, 0xc8, 0xbe, 0x2d, 0x76, 0xd9, 0xea, 0x64, 0x7a, 0xda, 0x4b, 0xfc, 0x31,
-	0x89, 0x13, 0x77, 0x3c, 0x11, 0x80, 0x1b, 0xf3, 0x00, 0x32, 0x9e, 0x24, 0x33, 0xb1, 0xb9, 0x4e,
-	0x82, 0xe9, 0x38, 0xee, 0xb1, 0x7f, 0x39, 0xc9, 0x79, 0x08, 0xad, 0x7e, 0xe2, 0x26, 0x04, 0x93,
-	0x78, 0x12, 0x06, 0x31, 0x41, 0x0e, 0x98, 0x31, 0x25, 0x74, 0x8c, 0x6d, 0xe3, 0x5e, 0x63, 0xb7,
-	0xc9, 0x71, 0x5d, 0x0e, 0xe2, 0x5b, 0xce, 0x4d, 0xa8, 0xa7, 0xf8, 0x36, 0x94, 0xc7, 0xb1, 0xc7,
-	0xd0, 0x16, 0xa6, 0x3f, 0x9d, 0xaf, 0x43, 0x0d, 0x93, 0x5f, 0x4d, 0x49, 0x9c, 0x20, 0x04, 0x95,
-	0xc0, 0x1d, 0x13, 0xb1, 0xcb, 0x7e, 0x3b, 0x5f, 0x56, 0xc0, 0x64, 0xd2, 0xd0, 0x0e, 0xc0, 0xc9,
-	0xd4, 0x1f, 0x0d, 0xfb, 0x8a, 0xbe, 0x75, 0xa1, 0xef, 0x69, 0xba, 0x81, 0x15, 0x10, 0xfa, 0x08,
-	0x1a, 0x43, 0x32, 0x19, 0x85, 0x33, 0xce, 0x53, 0x62, 0x3c, 0x48, 0xf0, 0x1c, 0x64, 0x3b, 0x58,
-	0x85, 0xa1, 0x23, 0x58, 0x3d, 0x0d, 0xa3, 0xcf, 0xdd, 0x68, 0x48, 0x86, 0xaf, 0xc3, 0x28, 0x89,
-	0x3b, 0x95, 0xed, 0xf2, 0xbd, 0xc6, 0xee, 0xb6, 0xea, 0x5c, 0xf7, 0x13, 0x0d, 0x72, 0x18, 0x24,
-	0xd1, 0x0c, 0xcf, 0xf1, 0xa1, 0x7d, 0x68, 0xd3, 0x10, 0x4c, 0xe3, 0xfd, 0xcf, 0xc8, 0xe0, 0x8c,
-	0x1b, 0x61, 0x32, 0x23, 0xb6, 0x14, 0x59, 0xea, 0x36, 0x5e, 0x60, 0x40, 0x7b, 0xd0, 0x3a, 0xf5,
-	0x47, 0xa4, 0x3f, 0x0b, 0x06, 0x5c, 0x42, 0x95, 0x49, 0xb8, 0x2e, 0x24, 0x7c, 0xa2, 0xee, 0x61,
-	0x1d, 0x8a, 0x5e, 0xc3, 0xb5, 0x21, 0x39, 0x99, 0x7a, 0x9e, 0x1f, 0x78, 0xfb, 0x61, 0x90, 0xb8,
-	0x7e, 0x40, 0xa2, 0xb8, 0x53, 0x63, 0xfe, 0xdc, 0x4a, 0x03, 0x31, 0x8f, 0x38, 0x3c, 0x27, 0x41,
-	0x82, 0xf3, 0x58, 0xd1, 0xfb, 0x50, 0x1f, 0x93, 0xc4, 0x1d, 0xba, 0x89, 0xdb, 0xa9, 0x33, 0x43,
-	0xd6, 0x84, 0x98, 0x1f, 0x09, 0x32, 0x4e, 0x01, 0xa8, 0x0b, 0x56, 0x42, 0xe2, 0x84, 0x9b, 0x6d,
-	0x31, 0x74, 0x5b, 0xa0, 0x8f, 0x25, 0x1d, 0x67, 0x10, 0xbb, 0x0f, 0xd7, 0x72, 0xc2, 0x4a, 0x93,
-	0xe6, 0x8c, 0xcc, 0xd8, 0x91, 0x9b, 0x98, 0xfe, 0x44, 0x77, 0xc1, 0x3c, 0x77, 0x47, 0x53, 0x79,
-	0xa4, 0x52, 0x28, 0xe5, 0xe1, 0xb6, 0xf3, 0xed, 0xbd, 0xd2, 0xc7, 0xc6, 0xa7, 0x95, 0x7a, 0xb9,
-	0x5d, 0x71, 0xfe, 0x50, 0x82, 0xba, 0xb4, 0x10, 0xdd, 0x07, 0x93, 0x65, 0x89, 0xc8, 0xa2, 0xeb,
-	0x6a, 0x16, 0xa5, 0x6e, 0x70, 0x08, 0xfa, 0x10, 0xaa, 0x3c, 0x39, 0x84, 0xae, 0x0d, 0x2d, 0x7d,
-	0x52, 0xb4, 0x00, 0xa1, 0x6f, 0x42, 0x85, 0xfa, 0xd3, 0x29, 0x33, 0xf0, 0x35, 0xc5, 0xdb, 0x14,
-	0xca, 0x00, 0xe8, 0x07, 0x00, 0xee, 0x70, 0xe8, 0xd3, 0xeb, 0xea, 0x8e, 0x3a, 0x03, 0x76, 0x22,
-	0xb7, 0xe7, 0x42, 0xd9, 0x7d, 0x92, 0x22, 0x78, 0x82, 0x29, 0x2c, 0xf6, 0x63, 0x58, 0x9b, 0xdb,
-	0x56, 0x03, 0x65, 0xf1, 0x40, 0x5d, 0x57, 0x03, 0x65, 0x29, 0x61, 0x71, 0x7e, 0x57, 0x86, 0x96,
-	0xe6, 0x30, 0xfa, 0x00, 0xd6, 0x83, 0xe9, 0xf8, 0x84, 0x44, 0xaf, 0x4e, 0x9f, 0x44, 0x89, 0x7f,
-	0xea, 0x0e, 0x92, 0x58, 0x04, 0x7d, 0x71, 0x03, 0x3d, 0x86, 0x3a, 0x0b, 0x10, 0xcd, 0xa7, 0x12,
-	0xb3, 0xfe, 0x1b, 0x79, 0x61, 0xec, 0x3e, 0x1f, 0xbb, 0x1e, 0x79, 0xca, 0x91, 0x38, 0x65, 0x41,
-	0xf7, 0xa1, 0x92, 0xcc, 0x26, 0x84, 0xc5, 0x69, 0x75, 0x77, 0x53, 0xb0, 0xf2, 0x5a, 0xc3, 0xd0,
-	0xc7, 0xb3, 0x09, 0xc1, 0x0c, 0x83, 0x0e, 0x72, 0x42, 0x75, 0x27, 0x57, 0xd9, 0x45, 0xf1, 0xc2,
-	0xd0, 0x54, 0x6d, 0x41, 0x1f, 0x08, 0x0b, 0x0c, 0x66, 0x41, 0x67, 0xd1, 0x02, 0x12, 0x29, 0x36,
-	0x5c, 0x07, 0x73, 0x10, 0x4e, 0x83, 0x84, 0x05, 0xd2, 0xc4, 0x7c, 0xf1, 0xae, 0x67, 0xf0, 0x85,
-	0x01, 0x4d, 0x35, 0x35, 0xd0, 0x47, 0x50, 0xa3, 0x6b, 0x1a, 0x53, 0x83, 0xb9, 0x69, 0xe7, 0x24,
-	0x50, 0x97, 0x43, 0xb0, 0x84, 0xda, 0x3f, 0x84, 0x2a, 0xff, 0x89, 0xde, 0xd7, 0x7c, 0xda, 0xd2,
-	0x7c, 0xe2, 0x90, 0x65, 0x2e, 0x39, 0x5f, 0x19, 0xb0, 0xaa, 0xe7, 0x36, 0x7a, 0x04, 0x16, 0xcf,
-	0xee, 0xcc, 0xae, 0x5b, 0xb9, 0xb7, 0x40, 0x2c, 0x49, 0x84, 0x33, 0x06, 0xb4, 0x0b, 0xb5, 0xc1,
-	0x68, 0x4a, 0x75, 0x33, 0x45, 0xf3, 0xa1, 0xde, 0xe7, 0x7b, 0xcc, 0x2e, 0x09, 0xb4, 0x5f, 0x41,
-	0x5d, 0x8a, 0x42, 0x1f, 0x6a, 0x3e, 0x7d, 0x4d, 0x63, 0x96, 0xa0, 0xa5, 0x5e, 0xfd, 0xd3, 0x00,
-	0xc8, 0x9a, 0x04, 0xfa, 0x3e, 0x58, 0xae, 0x92, 0xe2, 0x6a, 0x75, 0xcf, 0x50, 0xdd, 0x34, 0xd9,
-	0x79, 0x32, 0x65, 0x2c, 0x68, 0x1b, 0x1a, 0xee, 0x34, 0x09, 0x8f, 0x23, 0xdf, 0xf3, 0x84, 0x5f,
-	0x75, 0xac, 0x92, 0xd0, 0x77, 0x00, 0x44, 0x25, 0x0f, 0x87, 0x32, 0xcb, 0xf5, 0xf3, 0xe8, 0xa7,
-	0xdb, 0x58, 0x81, 0xda, 0x8f, 0x60, 0x55, 0xd7, 0x7b, 0xa5, 0x8c, 0xfa, 0x05, 0x58, 0x69, 0x65,
-	0x45, 0x9b, 0x50, 0xe5, 0x82, 0x05, 0xaf, 0x58, 0xcd, 0xd9, 0x56, 0xba, 0xb4, 0x6d, 0xce, 0x6f,
-	0x0d, 0x68, 0x28, 0x6d, 0xb3, 0x50, 0xc1, 0xff, 0x2e, 0x3c, 0xce, 0xbf, 0x0c, 0x68, 0xcf, 0x37,
-	0xcd, 0x42, 0x3b, 0x0e, 0xc0, 0x8a, 0x48, 0x1c, 0x4e, 0xa3, 0x01, 0x91, 0x45, 0xea, 0x6e, 0x41,
-	0xe3, 0xed, 0x62, 0x09, 0x14, 0x87, 0x9d, 0x32, 0xbe, 0xd3, 0x51, 0xea, 0x52, 0xaf, 0x74, 0x94,
-	0xcf, 0xa1, 0xa5, 0xf5, 0xf6, 0xb7, 0x8f, 0xb6, 0xf3, 0x37, 0x13, 0x4c, 0xd6, 0x17, 0xd1, 0x03,
-	0xb0, 0x68, 0x77, 0x66, 0x0b, 0xd1, 0xfd, 0xda, 0x4a, 0xd3, 0x61, 0xf4, 0xa3, 0x15, 0x9c, 0x81,
-	0xd0, 0x43, 0x31, 0x76, 0x71, 0x96, 0xd2, 0xe2, 0xd8, 0x25, 0x79, 0x14, 0x18, 0xfa, 0xb6, 0x1c,
-	0xbc, 0x38, 0x57, 0x39, 0x67, 0xf0, 0x92, 0x6c, 0x2a, 0x90, 0x9a, 0x37, 0x91, 0x3d, 0xbc, 0x53,
-	0xc9, 0xef, 0xed, 0xd4, 0xbc, 0x14, 0x84, 0x0e, 0xb5, 0x11, 0x8b, 0x33, 0x16, 0x8e, 0x58, 0x92,
-	0x7f, 0x81, 0x05, 0xfd, 0x12, 0x3a, 0xf2, 0xc0, 0xe7, 0xf1, 0x62, 0xde, 0x92, 0xbd, 0x19, 0x17,
-	0xc0, 0x8e, 0x56, 0x70, 0xa1, 0x08, 0xf4, 0x28, 0x9b, 0xe1, 0xb8, 0xcc, 0x5a, 0xee, 0x0c, 0x27,
-	0x05, 0xe9, 0x60, 0xf4, 0x73, 0xd8, 0x1a, 0xe6, 0xcf, 0x68, 0x62, 0x04, 0x5b, 0x32, 0xc9, 0x1d,
-	0xad, 0xe0, 0x22, 0x01, 0xe8, 0xbb, 0xd0, 0x1c, 0x92, 0xf3, 0x17, 0x61, 0x38, 0xe1, 0x02, 0x2d,
-	0x6d, 0x6e, 0x39, 0x50, 0xb6, 0x8e, 0x56, 0xb0, 0x06, 0xa5, 0xa1, 0x4f, 0x48, 0x34, 0xf6, 0x03,
-	0xf6, 0xcd, 0xc1, 0xd9, 0x41, 0x0b, 0xfd, 0xf1, 0xdc, 0x36, 0x0d, 0xfd, 0x3c, 0x0b, 0x3d, 0x73,
-	0x5a, 0xb2, 0x38, 0x7f, 0x63, 0x61, 0x48, 0x4c, 0xcf, 0x3c, 0x5d, 0x3c, 0x6d, 0x02, 0x10, 0xfa,
-	0xe3, 0x0d, 0x2d, 0xf8, 0x0e, 0x86, 0xf6, 0xbc, 0x9e, 0xc2, 0xab, 0x72, 0x17, 0xca, 0x24, 0x8a,
-	0x44, 0x16, 0xcb, 0xe8, 0x3f, 0x19, 0xb0, 0xfe, 0x7d, 0x32, 0x22, 0x87, 0x51, 0x84, 0x29, 0xc0,
-	0x19, 0x41, 0x53, 0x75, 0x1d, 0xdd, 0x04, 0xcb, 0x4f, 0x48, 0xc4, 0x34, 0x88, 0x91, 0x28, 0x23,
-	0x28, 0xda, 0x4a, 0x79, 0xda, 0xca, 0xcb, 0xb4, 0x7d, 0x61, 0x40, 0x4b, 0x23, 0xa3, 0x1d, 0xa8,
-	0x91, 0x28, 0x62, 0xf5, 0xc6, 0xb8, 0xb8, 0xde, 0x48, 0x1c, 0xea, 0x40, 0x6d, 0x4c, 0xe2, 0xd8,
-	0xf5, 0x64, 0x29, 0x91, 0x4b, 0xf4, 0x10, 0x1a, 0xf1, 0xd4, 0xf3, 0x48, 0xcc, 0x3e, 0x0d, 0x3b,
-	0x65, 0x56, 0x07, 0xe5, 0x15, 0xee, 0xa7, 0x3b, 0x58, 0x45, 0x39, 0x2f, 0xc1, 0x4a, 0x0b, 0x02,
-	0x2d, 0x52, 0x84, 0xd6, 0x2f, 0x11, 0x4d, 0xbe, 0xd0, 0x3e, 0x05, 0x4a, 0x4b, 0x3e, 0x05, 0x9c,
-	0xbf, 0xc8, 0x06, 0xcc, 0x25, 0xda, 0x50, 0x97, 0xdd, 0x54, 0x08, 0x4d, 0xd7, 0x85, 0xe1, 0x6c,
-	0x67, 0xe1, 0xb4, 0x58, 0xe0, 0xd4, 0x30, 0x55, 0x2e, 0x19, 0xa6, 0x3d, 0x68, 0xb9, 0x6a, 0xa8,
-	0x45, 0xb1, 0xc8, 0x3f, 0x1d, 0x1d, 0xea, 0xbc, 0x51, 0x32, 0xb5, 0x30, 0xc5, 0x16, 0x14, 0x94,
-	0x2e, 0xaf, 0xe0, 0x4f, 0x69, 0x7f, 0xbd, 0x58, 0x47, 0x3b, 0x4b, 0xe3, 0xc5, 0x48, 0x94, 0xdf,
-	0x36, 0x12, 0x95, 0xcb, 0x1b, 0xfa, 0xa5, 0xde, 0x85, 0x2f, 0xb6, 0xb6, 0x38, 0x33, 0xff, 0xef,
-	0x27, 0xfa, 0x6f, 0x03, 0x3a, 0x45, 0x05, 0x9d, 0xe6, 0xa8, 0x2c, 0xe8, 0x32, 0x47, 0xe5, 0xba,
-	0x30, 0x47, 0x15, 0x5f, 0xcb, 0xb9, 0xbe, 0x56, 0x32, 0x5f, 0xf5, 0xb9, 0xc2, 0xbc, 0xf4, 0x5c,
-	0xb1, 0xe8, 0x71, 0xf5, 0xf2, 0x1e, 0xff, 0xb5, 0x04, 0x56, 0xda, 0x4a, 0x69, 0x5d, 0x1b, 0x85,
-	0x03, 0x77, 0x44, 0x29, 0xb2, 0xae, 0xa5, 0x04, 0x74, 0x0b, 0x20, 0x22, 0xe3, 0x30, 0x21, 0x6c,
-	0x9b, 0xcf, 0xd3, 0x0a, 0x85, 0x3a, 0x3b, 0x09, 0x87, 0x2f, 0xdd, 0x71, 0xea, 0xac, 0x58, 0xa2,
-	0x3b, 0xd0, 0x1a, 0xc8, 0x3e, 0xc3, 0xf6, 0xb9, 0xdb, 0x3a, 0x91, 0x6a, 0x0f, 0xdc, 0x31, 0x89,
-	0x27, 0xee, 0x80, 0xfb, 0x6f, 0xe1, 0x8c, 0x40, 0xc3, 0x4f, 0xdb, 0x3c, 0x63, 0xaf, 0xf2, 0xf0,
-	0xcb, 0x35, 0x72, 0xa0, 0x29, 0x8f, 0x82, 0x8e, 0xfe, 0xac, 0x9d, 0x5a, 0x58, 0xa3, 0xa9, 0x18,
-	0x26, 0xa3, 0xae, 0x63, 0x98, 0x9c, 0x0e, 0xd4, 0xdc, 0xe1, 0x30, 0x22, 0x71, 0xcc, 0x1a, 0x9f,
-	0x85, 0xe5, 0x12, 0xed, 0x02, 0x24, 0x6e, 0xe4, 0x91, 0x84, 0xf9, 0x0e, 0xda, 0x00, 0xf3, 0x3c,
-	0x48, 0x5e, 0x45, 0xfd, 0x24, 0xf2, 0x03, 0x0f, 0x2b, 0x28, 0xe7, 0xef, 0x46, 0x36, 0xb2, 0xa5,
-	0xf1, 0xa5, 0xad, 0x7c, 0x9f, 0x7d, 0x90, 0x88, 0xf8, 0xa6, 0x04, 0x5a, 0x56, 0xfd, 0x71, 0x76,
-	0x2d, 0xf8, 0x42, 0x49, 0xad, 0x72, 0xde, 0xa5, 0xaf, 0xe4, 0x5e, 0x16, 0xf3, 0x6d, 0x2f, 0xcb,
-	0x15, 0x52, 0xe7, 0x3f, 0x25, 0xd8, 0x2a, 0x98, 0x30, 0x2e, 0xba, 0xfb, 0x32, 0x45, 0x4a, 0x4b,
-	0x52, 0xa4, 0xbc, 0x34, 0x45, 0x2a, 0x39, 0x29, 0x92, 0x76, 0x11, 0x73, 0xae, 0x8b, 0x74, 0xa0,
-	0x16, 0x4d, 0x83, 0xc4, 0x4f, 0xb3, 0x47, 0x2e, 0x69, 0x5a, 0x7f, 0x1e, 0x46, 0x67, 0x7e, 0xe0,
-	0x1d, 0xf8, 0x91, 0x48, 0x1d, 0x85, 0x82, 0x5e, 0x02, 0xb0, 0x69, 0x89, 0xbf, 0xfd, 0xd5, 0x59,
-	0xbb, 0xec, 0x5e, 0x3c, 0x61, 0x71, 0xba, 0xf2, 0x12, 0xa8, 0x48, 0xb0, 0x1f, 0xc3, 0xda, 0xdc,
-	0xf6, 0xb2, 0xef, 0x80, 0x96, 0xfa, 0x1d, 0xf0, 0x1b, 0xa8, 0xbf, 0x08, 0x3d, 0xce, 0xf7, 0x31,
-	0x58, 0xe9, 0x13, 0xae, 0x18, 0xdf, 0xed, 0x2e, 0x7f, 0xc3, 0xed, 0xca, 0x37, 0xdc, 0xee, 0xb1,
-	0x44, 0xe0, 0x0c, 0x8c, 0x1c, 0x30, 0x89, 0x32, 0xc1, 0xcb, 0x87, 0x5a, 0xf1, 0x5a, 0x46, 0xf4,
-	0x36, 0x5f, 0x56, 0xda, 0xbc, 0xb3, 0x07, 0xeb, 0x3f, 0x89, 0x49, 0xf4, 0x3c, 0x48, 0x28, 0x54,
-	0x3c, 0xd5, 0xbe, 0x07, 0x55, 0x9f, 0x11, 0x84, 0x15, 0xad, 0xec, 0x6a, 0x50, 0x94, 0xd8, 0x74,
-	0xbe, 0x07, 0xab, 0xe2, 0x1b, 0x44, 0x32, 0x7e, 0x4b, 0x7f, 0x30, 0x4e, 0x1f, 0xc8, 0x38, 0x4a,
-	0x7b, 0x37, 0xde, 0x81, 0xa6, 0x4a, 0x46, 0x36, 0xd4, 0x08, 0x4b, 0x46, 0xfe, 0x6e, 0x57, 0x3f,
-	0x5a, 0xc1, 0x92, 0xf0, 0xd4, 0x84, 0xf2, 0xb9, 0x3b, 0x72, 0x3e, 0x85, 0x2a, 0xb7, 0x80, 0xfa,
-	0x92, 0x3d, 0xf1, 0xd5, 0xe5, 0x63, 0x1e, 0x82, 0x4a, 0x3c, 0x0b, 0x06, 0xe2, 0x1b, 0x89, 0xfd,
-	0xa6, 0xa9, 0x2b, 0x1e, 0xf8, 0xca, 0x8c, 0x2a, 0x56, 0x8e, 0x0f, 0x90, 0x0d, 0x47, 0x68, 0x1f,
-	0x56, 0xb3, 0xf1, 0x48, 0x19, 0xcc, 0x6e, 0xe8, 0x57, 0x4e, 0x83, 0xe0, 0x39, 0x16, 0xaa, 0x8a,
-	0x5f, 0x29, 0xd9, 0x35, 0xf8, 0xca, 0xf9, 0x31, 0x34, 0x94, 0x9a, 0x42, 0xad, 0x4c, 0x5f, 0x3c,
-	0x4c, 0xf1, 0xac, 0xb1, 0xc9, 0x02, 0xfe, 0x53, 0x77, 0x24, 0xea, 0xb0, 0x58, 0xf1, 0x8b, 0x17,
-	0x51, 0x7a, 0x5a, 0x2d, 0xe8, 0x6a, 0xf7, 0xcf, 0x26, 0xac, 0xf5, 0xc5, 0x9f, 0x0c, 0xfa, 0x24,
-	0x3a, 0xf7, 0x07, 0x04, 0xed, 0x43, 0xfd, 0x19, 0x91, 0x6f, 0x03, 0x0b, 0x69, 0x73, 0x38, 0x9e,
-	0x24, 0x33, 0x5b, 0x7b, 0xc1, 0x77, 0xd6, 0x7f, 0xff, 0xd5, 0x3f, 0xfe, 0x58, 0x6a, 0x20, 0xab,
-	0x77, 0xbe, 0xd3, 0x63, 0xa7, 0x82, 0x9e, 0x41, 0x9d, 0x25, 0xcd, 0x8b, 0xd0, 0x43, 0x72, 0xde,
-	0x93, 0xf9, 0x69, 0xcf, 0x13, 0x9c, 0x0d, 0x26, 0x60, 0x0d, 0xb5, 0xa8, 0x00, 0x3e, 0xb4, 0x8f,
-	0x42, 0xef, 0x9e, 0xf1, 0xc0, 0x40, 0xcf, 0xa0, 0xca, 0x04, 0xc5, 0x85, 0xb6, 0x2c, 0x48, 0x43,
-	0x4c, 0x5a, 0x13, 0x41, 0x2a, 0x2d, 0x7e, 0x60, 0xa0, 0x9f, 0x41, 0xed, 0xf0, 0xd7, 0x64, 0x30,
-	0x4d, 0x08, 0x92, 0x4f, 0x4b, 0x0b, 0x09, 0x6b, 0x17, 0xe8, 0x70, 0x6e, 0x30, 0x91, 0x1b, 0x4e,
-	0x83, 0x89, 0xe4, 0x62, 0xf6, 0x44, 0xfa, 0x22, 0x17, 0xac, 0x27, 0xd3, 0x24, 0x64, 0x73, 0x2b,
-	0xda, 0xd0, 0x53, 0x75, 0x99, 0xe0, 0xf7, 0x98, 0xe0, 0xdb, 0xf6, 0x26, 0x15, 0xcc, 0xb2, 0xaf,
-	0x47, 0x3f, 0xc9, 0xdf, 0x48, 0x1d, 0x3c, 0xc9, 0xd1, 0x1b, 0xa8, 0x53, 0x15, 0xb4, 0x65, 0x5c,
-	0x55, 0xc3, 0x1d, 0xa6, 0xe1, 0x96, 0xbd, 0xc1, 0x0e, 0x67, 0x16, 0x0c, 0x72, 0x15, 0x0c, 0x00,
-	0xa8, 0x02, 0x3e, 0x56, 0x5e, 0x55, 0xc5, 0x5d, 0xa6, 0x62, 0xdb, 0xde, 0xa2, 0x2a, 0xf8, 0xbd,
-	0xc8, 0x55, 0xf2, 0x02, 0xaa, 0x47, 0x6e, 0x30, 0x1c, 0x11, 0xa4, 0x15, 0x96, 0x42, 0xb9, 0x37,
-	0x99, 0xdc, 0x4d, 0x67, 0x3d, 0x3b, 0xc8, 0xde, 0x67, 0x4c, 0xc0, 0x9e, 0x71, 0xff, 0x75, 0xf9,
-	0xa4, 0xca, 0xf0, 0x0f, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x04, 0x2e, 0xed, 0x6c, 0xf9, 0x1a,
-	0x00, 0x00,
+	// 2350 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5f, 0x8f, 0x1b, 0x49,
+	0x11, 0xcf, 0xd8, 0x1e, 0xdb, 0x53, 0xb6, 0xf7, 0x4f, 0x27, 0xbb, 0x71, 0x26, 0x7b, 0x49, 0x6e,
+	0x94, 0x84, 0x90, 0xdc, 0xd9, 0xc9, 0xe6, 0x74, 0x1c, 0x4b, 0x02, 0x4a, 0x36, 0x7b, 0xd9, 0x3d,
+	0x42, 0x12, 0xda, 0x01, 0x21, 0xc4, 0x69, 0x35, 0xeb, 0xe9, 0xf5, 0x8d, 0x62, 0xcf, 0x98, 0x99,
+	0xf1, 0x26, 0x16, 0x02, 0x01, 0x1f, 0x00, 0xe9, 0xc4, 0x77, 0xe0, 0x6b, 0x20, 0x1e, 0xee, 0x13,
+	0xdc, 0x13, 0x88, 0x47, 0x10, 0x8f, 0xbc, 0xf0, 0x86, 0x90, 0x50, 0xff, 0x9b, 0xe9, 0xb6, 0x67,
+	0xec, 0x4d, 0x4e, 0xa7, 0x7b, 0xd9, 0x9d, 0xae, 0xfe, 0x55, 0x55, 0x57, 0x75, 0x75, 0x55, 0x75,
+	0x1b, 0xd6, 0x4f, 0xee, 0x74, 0xe3, 0x97, 0xee, 0xf1, 0x71, 0x38, 0xf4, 0x3a, 0xe3, 0x28, 0x4c,
+	0x42, 0x64, 0xb2, 0x7f, 0xf6, 0xd6, 0x20, 0x0c, 0x07, 0x43, 0xd2, 0x75, 0xc7, 0x7e, 0xd7, 0x0d,
+	0x82, 0x30, 0x71, 0x13, 0x3f, 0x0c, 0x62, 0x0e, 0xb2, 0x2f, 0x8b, 0x59, 0x36, 0x3a, 0x9a, 0x1c,
+	0x77, 0x13, 0x7f, 0x44, 0xe2, 0xc4, 0x1d, 0x8d, 0x05, 0xe0, 0xe2, 0x2c, 0x80, 0x8c, 0xc6, 0xc9,
+	0x54, 0x4c, 0xae, 0x93, 0x60, 0x32, 0x8a, 0xbb, 0xec, 0x2f, 0x27, 0x39, 0x77, 0xa1, 0xd5, 0x4b,
+	0xdc, 0x84, 0x60, 0x12, 0x8f, 0xc3, 0x20, 0x26, 0xc8, 0x01, 0x33, 0xa6, 0x84, 0xb6, 0x71, 0xc5,
+	0xb8, 0xd1, 0xd8, 0x6e, 0x72, 0x5c, 0x87, 0x83, 0xf8, 0x94, 0xb3, 0x05, 0xf5, 0x14, 0xbf, 0x06,
+	0xe5, 0x51, 0x3c, 0x60, 0x68, 0x0b, 0xd3, 0x4f, 0xe7, 0x1d, 0xa8, 0x61, 0xf2, 0xcb, 0x09, 0x89,
+	0x13, 0x84, 0xa0, 0x12, 0xb8, 0x23, 0x22, 0x66, 0xd9, 0xb7, 0xf3, 0x45, 0x05, 0x4c, 0x26, 0x0d,
+	0xdd, 0x01, 0x38, 0x9a, 0xf8, 0x43, 0xaf, 0xa7, 0xe8, 0x5b, 0x17, 0xfa, 0x1e, 0xa6, 0x13, 0x58,
+	0x01, 0xa1, 0x0f, 0xa0, 0xe1, 0x91, 0xf1, 0x30, 0x9c, 0x72, 0x9e, 0x12, 0xe3, 0x41, 0x82, 0xe7,
+	0x51, 0x36, 0x83, 0x55, 0x18, 0xda, 0x87, 0x95, 0xe3, 0x30, 0x7a, 0xe5, 0x46, 0x1e, 0xf1, 0x9e,
+	0x87, 0x51, 0x12, 0xb7, 0x2b, 0x57, 0xca, 0x37, 0x1a, 0xdb, 0x57, 0x54, 0xe3, 0x3a, 0x1f, 0x6b,
+	0x90, 0xbd, 0x20, 0x89, 0xa6, 0x78, 0x86, 0x0f, 0xed, 0xc2, 0x1a, 0x75, 0xc1, 0x24, 0xde, 0xfd,
+	0x8c, 0xf4, 0x5f, 0xf2, 0x45, 0x98, 0x6c, 0x11, 0xe7, 0x15, 0x59, 0xea, 0x34, 0x9e, 0x63, 0x40,
+	0x3b, 0xd0, 0x3a, 0xf6, 0x87, 0xa4, 0x37, 0x0d, 0xfa, 0x5c, 0x42, 0x95, 0x49, 0x38, 0x27, 0x24,
+	0x7c, 0xac, 0xce, 0x61, 0x1d, 0x8a, 0x9e, 0xc3, 0x59, 0x8f, 0x1c, 0x4d, 0x06, 0x03, 0x3f, 0x18,
+	0xec, 0x86, 0x41, 0xe2, 0xfa, 0x01, 0x89, 0xe2, 0x76, 0x8d, 0xd9, 0x73, 0x29, 0x75, 0xc4, 0x2c,
+	0x62, 0xef, 0x84, 0x04, 0x09, 0xce, 0x63, 0x45, 0xb7, 0xa0, 0x3e, 0x22, 0x89, 0xeb, 0xb9, 0x89,
+	0xdb, 0xae, 0xb3, 0x85, 0xac, 0x0a, 0x31, 0x3f, 0x12, 0x64, 0x9c, 0x02, 0x50, 0x07, 0xac, 0x84,
+	0xc4, 0x09, 0x5f, 0xb6, 0xc5, 0xd0, 0x6b, 0x02, 0xfd, 0x42, 0xd2, 0x71, 0x06, 0xb1, 0x7b, 0x70,
+	0x36, 0xc7, 0xad, 0x34, 0x68, 0x5e, 0x92, 0x29, 0xdb, 0x72, 0x13, 0xd3, 0x4f, 0x74, 0x1d, 0xcc,
+	0x13, 0x77, 0x38, 0x91, 0x5b, 0x2a, 0x85, 0x52, 0x1e, 0xbe, 0x76, 0x3e, 0xbd, 0x53, 0xfa, 0xc8,
+	0xf8, 0xa4, 0x52, 0x2f, 0xaf, 0x55, 0x9c, 0x3f, 0x94, 0xa0, 0x2e, 0x57, 0x88, 0x6e, 0x82, 0xc9,
+	0xa2, 0x44, 0x44, 0xd1, 0x39, 0x35, 0x8a, 0x52, 0x33, 0x38, 0x04, 0xbd, 0x0f, 0x55, 0x1e, 0x1c,
+	0x42, 0xd7, 0x86, 0x16, 0x3e, 0x29, 0x5a, 0x80, 0xd0, 0xb7, 0xa0, 0x42, 0xed, 0x69, 0x97, 0x19,
+	0xf8, 0xac, 0x62, 0x6d, 0x0a, 0x65, 0x00, 0xf4, 0x03, 0x00, 0xd7, 0xf3, 0x7c, 0x7a, 0x5c, 0xdd,
+	0x61, 0xbb, 0xcf, 0x76, 0xe4, 0xf2, 0x8c, 0x2b, 0x3b, 0x0f, 0x52, 0x04, 0x0f, 0x30, 0x85, 0xc5,
+	0xbe, 0x0f, 0xab, 0x33, 0xd3, 0xaa, 0xa3, 0x2c, 0xee, 0xa8, 0x73, 0xaa, 0xa3, 0x2c, 0xc5, 0x2d,
+	0xce, 0xef, 0xca, 0xd0, 0xd2, 0x0c, 0x46, 0xef, 0xc1, 0x7a, 0x30, 0x19, 0x1d, 0x91, 0xe8, 0xd9,
+	0xf1, 0x83, 0x28, 0xf1, 0x8f, 0xdd, 0x7e, 0x12, 0x0b, 0xa7, 0xcf, 0x4f, 0xa0, 0xfb, 0x50, 0x67,
+	0x0e, 0xa2, 0xf1, 0x54, 0x62, 0xab, 0x7f, 0x37, 0xcf, 0x8d, 0x9d, 0x83, 0x91, 0x3b, 0x20, 0x0f,
+	0x39, 0x12, 0xa7, 0x2c, 0xe8, 0x26, 0x54, 0x92, 0xe9, 0x98, 0x30, 0x3f, 0xad, 0x6c, 0x6f, 0x0a,
+	0x56, 0x9e, 0x6b, 0x18, 0xfa, 0xc5, 0x74, 0x4c, 0x30, 0xc3, 0xa0, 0x47, 0x39, 0xae, 0xba, 0x9a,
+	0xab, 0x6c, 0x91, 0xbf, 0x30, 0x34, 0xd5, 0xb5, 0xa0, 0xf7, 0xc4, 0x0a, 0x0c, 0xb6, 0x82, 0xf6,
+	0xfc, 0x0a, 0x48, 0xa4, 0xac, 0xe1, 0x1c, 0x98, 0xfd, 0x70, 0x12, 0x24, 0xcc, 0x91, 0x26, 0xe6,
+	0x83, 0xaf, 0xba, 0x07, 0x9f, 0x1b, 0xd0, 0x54, 0x43, 0x03, 0x7d, 0x00, 0x35, 0x3a, 0xa6, 0x3e,
+	0x35, 0x98, 0x99, 0x76, 0x4e, 0x00, 0x75, 0x38, 0x04, 0x4b, 0xa8, 0xfd, 0x43, 0xa8, 0xf2, 0x4f,
+	0x74, 0x4b, 0xb3, 0xe9, 0xbc, 0x66, 0x13, 0x87, 0x2c, 0x33, 0xc9, 0xf9, 0xd2, 0x80, 0x15, 0x3d,
+	0xb6, 0xd1, 0x3d, 0xb0, 0x78, 0x74, 0x67, 0xeb, 0xba, 0x94, 0x7b, 0x0a, 0xc4, 0x90, 0x44, 0x38,
+	0x63, 0x40, 0xdb, 0x50, 0xeb, 0x0f, 0x27, 0x54, 0x37, 0x53, 0x34, 0xeb, 0xea, 0x5d, 0x3e, 0xc7,
+	0xd6, 0x25, 0x81, 0xf6, 0x33, 0xa8, 0x4b, 0x51, 0xe8, 0x7d, 0xcd, 0xa6, 0x0b, 0x1a, 0xb3, 0x04,
+	0x2d, 0xb5, 0xea, 0x9f, 0x06, 0x40, 0x56, 0x24, 0xd0, 0xf7, 0xc1, 0x72, 0x95, 0x10, 0x57, 0xb3,
+	0x7b, 0x86, 0xea, 0xa4, 0xc1, 0xce, 0x83, 0x29, 0x63, 0x41, 0x57, 0xa0, 0xe1, 0x4e, 0x92, 0xf0,
+	0x45, 0xe4, 0x0f, 0x06, 0xc2, 0xae, 0x3a, 0x56, 0x49, 0xe8, 0x3b, 0x00, 0x22, 0x93, 0x87, 0x9e,
+	0x8c, 0x72, 0x7d, 0x3f, 0x7a, 0xe9, 0x34, 0x56, 0xa0, 0xf6, 0x3d, 0x58, 0xd1, 0xf5, 0xbe, 0x51,
+	0x44, 0xfd, 0x02, 0xac, 0x34, 0xb3, 0xa2, 0x4d, 0xa8, 0x72, 0xc1, 0x82, 0x57, 0x8c, 0x66, 0xd6,
+	0x56, 0x3a, 0xf5, 0xda, 0x9c, 0xdf, 0x1a, 0xd0, 0x50, 0xca, 0x66, 0xa1, 0x82, 0xaf, 0xcf, 0x3d,
+	0xce, 0xbf, 0x0c, 0x58, 0x9b, 0x2d, 0x9a, 0x85, 0xeb, 0x78, 0x04, 0x56, 0x44, 0xe2, 0x70, 0x12,
+	0xf5, 0x89, 0x4c, 0x52, 0xd7, 0x0b, 0x0a, 0x6f, 0x07, 0x4b, 0xa0, 0xd8, 0xec, 0x94, 0xf1, 0x2b,
+	0x6d, 0xa5, 0x2e, 0xf5, 0x8d, 0xb6, 0xf2, 0x00, 0x5a, 0x5a, 0x6d, 0x7f, 0x7b, 0x6f, 0x3b, 0x7f,
+	0x33, 0xc1, 0x64, 0x75, 0x11, 0xdd, 0x06, 0x8b, 0x56, 0x67, 0x36, 0x10, 0xd5, 0x6f, 0x4d, 0x29,
+	0x3a, 0x8c, 0xbe, 0x7f, 0x06, 0x67, 0x20, 0x74, 0x57, 0xb4, 0x5d, 0x9c, 0xa5, 0x34, 0xdf, 0x76,
+	0x49, 0x1e, 0x05, 0x86, 0x3e, 0x94, 0x8d, 0x17, 0xe7, 0x2a, 0xe7, 0x34, 0x5e, 0x92, 0x4d, 0x05,
+	0xd2, 0xe5, 0x8d, 0x65, 0x0d, 0x6f, 0x57, 0xf2, 0x6b, 0x3b, 0x5d, 0x5e, 0x0a, 0x42, 0x7b, 0x5a,
+	0x8b, 0xc5, 0x19, 0x0b, 0x5b, 0x2c, 0xc9, 0x3f, 0xc7, 0x82, 0x3e, 0x85, 0xb6, 0xdc, 0xf0, 0x59,
+	0xbc, 0xe8, 0xb7, 0x64, 0x6d, 0xc6, 0x05, 0xb0, 0xfd, 0x33, 0xb8, 0x50, 0x04, 0xba, 0x97, 0xf5,
+	0x70, 0x5c, 0x66, 0x2d, 0xb7, 0x87, 0x93, 0x82, 0x74, 0x30, 0xfa, 0x39, 0x9c, 0xf7, 0xf2, 0x7b,
+	0x34, 0xd1, 0x82, 0x2d, 0xe9, 0xe4, 0xf6, 0xcf, 0xe0, 0x22, 0x01, 0xe8, 0xbb, 0xd0, 0xf4, 0xc8,
+	0xc9, 0x93, 0x30, 0x1c, 0x73, 0x81, 0x96, 0xd6, 0xb7, 0x3c, 0x52, 0xa6, 0xf6, 0xcf, 0x60, 0x0d,
+	0x4a, 0x5d, 0x9f, 0x90, 0x68, 0xe4, 0x07, 0xec, 0xce, 0xc1, 0xd9, 0x41, 0x73, 0xfd, 0x8b, 0x99,
+	0x69, 0xea, 0xfa, 0x59, 0x16, 0xba, 0xe7, 0x34, 0x65, 0x71, 0xfe, 0xc6, 0x5c, 0x93, 0x98, 0xee,
+	0x79, 0x3a, 0x78, 0xd8, 0x04, 0x20, 0xf4, 0xe3, 0x90, 0x26, 0x7c, 0x07, 0xc3, 0xda, 0xac, 0x9e,
+	0xc2, 0xa3, 0x72, 0x1d, 0xca, 0x24, 0x8a, 0x44, 0x14, 0x4b, 0xef, 0x3f, 0xe8, 0xb3, 0xfa, 0x7d,
+	0x34, 0x24, 0x7b, 0x51, 0x84, 0x29, 0xc0, 0x19, 0x42, 0x53, 0x35, 0x1d, 0x6d, 0x81, 0xe5, 0x27,
+	0x24, 0x62, 0x1a, 0x44, 0x4b, 0x94, 0x11, 0x14, 0x6d, 0xa5, 0x3c, 0x6d, 0xe5, 0x65, 0xda, 0x3e,
+	0x37, 0xa0, 0xa5, 0x91, 0xd1, 0x1d, 0xa8, 0x91, 0x28, 0x62, 0xf9, 0xc6, 0x58, 0x9c, 0x6f, 0x24,
+	0x0e, 0xb5, 0xa1, 0x36, 0x22, 0x71, 0xec, 0x0e, 0x64, 0x2a, 0x91, 0x43, 0x74, 0x17, 0x1a, 0xf1,
+	0x64, 0x30, 0x20, 0x31, 0xbb, 0x1a, 0xb6, 0xcb, 0x2c, 0x0f, 0xca, 0x23, 0xdc, 0x4b, 0x67, 0xb0,
+	0x8a, 0x72, 0x9e, 0x82, 0x95, 0x26, 0x04, 0x9a, 0xa4, 0x08, 0xcd, 0x5f, 0xc2, 0x9b, 0x7c, 0xa0,
+	0x5d, 0x05, 0x4a, 0x4b, 0xae, 0x02, 0xce, 0x5f, 0x64, 0x01, 0xe6, 0x12, 0x6d, 0xa8, 0xcb, 0x6a,
+	0x2a, 0x84, 0xa6, 0xe3, 0x42, 0x77, 0xae, 0x65, 0xee, 0xb4, 0x98, 0xe3, 0x54, 0x37, 0x55, 0x4e,
+	0xe9, 0xa6, 0x1d, 0x68, 0xb9, 0xaa, 0xab, 0x45, 0xb2, 0xc8, 0xdf, 0x1d, 0x1d, 0xea, 0x1c, 0x2a,
+	0x91, 0x5a, 0x18, 0x62, 0x73, 0x0a, 0x4a, 0xa7, 0x57, 0xf0, 0xa7, 0xb4, 0xbe, 0x2e, 0xd6, 0xb1,
+	0x96, 0x85, 0xf1, 0xbc, 0x27, 0xca, 0x6f, 0xeb, 0x89, 0xca, 0xe9, 0x17, 0xfa, 0x85, 0x5e, 0x85,
+	0x17, 0xaf, 0xb6, 0x38, 0x32, 0xbf, 0xf1, 0x1d, 0xfd, 0xb7, 0x01, 0xed, 0xa2, 0x84, 0x4e, 0x63,
+	0x54, 0x26, 0x74, 0x19, 0xa3, 0x72, 0x5c, 0x18, 0xa3, 0x8a, 0xad, 0xe5, 0x5c, 0x5b, 0x2b, 0x99,
+	0xad, 0x7a, 0x5f, 0x61, 0x9e, 0xba, 0xaf, 0x98, 0xb7, 0xb8, 0x7a, 0x7a, 0x8b, 0xff, 0x5a, 0x02,
+	0x2b, 0x2d, 0xa5, 0x34, 0xaf, 0x0d, 0xc3, 0xbe, 0x3b, 0xa4, 0x14, 0x99, 0xd7, 0x52, 0x02, 0xba,
+	0x04, 0x10, 0x91, 0x51, 0x98, 0x10, 0x36, 0xcd, 0xfb, 0x69, 0x85, 0x42, 0x8d, 0x1d, 0x87, 0xde,
+	0x53, 0x77, 0x94, 0x1a, 0x2b, 0x86, 0xe8, 0x2a, 0xb4, 0xfa, 0xb2, 0xce, 0xb0, 0x79, 0x6e, 0xb6,
+	0x4e, 0xa4, 0xda, 0x03, 0x77, 0x44, 0xe2, 0xb1, 0xdb, 0xe7, 0xf6, 0x5b, 0x38, 0x23, 0x50, 0xf7,
+	0xd3, 0x32, 0xcf, 0xd8, 0xab, 0xdc, 0xfd, 0x72, 0x8c, 0x1c, 0x68, 0xca, 0xad, 0xa0, 0xad, 0x3f,
+	0x2b, 0xa7, 0x16, 0xd6, 0x68, 0x2a, 0x86, 0xc9, 0xa8, 0xeb, 0x18, 0x26, 0xa7, 0x0d, 0x35, 0xd7,
+	0xf3, 0x22, 0x12, 0xc7, 0xac, 0xf0, 0x59, 0x58, 0x0e, 0xd1, 0x36, 0x40, 0xe2, 0x46, 0x03, 0x92,
+	0x30, 0xdb, 0x41, 0x6b, 0x60, 0x0e, 0x82, 0xe4, 0x59, 0xd4, 0x4b, 0x22, 0x3f, 0x18, 0x60, 0x05,
+	0xe5, 0xfc, 0xdd, 0xc8, 0x5a, 0xb6, 0xd4, 0xbf, 0xb4, 0x94, 0xef, 0xb2, 0x0b, 0x89, 0xf0, 0x6f,
+	0x4a, 0xa0, 0x69, 0xd5, 0x1f, 0x65, 0xc7, 0x82, 0x0f, 0x94, 0xd0, 0x2a, 0xe7, 0x1d, 0xfa, 0x4a,
+	0xee, 0x61, 0x31, 0xdf, 0xf6, 0xb0, 0xbc, 0x41, 0xe8, 0xfc, 0xa7, 0x04, 0xe7, 0x0b, 0x3a, 0x8c,
+	0x45, 0x67, 0x5f, 0x86, 0x48, 0x69, 0x49, 0x88, 0x94, 0x97, 0x86, 0x48, 0x25, 0x27, 0x44, 0xd2,
+	0x2a, 0x62, 0xce, 0x54, 0x91, 0x36, 0xd4, 0xa2, 0x49, 0x90, 0xf8, 0x69, 0xf4, 0xc8, 0x21, 0x0d,
+	0xeb, 0x57, 0x61, 0xf4, 0xd2, 0x0f, 0x06, 0x8f, 0xfc, 0x48, 0x84, 0x8e, 0x42, 0x41, 0x4f, 0x01,
+	0x58, 0xb7, 0xc4, 0xdf, 0xfe, 0xea, 0xac, 0x5c, 0x76, 0x16, 0x77, 0x58, 0x9c, 0xae, 0xbc, 0x04,
+	0x2a, 0x12, 0xec, 0xfb, 0xb0, 0x3a, 0x33, 0xbd, 0xec, 0x1e, 0xd0, 0x52, 0xef, 0x01, 0xbf, 0x81,
+	0xfa, 0x93, 0x70, 0xc0, 0xf9, 0x3e, 0x02, 0x2b, 0x7d, 0xc2, 0x15, 0xed, 0xbb, 0xdd, 0xe1, 0x6f,
+	0xb8, 0x1d, 0xf9, 0x86, 0xdb, 0x79, 0x21, 0x11, 0x38, 0x03, 0x23, 0x07, 0x4c, 0xa2, 0x74, 0xf0,
+	0xf2, 0xa1, 0x56, 0xbc, 0x96, 0x11, 0xbd, 0xcc, 0x97, 0x95, 0x32, 0xef, 0xec, 0xc0, 0xfa, 0x4f,
+	0x62, 0x12, 0x1d, 0x04, 0x09, 0x85, 0x8a, 0xa7, 0xda, 0x6b, 0x50, 0xf5, 0x19, 0x41, 0xac, 0xa2,
+	0x95, 0x1d, 0x0d, 0x8a, 0x12, 0x93, 0xce, 0xf7, 0x60, 0x45, 0xdc, 0x41, 0x24, 0xe3, 0xb7, 0xf5,
+	0x07, 0xe3, 0xf4, 0x81, 0x8c, 0xa3, 0xb4, 0x77, 0xe3, 0x4f, 0x01, 0x51, 0x97, 0x89, 0x17, 0x41,
+	0x29, 0x60, 0x71, 0xca, 0x4a, 0xc5, 0x97, 0x96, 0x8a, 0xff, 0xb3, 0x01, 0x1b, 0xcf, 0x43, 0x2f,
+	0x5f, 0x45, 0x16, 0x74, 0xc6, 0x6c, 0xd0, 0x15, 0x87, 0x34, 0x82, 0x0a, 0xcd, 0x50, 0xcc, 0x7d,
+	0x26, 0x66, 0xdf, 0xd4, 0xa7, 0xe1, 0xab, 0x80, 0xc8, 0x73, 0xcb, 0x07, 0xf4, 0xca, 0x36, 0x0e,
+	0xbd, 0x83, 0xe7, 0xbd, 0xc9, 0xf1, 0xb1, 0xff, 0x9a, 0xc5, 0xae, 0x89, 0x55, 0x12, 0xcf, 0x5e,
+	0xe3, 0xa1, 0xdf, 0x77, 0x0f, 0x02, 0x8f, 0xbc, 0x66, 0x31, 0x6c, 0x62, 0x8d, 0xe6, 0x7c, 0x08,
+	0x9b, 0xb3, 0x06, 0x88, 0x67, 0xf6, 0x85, 0x4e, 0x72, 0xfe, 0x57, 0x82, 0x75, 0x7a, 0xd2, 0x4f,
+	0x88, 0xc2, 0xbb, 0xc4, 0xb1, 0x5f, 0xfb, 0x41, 0x4e, 0x73, 0xbd, 0xb9, 0x24, 0xd7, 0x57, 0x4f,
+	0x91, 0xeb, 0x6b, 0x8b, 0x73, 0x7d, 0x7d, 0x51, 0xae, 0xb7, 0x4e, 0x93, 0xeb, 0xe9, 0xbe, 0xf2,
+	0x40, 0x03, 0xbe, 0xaf, 0x6c, 0x40, 0x7d, 0x31, 0x19, 0xd3, 0x43, 0xd7, 0x23, 0xfd, 0x30, 0xf0,
+	0x62, 0x76, 0x9f, 0x29, 0x63, 0x9d, 0xe8, 0x60, 0x40, 0x73, 0xee, 0x8f, 0xd1, 0x3d, 0x68, 0x8e,
+	0x95, 0xb1, 0x78, 0x96, 0x6a, 0x2b, 0x99, 0x59, 0x63, 0xc0, 0x1a, 0xda, 0xb9, 0x03, 0x4d, 0x35,
+	0xc8, 0x91, 0x0d, 0x35, 0xc2, 0x32, 0x37, 0x7f, 0xe4, 0xae, 0xef, 0x9f, 0xc1, 0x92, 0xf0, 0xd0,
+	0x84, 0xf2, 0x89, 0x3b, 0x74, 0x3e, 0x81, 0x2a, 0x3f, 0xae, 0xd4, 0x98, 0xec, 0x3d, 0xbc, 0x2e,
+	0x5f, 0xbe, 0x11, 0x54, 0xe2, 0x69, 0xd0, 0x17, 0x0f, 0x0a, 0xec, 0x9b, 0xe6, 0x79, 0xf1, 0x1a,
+	0x5e, 0x66, 0x54, 0x31, 0x72, 0x7c, 0x80, 0xec, 0x26, 0x81, 0x76, 0x61, 0x25, 0xbb, 0x4b, 0x28,
+	0xb7, 0x98, 0x8b, 0x7a, 0x7d, 0xd2, 0x20, 0x78, 0x86, 0x85, 0xaa, 0xe2, 0xf5, 0x47, 0xb6, 0x58,
+	0x7c, 0xe4, 0xfc, 0x18, 0x1a, 0xca, 0xa6, 0xd0, 0x55, 0xa6, 0xcf, 0x83, 0xa6, 0x78, 0x03, 0xdc,
+	0x64, 0xd9, 0xe9, 0xa7, 0xee, 0x50, 0x34, 0x2d, 0x62, 0xc4, 0xab, 0x54, 0x44, 0xe9, 0x69, 0x69,
+	0xa5, 0xa3, 0xed, 0xff, 0xd6, 0x60, 0xb5, 0x27, 0x7e, 0x5f, 0xeb, 0x91, 0xe8, 0xc4, 0xef, 0x13,
+	0xb4, 0x0b, 0xf5, 0xc7, 0x44, 0x3e, 0xa4, 0xcd, 0xe5, 0xd8, 0xbd, 0xd1, 0x38, 0x99, 0xda, 0xda,
+	0xcf, 0x5d, 0xce, 0xfa, 0xef, 0xbf, 0xfc, 0xc7, 0x1f, 0x4b, 0x0d, 0x64, 0x75, 0x4f, 0xee, 0x74,
+	0x79, 0x3c, 0x3c, 0x86, 0x3a, 0xcb, 0xb0, 0x4f, 0xc2, 0x01, 0x92, 0x97, 0x23, 0x99, 0xcc, 0xed,
+	0x59, 0x82, 0xb3, 0xc1, 0x04, 0xac, 0xa2, 0x16, 0x15, 0xc0, 0x6f, 0xb8, 0xc3, 0x70, 0x70, 0xc3,
+	0xb8, 0x6d, 0xa0, 0xc7, 0x50, 0x65, 0x82, 0xe2, 0xc2, 0xb5, 0xcc, 0x49, 0x43, 0x4c, 0x5a, 0x13,
+	0x41, 0x2a, 0x2d, 0xbe, 0x6d, 0xa0, 0x9f, 0x41, 0x6d, 0xef, 0x35, 0xe9, 0x4f, 0x12, 0x82, 0x64,
+	0x68, 0xcd, 0x65, 0x77, 0xbb, 0x40, 0x87, 0x73, 0x91, 0x89, 0xdc, 0x70, 0x1a, 0x4c, 0x24, 0x17,
+	0xb3, 0x23, 0x72, 0x3d, 0x72, 0xc1, 0x7a, 0x30, 0x49, 0x42, 0x76, 0xc9, 0x43, 0x1b, 0x7a, 0xe2,
+	0x5d, 0x26, 0xf8, 0x1a, 0x13, 0x7c, 0xd9, 0xde, 0xa4, 0x82, 0x59, 0xf4, 0x75, 0xdd, 0x49, 0x12,
+	0x1e, 0x4a, 0x1d, 0xe2, 0x78, 0x1d, 0x42, 0x9d, 0xaa, 0xa0, 0xfd, 0xd5, 0x9b, 0x6a, 0xb8, 0xca,
+	0x34, 0x5c, 0xb2, 0x37, 0xd8, 0xe6, 0x4c, 0x83, 0x7e, 0xae, 0x82, 0x3e, 0x00, 0x55, 0xc0, 0xef,
+	0x60, 0x6f, 0xaa, 0xe2, 0x3a, 0x53, 0x71, 0xc5, 0x3e, 0x4f, 0x55, 0xf0, 0x73, 0x91, 0xab, 0x64,
+	0x04, 0x0d, 0x35, 0xef, 0x5e, 0x50, 0x1e, 0xb8, 0xf4, 0x42, 0x54, 0xa8, 0xe9, 0x16, 0xd3, 0x74,
+	0xcd, 0xde, 0xa2, 0x9a, 0x68, 0x1a, 0x38, 0x14, 0x3f, 0x3c, 0x76, 0x7f, 0x95, 0x66, 0xec, 0x5f,
+	0x4b, 0x75, 0x63, 0x58, 0x11, 0x62, 0x45, 0xb1, 0x40, 0x5b, 0xa9, 0xc6, 0x9c, 0xea, 0x67, 0xbf,
+	0x53, 0x30, 0xcb, 0x4b, 0x8b, 0xf3, 0x2e, 0xd3, 0x7d, 0xd1, 0xd9, 0x9c, 0xd5, 0x1d, 0x77, 0xc7,
+	0xa1, 0x17, 0xef, 0x18, 0x37, 0xd1, 0x21, 0xac, 0x3e, 0xe6, 0x69, 0x32, 0x4d, 0x6e, 0x45, 0x51,
+	0x7b, 0xa1, 0x28, 0xbd, 0xc5, 0xce, 0x05, 0xa6, 0xe8, 0x2c, 0x5a, 0x9f, 0x53, 0x84, 0x9e, 0x40,
+	0x75, 0xdf, 0x0d, 0xbc, 0x21, 0x41, 0x5a, 0x1f, 0x53, 0xe8, 0xaf, 0x2d, 0x26, 0x6a, 0xd3, 0x59,
+	0xcf, 0x8e, 0x42, 0xf7, 0x33, 0x26, 0x60, 0xc7, 0xb8, 0xf9, 0xbc, 0x7c, 0x54, 0x65, 0xf8, 0xbb,
+	0xff, 0x0f, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x05, 0xdb, 0xfa, 0x68, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2812,6 +3259,13 @@ type SkaffoldServiceClient interface {
 	AutoSync(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(ctx context.Context, in *TriggerRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Allows for disabling a port forward and enabling it again. A disabled port forward is not re-created when its pod restarts.
+	PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Forwards a container port of a running pod, or of a replica of a workload, when pods are only forwarded on demand, with `--port-forward-on-demand`.
+	// Otherwise, pods are forwarded automatically and the request fails with FAILED_PRECONDITION. The port stays forwarded across restarts of the pod.
+	ForwardPodPort(ctx context.Context, in *PodPortForwardRequest, opts ...grpc.CallOption) (*PodPortForwardResponse, error)
+	// Returns the port forwards currently managed by Skaffold, ordered by namespace, then pod, then port.
+	GetPortForwards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActivePortForwards, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
@@ -2932,6 +3386,33 @@ func (c *skaffoldServiceClient) AutoDeploy(ctx context.Context, in *TriggerReque
 	return out, nil
 }
 
+func (c *skaffoldServiceClient) PortForward(ctx context.Context, in *PortForwardRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/PortForward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skaffoldServiceClient) ForwardPodPort(ctx context.Context, in *PodPortForwardRequest, opts ...grpc.CallOption) (*PodPortForwardResponse, error) {
+	out := new(PodPortForwardResponse)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/ForwardPodPort", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *skaffoldServiceClient) GetPortForwards(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ActivePortForwards, error) {
+	out := new(ActivePortForwards)
+	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/GetPortForwards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *skaffoldServiceClient) Handle(ctx context.Context, in *Event, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, "/proto.SkaffoldService/Handle", in, out, opts...)
@@ -2958,6 +3439,13 @@ type SkaffoldServiceServer interface {
 	AutoSync(context.Context, *TriggerRequest) (*emptypb.Empty, error)
 	// Allows for enabling or disabling automatic deploy trigger
 	AutoDeploy(context.Context, *TriggerRequest) (*emptypb.Empty, error)
+	// Allows for disabling a port forward and enabling it again. A disabled port forward is not re-created when its pod restarts.
+	PortForward(context.Context, *PortForwardRequest) (*emptypb.Empty, error)
+	// Forwards a container port of a running pod, or of a replica of a workload, when pods are only forwarded on demand, with `--port-forward-on-demand`.
+	// Otherwise, pods are forwarded automatically and the request fails with FAILED_PRECONDITION. The port stays forwarded across restarts of the pod.
+	ForwardPodPort(context.Context, *PodPortForwardRequest) (*PodPortForwardResponse, error)
+	// Returns the port forwards currently managed by Skaffold, ordered by namespace, then pod, then port.
+	GetPortForwards(context.Context, *emptypb.Empty) (*ActivePortForwards, error)
 	// EXPERIMENTAL. It allows for custom events to be implemented in custom builders for example.
 	Handle(context.Context, *Event) (*emptypb.Empty, error)
 }
@@ -2987,6 +3475,15 @@ func (*UnimplementedSkaffoldServiceServer) AutoSync(ctx context.Context, req *Tr
 func (*UnimplementedSkaffoldServiceServer) AutoDeploy(ctx context.Context, req *TriggerRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AutoDeploy not implemented")
 }
+func (*UnimplementedSkaffoldServiceServer) PortForward(ctx context.Context, req *PortForwardRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PortForward not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) ForwardPodPort(ctx context.Context, req *PodPortForwardRequest) (*PodPortForwardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardPodPort not implemented")
+}
+func (*UnimplementedSkaffoldServiceServer) GetPortForwards(ctx context.Context, req *emptypb.Empty) (*ActivePortForwards, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortForwards not implemented")
+}
 func (*UnimplementedSkaffoldServiceServer) Handle(ctx context.Context, req *Event) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Handle not implemented")
 }
@@ -3132,6 +3629,60 @@ func _SkaffoldService_AutoDeploy_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SkaffoldService_PortForward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).PortForward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/PortForward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).PortForward(ctx, req.(*PortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkaffoldService_ForwardPodPort_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PodPortForwardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).ForwardPodPort(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/ForwardPodPort",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).ForwardPodPort(ctx, req.(*PodPortForwardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SkaffoldService_GetPortForwards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SkaffoldServiceServer).GetPortForwards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.SkaffoldService/GetPortForwards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SkaffoldServiceServer).GetPortForwards(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SkaffoldService_Handle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(Event)
 	if err := dec(in); err != nil {
@@ -3174,6 +3725,18 @@ var _SkaffoldService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AutoDeploy",
 			Handler:    _SkaffoldService_AutoDeploy_Handler,
 		},
+		{
+			MethodName: "PortForward",
+			Handler:    _SkaffoldService_PortForward_Handler,
+		},
+		{
+			MethodName: "ForwardPodPort",
+			Handler:    _SkaffoldService_ForwardPodPort_Handler,
+		},
+		{
+			MethodName: "GetPortForwards",
+			Handler:    _SkaffoldService_GetPortForwards_Handler,
+		},
 		{
 			MethodName: "Handle",
 			Handler:    _SkaffoldService_Handle_Handler,
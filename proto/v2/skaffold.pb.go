@@ -141,6 +141,14 @@ const StatusCode_BUILD_DOCKERFILE_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_
 const StatusCode_BUILD_DOCKER_CACHE_FROM_PULL_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_CACHE_FROM_PULL_ERR)
 const StatusCode_BUILD_DOCKER_GET_DIGEST_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_GET_DIGEST_ERR)
 const StatusCode_BUILD_DOCKER_NO_SPACE_ERR = StatusCode(enums.StatusCode_BUILD_DOCKER_NO_SPACE_ERR)
+const StatusCode_BUILD_REGISTRY_SERVER_ERR = StatusCode(enums.StatusCode_BUILD_REGISTRY_SERVER_ERR)
+const StatusCode_BUILD_HOST_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_HOST_NOT_FOUND)
+const StatusCode_BUILD_REGISTRY_TLS_TIMEOUT = StatusCode(enums.StatusCode_BUILD_REGISTRY_TLS_TIMEOUT)
+const StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR = StatusCode(enums.StatusCode_BUILD_KANIKO_PUSH_PERMISSION_ERR)
+const StatusCode_BUILD_INVALID_IMAGE_REFERENCE = StatusCode(enums.StatusCode_BUILD_INVALID_IMAGE_REFERENCE)
+const StatusCode_BUILD_REGISTRY_CONNECTION_RESET = StatusCode(enums.StatusCode_BUILD_REGISTRY_CONNECTION_RESET)
+const StatusCode_BUILD_COPY_SOURCE_NOT_FOUND = StatusCode(enums.StatusCode_BUILD_COPY_SOURCE_NOT_FOUND)
+const StatusCode_BUILD_CORRUPT_LOCAL_IMAGE = StatusCode(enums.StatusCode_BUILD_CORRUPT_LOCAL_IMAGE)
 const StatusCode_BUILD_REGISTRY_GET_DIGEST_ERR = StatusCode(enums.StatusCode_BUILD_REGISTRY_GET_DIGEST_ERR)
 const StatusCode_BUILD_UNKNOWN_JIB_PLUGIN_TYPE = StatusCode(enums.StatusCode_BUILD_UNKNOWN_JIB_PLUGIN_TYPE)
 const StatusCode_BUILD_JIB_GRADLE_DEP_ERR = StatusCode(enums.StatusCode_BUILD_JIB_GRADLE_DEP_ERR)
@@ -155,6 +163,8 @@ const StatusCode_STATUSCHECK_CONTAINER_CREATING = StatusCode(enums.StatusCode_ST
 const StatusCode_STATUSCHECK_RUN_CONTAINER_ERR = StatusCode(enums.StatusCode_STATUSCHECK_RUN_CONTAINER_ERR)
 const StatusCode_STATUSCHECK_CONTAINER_TERMINATED = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_TERMINATED)
 const StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_ROLLOUT_PENDING)
+const StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN = StatusCode(enums.StatusCode_STATUSCHECK_IMAGE_MANIFEST_UNKNOWN)
+const StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RUN_AS_ROOT)
 const StatusCode_STATUSCHECK_CONTAINER_RESTARTING = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_RESTARTING)
 const StatusCode_STATUSCHECK_UNHEALTHY = StatusCode(enums.StatusCode_STATUSCHECK_UNHEALTHY)
 const StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR = StatusCode(enums.StatusCode_STATUSCHECK_CONTAINER_EXEC_ERROR)
@@ -170,6 +180,14 @@ const StatusCode_STATUSCHECK_KUBECTL_CONNECTION_ERR = StatusCode(enums.StatusCod
 const StatusCode_STATUSCHECK_KUBECTL_PID_KILLED = StatusCode(enums.StatusCode_STATUSCHECK_KUBECTL_PID_KILLED)
 const StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR = StatusCode(enums.StatusCode_STATUSCHECK_KUBECTL_CLIENT_FETCH_ERR)
 const StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR = StatusCode(enums.StatusCode_STATUSCHECK_DEPLOYMENT_FETCH_ERR)
+const StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH = StatusCode(enums.StatusCode_STATUSCHECK_NODE_SELECTOR_MISMATCH)
+const StatusCode_STATUSCHECK_UNTOLERATED_TAINT = StatusCode(enums.StatusCode_STATUSCHECK_UNTOLERATED_TAINT)
+const StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED = StatusCode(enums.StatusCode_STATUSCHECK_CLUSTER_UNAUTHORIZED)
+const StatusCode_STATUSCHECK_UNBOUND_PVC = StatusCode(enums.StatusCode_STATUSCHECK_UNBOUND_PVC)
+const StatusCode_STATUSCHECK_POD_EVICTED = StatusCode(enums.StatusCode_STATUSCHECK_POD_EVICTED)
+const StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY = StatusCode(enums.StatusCode_STATUSCHECK_CLUSTER_UNKNOWN_AUTHORITY)
+const StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT = StatusCode(enums.StatusCode_STATUSCHECK_JOB_BACKOFF_LIMIT)
+const StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS = StatusCode(enums.StatusCode_STATUSCHECK_SERVICE_NO_ENDPOINTS)
 const StatusCode_STATUSCHECK_POD_INITIALIZING = StatusCode(enums.StatusCode_STATUSCHECK_POD_INITIALIZING)
 const StatusCode_UNKNOWN_ERROR = StatusCode(enums.StatusCode_UNKNOWN_ERROR)
 const StatusCode_STATUSCHECK_UNKNOWN = StatusCode(enums.StatusCode_STATUSCHECK_UNKNOWN)
@@ -184,12 +202,18 @@ const StatusCode_CLEANUP_UNKNOWN = StatusCode(enums.StatusCode_CLEANUP_UNKNOWN)
 const StatusCode_INIT_UNKNOWN = StatusCode(enums.StatusCode_INIT_UNKNOWN)
 const StatusCode_BUILD_DOCKER_UNKNOWN = StatusCode(enums.StatusCode_BUILD_DOCKER_UNKNOWN)
 const StatusCode_TEST_UNKNOWN = StatusCode(enums.StatusCode_TEST_UNKNOWN)
+const StatusCode_RENDER_UNKNOWN = StatusCode(enums.StatusCode_RENDER_UNKNOWN)
 const StatusCode_SYNC_INIT_ERROR = StatusCode(enums.StatusCode_SYNC_INIT_ERROR)
+const StatusCode_SYNC_PERMISSION_DENIED = StatusCode(enums.StatusCode_SYNC_PERMISSION_DENIED)
 const StatusCode_DEVINIT_REGISTER_BUILD_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_BUILD_DEPS)
 const StatusCode_DEVINIT_REGISTER_TEST_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_TEST_DEPS)
 const StatusCode_DEVINIT_REGISTER_DEPLOY_DEPS = StatusCode(enums.StatusCode_DEVINIT_REGISTER_DEPLOY_DEPS)
 const StatusCode_DEVINIT_REGISTER_CONFIG_DEP = StatusCode(enums.StatusCode_DEVINIT_REGISTER_CONFIG_DEP)
 const StatusCode_DEVINIT_UNSUPPORTED_V1_MANIFEST = StatusCode(enums.StatusCode_DEVINIT_UNSUPPORTED_V1_MANIFEST)
+const StatusCode_DEVINIT_WATCH_PODS_FAILED = StatusCode(enums.StatusCode_DEVINIT_WATCH_PODS_FAILED)
+const StatusCode_DEVINIT_NO_AVAILABLE_PORT = StatusCode(enums.StatusCode_DEVINIT_NO_AVAILABLE_PORT)
+const StatusCode_DEVINIT_INOTIFY_LIMIT = StatusCode(enums.StatusCode_DEVINIT_INOTIFY_LIMIT)
+const StatusCode_DEVINIT_RPC_CONNECTION_REFUSED = StatusCode(enums.StatusCode_DEVINIT_RPC_CONNECTION_REFUSED)
 const StatusCode_STATUSCHECK_USER_CANCELLED = StatusCode(enums.StatusCode_STATUSCHECK_USER_CANCELLED)
 const StatusCode_STATUSCHECK_DEADLINE_EXCEEDED = StatusCode(enums.StatusCode_STATUSCHECK_DEADLINE_EXCEEDED)
 const StatusCode_BUILD_CANCELLED = StatusCode(enums.StatusCode_BUILD_CANCELLED)
@@ -205,6 +229,10 @@ const StatusCode_INIT_CREATE_TEST_DEP_ERROR = StatusCode(enums.StatusCode_INIT_C
 const StatusCode_INIT_CACHE_ERROR = StatusCode(enums.StatusCode_INIT_CACHE_ERROR)
 const StatusCode_INIT_CREATE_WATCH_TRIGGER_ERROR = StatusCode(enums.StatusCode_INIT_CREATE_WATCH_TRIGGER_ERROR)
 const StatusCode_INIT_CREATE_ARTIFACT_DEP_ERROR = StatusCode(enums.StatusCode_INIT_CREATE_ARTIFACT_DEP_ERROR)
+const StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND = StatusCode(enums.StatusCode_INIT_MINIKUBE_PROFILE_NOT_FOUND)
+const StatusCode_INIT_KIND_CLUSTER_NOT_FOUND = StatusCode(enums.StatusCode_INIT_KIND_CLUSTER_NOT_FOUND)
+const StatusCode_INIT_HOST_NOT_FOUND = StatusCode(enums.StatusCode_INIT_HOST_NOT_FOUND)
+const StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND = StatusCode(enums.StatusCode_INIT_COMPOSE_NETWORK_NOT_FOUND)
 const StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_CONNECTION_ERR)
 const StatusCode_DEPLOY_DEBUG_HELPER_RETRIEVE_ERR = StatusCode(enums.StatusCode_DEPLOY_DEBUG_HELPER_RETRIEVE_ERR)
 const StatusCode_DEPLOY_CLEANUP_ERR = StatusCode(enums.StatusCode_DEPLOY_CLEANUP_ERR)
@@ -229,6 +257,25 @@ const StatusCode_DEPLOY_PARSE_MANIFEST_IMAGES_ERR = StatusCode(enums.StatusCode_
 const StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE = StatusCode(enums.StatusCode_DEPLOY_HELM_CREATE_NS_NOT_AVAILABLE)
 const StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_INTERNAL_SYSTEM_ERR)
 const StatusCode_DEPLOY_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_DEPLOY_KPTFILE_INIT_ERR)
+const StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR = StatusCode(enums.StatusCode_DEPLOY_MANIFEST_VALIDATION_ERR)
+const StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_UNAUTHORIZED)
+const StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED = StatusCode(enums.StatusCode_DEPLOY_ADMISSION_WEBHOOK_DENIED)
+const StatusCode_DEPLOY_HELM_CHART_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_HELM_CHART_NOT_FOUND)
+const StatusCode_DEPLOY_HOST_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_HOST_NOT_FOUND)
+const StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT = StatusCode(enums.StatusCode_DEPLOY_REGISTRY_TLS_TIMEOUT)
+const StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG = StatusCode(enums.StatusCode_DEPLOY_ANNOTATIONS_TOO_LONG)
+const StatusCode_DEPLOY_HELM_WAIT_TIMEOUT = StatusCode(enums.StatusCode_DEPLOY_HELM_WAIT_TIMEOUT)
+const StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE = StatusCode(enums.StatusCode_DEPLOY_INVALID_IMAGE_REFERENCE)
+const StatusCode_DEPLOY_IMMUTABLE_FIELD = StatusCode(enums.StatusCode_DEPLOY_IMMUTABLE_FIELD)
+const StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN = StatusCode(enums.StatusCode_DEPLOY_KUBECTL_NOT_LOGGED_IN)
+const StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_REFERENCED_RESOURCE_NOT_FOUND)
+const StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR = StatusCode(enums.StatusCode_DEPLOY_HELM_VALUES_PARSE_ERR)
+const StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET = StatusCode(enums.StatusCode_DEPLOY_REGISTRY_CONNECTION_RESET)
+const StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY = StatusCode(enums.StatusCode_DEPLOY_CLUSTER_UNKNOWN_AUTHORITY)
+const StatusCode_DEPLOY_REMOVED_API_VERSION = StatusCode(enums.StatusCode_DEPLOY_REMOVED_API_VERSION)
+const StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE = StatusCode(enums.StatusCode_DEPLOY_EMPTY_CONTAINER_IMAGE)
+const StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND = StatusCode(enums.StatusCode_DEPLOY_CLEANUP_RESOURCE_NOT_FOUND)
+const StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR = StatusCode(enums.StatusCode_DEPLOY_MANIFEST_YAML_SYNTAX_ERR)
 const StatusCode_TEST_USER_CONFIG_ERR = StatusCode(enums.StatusCode_TEST_USER_CONFIG_ERR)
 const StatusCode_TEST_CST_USER_ERR = StatusCode(enums.StatusCode_TEST_CST_USER_ERR)
 const StatusCode_TEST_IMG_PULL_ERR = StatusCode(enums.StatusCode_TEST_IMG_PULL_ERR)
@@ -245,6 +292,9 @@ const StatusCode_TEST_CUSTOM_CMD_RETRIEVE_ERR = StatusCode(enums.StatusCode_TEST
 const StatusCode_RENDER_KPTFILE_INIT_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INIT_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_YAML_ERR)
 const StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR = StatusCode(enums.StatusCode_RENDER_KPTFILE_INVALID_SCHEMA_ERR)
+const StatusCode_RENDER_HELM_TEMPLATE_ERR = StatusCode(enums.StatusCode_RENDER_HELM_TEMPLATE_ERR)
+const StatusCode_RENDER_KUSTOMIZE_BUILD_ERR = StatusCode(enums.StatusCode_RENDER_KUSTOMIZE_BUILD_ERR)
+const StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE = StatusCode(enums.StatusCode_RENDER_VALIDATION_SCHEMA_UNAVAILABLE)
 const StatusCode_CONFIG_FILE_PARSING_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_PARSING_ERR)
 const StatusCode_CONFIG_FILE_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_FILE_NOT_FOUND_ERR)
 const StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR = StatusCode(enums.StatusCode_CONFIG_DEPENDENCY_NOT_FOUND_ERR)
@@ -261,6 +311,7 @@ const StatusCode_CONFIG_UNKNOWN_API_VERSION_ERR = StatusCode(enums.StatusCode_CO
 const StatusCode_CONFIG_UNKNOWN_VALIDATOR = StatusCode(enums.StatusCode_CONFIG_UNKNOWN_VALIDATOR)
 const StatusCode_CONFIG_UNKNOWN_TRANSFORMER = StatusCode(enums.StatusCode_CONFIG_UNKNOWN_TRANSFORMER)
 const StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR = StatusCode(enums.StatusCode_CONFIG_MISSING_MANIFEST_FILE_ERR)
+const StatusCode_CONFIG_FIELD_TYPE_ERR = StatusCode(enums.StatusCode_CONFIG_FIELD_TYPE_ERR)
 const StatusCode_INSPECT_UNKNOWN_ERR = StatusCode(enums.StatusCode_INSPECT_UNKNOWN_ERR)
 const StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR = StatusCode(enums.StatusCode_INSPECT_BUILD_ENV_ALREADY_EXISTS_ERR)
 const StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR = StatusCode(enums.StatusCode_INSPECT_BUILD_ENV_INCORRECT_TYPE_ERR)
@@ -290,6 +341,13 @@ const SuggestionCode_CHECK_DOCKER_NETWORK_CONTAINER_RUNNING = SuggestionCode(enu
 const SuggestionCode_FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME = SuggestionCode(enums.SuggestionCode_FIX_DOCKER_NETWORK_MODE_WHEN_EXTRACTING_CONTAINER_NAME)
 const SuggestionCode_RUN_DOCKER_PRUNE = SuggestionCode(enums.SuggestionCode_RUN_DOCKER_PRUNE)
 const SuggestionCode_SET_CLEANUP_FLAG = SuggestionCode(enums.SuggestionCode_SET_CLEANUP_FLAG)
+const SuggestionCode_GRANT_GCP_REGISTRY_ROLE = SuggestionCode(enums.SuggestionCode_GRANT_GCP_REGISTRY_ROLE)
+const SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY = SuggestionCode(enums.SuggestionCode_CHECK_GKE_WORKLOAD_IDENTITY)
+const SuggestionCode_RETRY_REGISTRY_PUSH = SuggestionCode(enums.SuggestionCode_RETRY_REGISTRY_PUSH)
+const SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION = SuggestionCode(enums.SuggestionCode_CHECK_KANIKO_PUSH_DESTINATION)
+const SuggestionCode_FIX_IMAGE_NAME = SuggestionCode(enums.SuggestionCode_FIX_IMAGE_NAME)
+const SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE = SuggestionCode(enums.SuggestionCode_FIX_DOCKERFILE_COPY_SOURCE)
+const SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE = SuggestionCode(enums.SuggestionCode_REMOVE_CORRUPT_LOCAL_IMAGE)
 const SuggestionCode_CHECK_CLUSTER_CONNECTION = SuggestionCode(enums.SuggestionCode_CHECK_CLUSTER_CONNECTION)
 const SuggestionCode_CHECK_MINIKUBE_STATUS = SuggestionCode(enums.SuggestionCode_CHECK_MINIKUBE_STATUS)
 const SuggestionCode_INSTALL_HELM = SuggestionCode(enums.SuggestionCode_INSTALL_HELM)
@@ -297,10 +355,26 @@ const SuggestionCode_UPGRADE_HELM = SuggestionCode(enums.SuggestionCode_UPGRADE_
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_ARTIFACT_OVERRIDES)
 const SuggestionCode_UPGRADE_HELM32 = SuggestionCode(enums.SuggestionCode_UPGRADE_HELM32)
 const SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE = SuggestionCode(enums.SuggestionCode_FIX_SKAFFOLD_CONFIG_HELM_CREATE_NAMESPACE)
+const SuggestionCode_FIX_INVALID_MANIFEST_FIELD = SuggestionCode(enums.SuggestionCode_FIX_INVALID_MANIFEST_FIELD)
+const SuggestionCode_CHECK_ADMISSION_POLICY = SuggestionCode(enums.SuggestionCode_CHECK_ADMISSION_POLICY)
+const SuggestionCode_ADD_HELM_REPO = SuggestionCode(enums.SuggestionCode_ADD_HELM_REPO)
+const SuggestionCode_FIX_HELM_CHART_VERSION = SuggestionCode(enums.SuggestionCode_FIX_HELM_CHART_VERSION)
+const SuggestionCode_USE_SERVER_SIDE_APPLY = SuggestionCode(enums.SuggestionCode_USE_SERVER_SIDE_APPLY)
+const SuggestionCode_INCREASE_HELM_TIMEOUT = SuggestionCode(enums.SuggestionCode_INCREASE_HELM_TIMEOUT)
+const SuggestionCode_RECREATE_RESOURCE = SuggestionCode(enums.SuggestionCode_RECREATE_RESOURCE)
+const SuggestionCode_LOGIN_TO_CLUSTER = SuggestionCode(enums.SuggestionCode_LOGIN_TO_CLUSTER)
+const SuggestionCode_CREATE_REFERENCED_RESOURCE = SuggestionCode(enums.SuggestionCode_CREATE_REFERENCED_RESOURCE)
+const SuggestionCode_FIX_HELM_VALUES = SuggestionCode(enums.SuggestionCode_FIX_HELM_VALUES)
+const SuggestionCode_UPDATE_API_VERSION = SuggestionCode(enums.SuggestionCode_UPDATE_API_VERSION)
+const SuggestionCode_CHECK_IMAGE_SUBSTITUTION = SuggestionCode(enums.SuggestionCode_CHECK_IMAGE_SUBSTITUTION)
 const SuggestionCode_INSTALL_KUBECTL = SuggestionCode(enums.SuggestionCode_INSTALL_KUBECTL)
+const SuggestionCode_FIX_MANIFEST_YAML = SuggestionCode(enums.SuggestionCode_FIX_MANIFEST_YAML)
 const SuggestionCode_CHECK_CONTAINER_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_LOGS)
 const SuggestionCode_CHECK_READINESS_PROBE = SuggestionCode(enums.SuggestionCode_CHECK_READINESS_PROBE)
 const SuggestionCode_CHECK_CONTAINER_IMAGE = SuggestionCode(enums.SuggestionCode_CHECK_CONTAINER_IMAGE)
+const SuggestionCode_VERIFY_IMAGE_PUSHED = SuggestionCode(enums.SuggestionCode_VERIFY_IMAGE_PUSHED)
+const SuggestionCode_BUILD_MULTI_ARCH_IMAGE = SuggestionCode(enums.SuggestionCode_BUILD_MULTI_ARCH_IMAGE)
+const SuggestionCode_RUN_AS_NON_ROOT_USER = SuggestionCode(enums.SuggestionCode_RUN_AS_NON_ROOT_USER)
 const SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_MEMORY_PRESSURE)
 const SuggestionCode_ADDRESS_NODE_DISK_PRESSURE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_DISK_PRESSURE)
 const SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_NETWORK_UNAVAILABLE)
@@ -310,12 +384,32 @@ const SuggestionCode_ADDRESS_NODE_UNREACHABLE = SuggestionCode(enums.SuggestionC
 const SuggestionCode_ADDRESS_NODE_NOT_READY = SuggestionCode(enums.SuggestionCode_ADDRESS_NODE_NOT_READY)
 const SuggestionCode_ADDRESS_FAILED_SCHEDULING = SuggestionCode(enums.SuggestionCode_ADDRESS_FAILED_SCHEDULING)
 const SuggestionCode_CHECK_HOST_CONNECTION = SuggestionCode(enums.SuggestionCode_CHECK_HOST_CONNECTION)
+const SuggestionCode_FIX_NODE_SELECTOR = SuggestionCode(enums.SuggestionCode_FIX_NODE_SELECTOR)
+const SuggestionCode_ADD_TOLERATIONS = SuggestionCode(enums.SuggestionCode_ADD_TOLERATIONS)
+const SuggestionCode_REFRESH_CLUSTER_CREDENTIALS = SuggestionCode(enums.SuggestionCode_REFRESH_CLUSTER_CREDENTIALS)
+const SuggestionCode_CHECK_HOST_RESOLUTION = SuggestionCode(enums.SuggestionCode_CHECK_HOST_RESOLUTION)
+const SuggestionCode_CHECK_REGISTRY_CONNECTIVITY = SuggestionCode(enums.SuggestionCode_CHECK_REGISTRY_CONNECTIVITY)
+const SuggestionCode_CHECK_PVC_PROVISIONING = SuggestionCode(enums.SuggestionCode_CHECK_PVC_PROVISIONING)
+const SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE = SuggestionCode(enums.SuggestionCode_CHECK_NODE_RESOURCE_PRESSURE)
+const SuggestionCode_CONFIGURE_CLUSTER_CA = SuggestionCode(enums.SuggestionCode_CONFIGURE_CLUSTER_CA)
+const SuggestionCode_CHECK_JOB_LOGS = SuggestionCode(enums.SuggestionCode_CHECK_JOB_LOGS)
+const SuggestionCode_CHECK_SERVICE_ENDPOINTS = SuggestionCode(enums.SuggestionCode_CHECK_SERVICE_ENDPOINTS)
 const SuggestionCode_START_MINIKUBE = SuggestionCode(enums.SuggestionCode_START_MINIKUBE)
 const SuggestionCode_UNPAUSE_MINIKUBE = SuggestionCode(enums.SuggestionCode_UNPAUSE_MINIKUBE)
+const SuggestionCode_CREATE_KIND_CLUSTER = SuggestionCode(enums.SuggestionCode_CREATE_KIND_CLUSTER)
 const SuggestionCode_RUN_DOCKER_PULL = SuggestionCode(enums.SuggestionCode_RUN_DOCKER_PULL)
+const SuggestionCode_CHECK_POD_WATCH_PERMISSIONS = SuggestionCode(enums.SuggestionCode_CHECK_POD_WATCH_PERMISSIONS)
+const SuggestionCode_CREATE_COMPOSE_NETWORK = SuggestionCode(enums.SuggestionCode_CREATE_COMPOSE_NETWORK)
+const SuggestionCode_FREE_LOCAL_PORTS = SuggestionCode(enums.SuggestionCode_FREE_LOCAL_PORTS)
+const SuggestionCode_RAISE_INOTIFY_LIMITS = SuggestionCode(enums.SuggestionCode_RAISE_INOTIFY_LIMITS)
+const SuggestionCode_CHECK_RPC_PORT = SuggestionCode(enums.SuggestionCode_CHECK_RPC_PORT)
+const SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS = SuggestionCode(enums.SuggestionCode_CHECK_SYNC_FILE_PERMISSIONS)
 const SuggestionCode_SET_RENDER_FLAG_OFFLINE_FALSE = SuggestionCode(enums.SuggestionCode_SET_RENDER_FLAG_OFFLINE_FALSE)
 const SuggestionCode_KPTFILE_MANUAL_INIT = SuggestionCode(enums.SuggestionCode_KPTFILE_MANUAL_INIT)
 const SuggestionCode_KPTFILE_CHECK_YAML = SuggestionCode(enums.SuggestionCode_KPTFILE_CHECK_YAML)
+const SuggestionCode_FIX_HELM_TEMPLATE = SuggestionCode(enums.SuggestionCode_FIX_HELM_TEMPLATE)
+const SuggestionCode_FIX_KUSTOMIZATION = SuggestionCode(enums.SuggestionCode_FIX_KUSTOMIZATION)
+const SuggestionCode_DISABLE_CLIENT_VALIDATION = SuggestionCode(enums.SuggestionCode_DISABLE_CLIENT_VALIDATION)
 const SuggestionCode_CONFIG_CHECK_FILE_PATH = SuggestionCode(enums.SuggestionCode_CONFIG_CHECK_FILE_PATH)
 const SuggestionCode_CONFIG_CHECK_DEPENDENCY_DEFINITION = SuggestionCode(enums.SuggestionCode_CONFIG_CHECK_DEPENDENCY_DEFINITION)
 const SuggestionCode_CONFIG_CHANGE_NAMES = SuggestionCode(enums.SuggestionCode_CONFIG_CHANGE_NAMES)
@@ -327,6 +421,7 @@ const SuggestionCode_CONFIG_FIX_API_VERSION = SuggestionCode(enums.SuggestionCod
 const SuggestionCode_CONFIG_ALLOWLIST_VALIDATORS = SuggestionCode(enums.SuggestionCode_CONFIG_ALLOWLIST_VALIDATORS)
 const SuggestionCode_CONFIG_ALLOWLIST_transformers = SuggestionCode(enums.SuggestionCode_CONFIG_ALLOWLIST_transformers)
 const SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE = SuggestionCode(enums.SuggestionCode_CONFIG_FIX_MISSING_MANIFEST_FILE)
+const SuggestionCode_CONFIG_FIX_FIELD_TYPE = SuggestionCode(enums.SuggestionCode_CONFIG_FIX_FIELD_TYPE)
 const SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE = SuggestionCode(enums.SuggestionCode_INSPECT_USE_MODIFY_OR_NEW_PROFILE)
 const SuggestionCode_INSPECT_USE_ADD_BUILD_ENV = SuggestionCode(enums.SuggestionCode_INSPECT_USE_ADD_BUILD_ENV)
 const SuggestionCode_INSPECT_CHECK_INPUT_PROFILE = SuggestionCode(enums.SuggestionCode_INSPECT_CHECK_INPUT_PROFILE)
@@ -1373,6 +1468,7 @@ type Event struct {
 	//	*Event_TerminationEvent
 	//	*Event_TestEvent
 	//	*Event_RenderEvent
+	//	*Event_PortForwardReadinessEvent
 	EventType            isEvent_EventType `protobuf_oneof:"event_type"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
@@ -1467,6 +1563,10 @@ type Event_RenderEvent struct {
 	RenderEvent *RenderSubtaskEvent `protobuf:"bytes,14,opt,name=renderEvent,proto3,oneof"`
 }
 
+type Event_PortForwardReadinessEvent struct {
+	PortForwardReadinessEvent *PortForwardReadinessEvent `protobuf:"bytes,15,opt,name=portForwardReadinessEvent,proto3,oneof"`
+}
+
 func (*Event_MetaEvent) isEvent_EventType() {}
 
 func (*Event_SkaffoldLogEvent) isEvent_EventType() {}
@@ -1493,6 +1593,8 @@ func (*Event_TestEvent) isEvent_EventType() {}
 
 func (*Event_RenderEvent) isEvent_EventType() {}
 
+func (*Event_PortForwardReadinessEvent) isEvent_EventType() {}
+
 func (m *Event) GetEventType() isEvent_EventType {
 	if m != nil {
 		return m.EventType
@@ -1591,6 +1693,13 @@ func (m *Event) GetRenderEvent() *RenderSubtaskEvent {
 	return nil
 }
 
+func (m *Event) GetPortForwardReadinessEvent() *PortForwardReadinessEvent {
+	if x, ok := m.GetEventType().(*Event_PortForwardReadinessEvent); ok {
+		return x.PortForwardReadinessEvent
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Event) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -1607,6 +1716,7 @@ func (*Event) XXX_OneofWrappers() []interface{} {
 		(*Event_TerminationEvent)(nil),
 		(*Event_TestEvent)(nil),
 		(*Event_RenderEvent)(nil),
+		(*Event_PortForwardReadinessEvent)(nil),
 	}
 }
 
@@ -2360,6 +2470,8 @@ type PortForwardEvent struct {
 	ResourceName         string       `protobuf:"bytes,9,opt,name=resourceName,proto3" json:"resourceName,omitempty"`
 	Address              string       `protobuf:"bytes,10,opt,name=address,proto3" json:"address,omitempty"`
 	TargetPort           *IntOrString `protobuf:"bytes,11,opt,name=targetPort,proto3" json:"targetPort,omitempty"`
+	WebSocketUrl         string       `protobuf:"bytes,12,opt,name=webSocketUrl,proto3" json:"webSocketUrl,omitempty"`
+	Iteration            int32        `protobuf:"varint,13,opt,name=iteration,proto3" json:"iteration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
 	XXX_unrecognized     []byte       `json:"-"`
 	XXX_sizecache        int32        `json:"-"`
@@ -2467,6 +2579,84 @@ func (m *PortForwardEvent) GetTargetPort() *IntOrString {
 	return nil
 }
 
+func (m *PortForwardEvent) GetWebSocketUrl() string {
+	if m != nil {
+		return m.WebSocketUrl
+	}
+	return ""
+}
+
+func (m *PortForwardEvent) GetIteration() int32 {
+	if m != nil {
+		return m.Iteration
+	}
+	return 0
+}
+
+// PortForwardReadinessEvent describes the aggregate readiness of all active port forwards.
+type PortForwardReadinessEvent struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Ready                bool     `protobuf:"varint,2,opt,name=ready,proto3" json:"ready,omitempty"`
+	ReadyCount           int32    `protobuf:"varint,3,opt,name=readyCount,proto3" json:"readyCount,omitempty"`
+	TotalCount           int32    `protobuf:"varint,4,opt,name=totalCount,proto3" json:"totalCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PortForwardReadinessEvent) Reset()         { *m = PortForwardReadinessEvent{} }
+func (m *PortForwardReadinessEvent) String() string { return proto.CompactTextString(m) }
+func (*PortForwardReadinessEvent) ProtoMessage()    {}
+func (*PortForwardReadinessEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_39088757fd9c8e40, []int{28}
+}
+
+func (m *PortForwardReadinessEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PortForwardReadinessEvent.Unmarshal(m, b)
+}
+func (m *PortForwardReadinessEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PortForwardReadinessEvent.Marshal(b, m, deterministic)
+}
+func (m *PortForwardReadinessEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PortForwardReadinessEvent.Merge(m, src)
+}
+func (m *PortForwardReadinessEvent) XXX_Size() int {
+	return xxx_messageInfo_PortForwardReadinessEvent.Size(m)
+}
+func (m *PortForwardReadinessEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_PortForwardReadinessEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PortForwardReadinessEvent proto.InternalMessageInfo
+
+func (m *PortForwardReadinessEvent) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *PortForwardReadinessEvent) GetReady() bool {
+	if m != nil {
+		return m.Ready
+	}
+	return false
+}
+
+func (m *PortForwardReadinessEvent) GetReadyCount() int32 {
+	if m != nil {
+		return m.ReadyCount
+	}
+	return 0
+}
+
+func (m *PortForwardReadinessEvent) GetTotalCount() int32 {
+	if m != nil {
+		return m.TotalCount
+	}
+	return 0
+}
+
 // FileSyncEvent describes the sync status.
 type FileSyncEvent struct {
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -2484,7 +2674,7 @@ func (m *FileSyncEvent) Reset()         { *m = FileSyncEvent{} }
 func (m *FileSyncEvent) String() string { return proto.CompactTextString(m) }
 func (*FileSyncEvent) ProtoMessage()    {}
 func (*FileSyncEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{28}
+	return fileDescriptor_39088757fd9c8e40, []int{29}
 }
 
 func (m *FileSyncEvent) XXX_Unmarshal(b []byte) error {
@@ -2568,7 +2758,7 @@ func (m *DebuggingContainerEvent) Reset()         { *m = DebuggingContainerEvent
 func (m *DebuggingContainerEvent) String() string { return proto.CompactTextString(m) }
 func (*DebuggingContainerEvent) ProtoMessage()    {}
 func (*DebuggingContainerEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{29}
+	return fileDescriptor_39088757fd9c8e40, []int{30}
 }
 
 func (m *DebuggingContainerEvent) XXX_Unmarshal(b []byte) error {
@@ -2670,7 +2860,7 @@ func (m *UserIntentRequest) Reset()         { *m = UserIntentRequest{} }
 func (m *UserIntentRequest) String() string { return proto.CompactTextString(m) }
 func (*UserIntentRequest) ProtoMessage()    {}
 func (*UserIntentRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{30}
+	return fileDescriptor_39088757fd9c8e40, []int{31}
 }
 
 func (m *UserIntentRequest) XXX_Unmarshal(b []byte) error {
@@ -2709,7 +2899,7 @@ func (m *TriggerRequest) Reset()         { *m = TriggerRequest{} }
 func (m *TriggerRequest) String() string { return proto.CompactTextString(m) }
 func (*TriggerRequest) ProtoMessage()    {}
 func (*TriggerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{31}
+	return fileDescriptor_39088757fd9c8e40, []int{32}
 }
 
 func (m *TriggerRequest) XXX_Unmarshal(b []byte) error {
@@ -2751,7 +2941,7 @@ func (m *TriggerState) Reset()         { *m = TriggerState{} }
 func (m *TriggerState) String() string { return proto.CompactTextString(m) }
 func (*TriggerState) ProtoMessage()    {}
 func (*TriggerState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{32}
+	return fileDescriptor_39088757fd9c8e40, []int{33}
 }
 
 func (m *TriggerState) XXX_Unmarshal(b []byte) error {
@@ -2817,7 +3007,7 @@ func (m *Intent) Reset()         { *m = Intent{} }
 func (m *Intent) String() string { return proto.CompactTextString(m) }
 func (*Intent) ProtoMessage()    {}
 func (*Intent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{33}
+	return fileDescriptor_39088757fd9c8e40, []int{34}
 }
 
 func (m *Intent) XXX_Unmarshal(b []byte) error {
@@ -2872,7 +3062,7 @@ func (m *Suggestion) Reset()         { *m = Suggestion{} }
 func (m *Suggestion) String() string { return proto.CompactTextString(m) }
 func (*Suggestion) ProtoMessage()    {}
 func (*Suggestion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{34}
+	return fileDescriptor_39088757fd9c8e40, []int{35}
 }
 
 func (m *Suggestion) XXX_Unmarshal(b []byte) error {
@@ -2921,7 +3111,7 @@ func (m *IntOrString) Reset()         { *m = IntOrString{} }
 func (m *IntOrString) String() string { return proto.CompactTextString(m) }
 func (*IntOrString) ProtoMessage()    {}
 func (*IntOrString) Descriptor() ([]byte, []int) {
-	return fileDescriptor_39088757fd9c8e40, []int{35}
+	return fileDescriptor_39088757fd9c8e40, []int{36}
 }
 
 func (m *IntOrString) XXX_Unmarshal(b []byte) error {
@@ -3001,6 +3191,7 @@ func init() {
 	proto.RegisterType((*DeploySubtaskEvent)(nil), "proto.v2.DeploySubtaskEvent")
 	proto.RegisterType((*StatusCheckSubtaskEvent)(nil), "proto.v2.StatusCheckSubtaskEvent")
 	proto.RegisterType((*PortForwardEvent)(nil), "proto.v2.PortForwardEvent")
+	proto.RegisterType((*PortForwardReadinessEvent)(nil), "proto.v2.PortForwardReadinessEvent")
 	proto.RegisterType((*FileSyncEvent)(nil), "proto.v2.FileSyncEvent")
 	proto.RegisterType((*DebuggingContainerEvent)(nil), "proto.v2.DebuggingContainerEvent")
 	proto.RegisterMapType((map[string]uint32)(nil), "proto.v2.DebuggingContainerEvent.DebugPortsEntry")
@@ -3015,154 +3206,160 @@ func init() {
 func init() { proto.RegisterFile("v2/skaffold.proto", fileDescriptor_39088757fd9c8e40) }
 
 var fileDescriptor_39088757fd9c8e40 = []byte{
-	// 2349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x6f, 0x1b, 0xc9,
-	0xf1, 0xf7, 0x90, 0x1a, 0x92, 0x53, 0x14, 0x69, 0xa9, 0x65, 0x5b, 0xfc, 0xd3, 0xb2, 0xd7, 0x9e,
-	0xdd, 0xfd, 0xc7, 0xfb, 0x22, 0x6d, 0x3a, 0x59, 0x2f, 0x8c, 0x78, 0x37, 0xf2, 0x53, 0x8a, 0x5f,
-	0xeb, 0x96, 0x76, 0x81, 0x3c, 0x36, 0xc6, 0x68, 0xa6, 0x45, 0x0f, 0x44, 0xce, 0x30, 0x33, 0x4d,
-	0xed, 0xf2, 0x16, 0xe4, 0x10, 0xe4, 0x90, 0x53, 0xb2, 0xa7, 0x9c, 0x16, 0xc8, 0x29, 0xf7, 0x7c,
-	0x83, 0x00, 0xf9, 0x02, 0x01, 0xf2, 0x01, 0x82, 0x1c, 0x82, 0x20, 0xe7, 0x9c, 0x83, 0x7e, 0xcd,
-	0x74, 0xcf, 0x90, 0x96, 0x65, 0xc7, 0x48, 0x2e, 0xd2, 0x74, 0xf7, 0xaf, 0xaa, 0xab, 0xab, 0x7f,
-	0x5d, 0x5d, 0xd5, 0x84, 0xd5, 0xc3, 0x41, 0x3f, 0x3d, 0xf0, 0xf6, 0xf7, 0xe3, 0x51, 0xd0, 0x9b,
-	0x24, 0x31, 0x8d, 0x51, 0x83, 0xff, 0xeb, 0x1d, 0x0e, 0xba, 0x1b, 0xc3, 0x38, 0x1e, 0x8e, 0x48,
-	0xdf, 0x9b, 0x84, 0x7d, 0x2f, 0x8a, 0x62, 0xea, 0xd1, 0x30, 0x8e, 0x52, 0x81, 0xeb, 0xbe, 0x21,
-	0x47, 0x79, 0x6b, 0x6f, 0xba, 0xdf, 0xa7, 0xe1, 0x98, 0xa4, 0xd4, 0x1b, 0x4f, 0x24, 0xe0, 0x6c,
-	0x11, 0x40, 0xc6, 0x13, 0x3a, 0x93, 0x83, 0xab, 0x24, 0x9a, 0x8e, 0xd3, 0x3e, 0xff, 0x2b, 0xba,
-	0xdc, 0x0f, 0xa1, 0xb5, 0x43, 0x3d, 0x4a, 0x30, 0x49, 0x27, 0x71, 0x94, 0x12, 0xf4, 0x36, 0xd8,
-	0x29, 0xeb, 0xe8, 0x58, 0x17, 0xac, 0x4b, 0xcd, 0xc1, 0xc9, 0x9e, 0xb2, 0xac, 0x27, 0x70, 0x62,
-	0xd4, 0xdd, 0x80, 0x46, 0x26, 0xb2, 0x02, 0xd5, 0x71, 0x3a, 0xe4, 0x02, 0x0e, 0x66, 0x9f, 0xee,
-	0x39, 0xa8, 0x63, 0xf2, 0xd3, 0x29, 0x49, 0x29, 0x42, 0xb0, 0x14, 0x79, 0x63, 0x22, 0x47, 0xf9,
-	0xb7, 0xfb, 0x5b, 0x1b, 0x6c, 0xae, 0x0d, 0x7d, 0x1b, 0x60, 0x6f, 0x1a, 0x8e, 0x82, 0x1d, 0x6d,
-	0xca, 0x53, 0xf9, 0x94, 0x37, 0xb3, 0x31, 0xac, 0xe1, 0xd0, 0x35, 0x68, 0x06, 0x64, 0x32, 0x8a,
-	0x67, 0x42, 0xac, 0xc2, 0xc5, 0x4e, 0xe7, 0x62, 0xb7, 0xf3, 0x41, 0xac, 0x23, 0xd1, 0x7d, 0x68,
-	0xef, 0xc7, 0xc9, 0x97, 0x5e, 0x12, 0x90, 0xe0, 0xd3, 0x38, 0xa1, 0x69, 0xa7, 0x7a, 0xa1, 0x7a,
-	0xa9, 0x39, 0x78, 0xb3, 0xb0, 0xca, 0xde, 0x5d, 0x03, 0x75, 0x27, 0xa2, 0xc9, 0x0c, 0x17, 0x44,
-	0xd1, 0x5d, 0x58, 0x61, 0xbe, 0x98, 0xa6, 0xb7, 0x9e, 0x11, 0xff, 0x40, 0x98, 0xb2, 0xc4, 0x4d,
-	0xe9, 0x9a, 0xea, 0x74, 0x04, 0x2e, 0xc9, 0xa0, 0x1b, 0xd0, 0xda, 0x0f, 0x47, 0x64, 0x67, 0x16,
-	0xf9, 0x42, 0x89, 0xcd, 0x95, 0xac, 0xe7, 0x4a, 0xee, 0xea, 0xc3, 0xd8, 0x44, 0xa3, 0x1d, 0x58,
-	0x0b, 0xc8, 0xde, 0x74, 0x38, 0x0c, 0xa3, 0xe1, 0xad, 0x38, 0xa2, 0x5e, 0x18, 0x91, 0x24, 0xed,
-	0xd4, 0xf8, 0xc2, 0x2e, 0xea, 0x4e, 0x29, 0x82, 0xee, 0x1c, 0x92, 0x88, 0xe2, 0x79, 0xd2, 0xa8,
-	0x07, 0x8d, 0x31, 0xa1, 0x5e, 0xe0, 0x51, 0xaf, 0x53, 0xe7, 0xe6, 0xa0, 0x5c, 0xd3, 0x43, 0x39,
-	0x82, 0x33, 0x0c, 0xba, 0x02, 0x0e, 0x25, 0x29, 0x15, 0xf6, 0x37, 0xb8, 0xc0, 0x5a, 0x2e, 0xb0,
-	0xab, 0x86, 0x70, 0x8e, 0x62, 0x9b, 0x98, 0x90, 0x28, 0x20, 0x89, 0x10, 0x72, 0x8a, 0x9b, 0x88,
-	0xf3, 0x41, 0xac, 0x23, 0xbb, 0x5f, 0xc0, 0xda, 0x9c, 0xed, 0x61, 0x2c, 0x3c, 0x20, 0x33, 0xce,
-	0x21, 0x1b, 0xb3, 0x4f, 0x74, 0x19, 0xec, 0x43, 0x6f, 0x34, 0x55, 0x04, 0xd1, 0x76, 0x85, 0x89,
-	0x49, 0x1d, 0xc2, 0x09, 0x02, 0x78, 0xbd, 0xf2, 0x91, 0xe5, 0xfe, 0xb5, 0x02, 0x0d, 0xb5, 0x42,
-	0xf4, 0x01, 0xd8, 0x9c, 0x77, 0x92, 0x9a, 0xeb, 0x05, 0x6a, 0x66, 0x9e, 0x10, 0x28, 0x74, 0x19,
-	0x6a, 0x82, 0x6e, 0x72, 0xca, 0x4e, 0x91, 0x93, 0x99, 0x80, 0xc4, 0xa1, 0x77, 0x61, 0x89, 0xb9,
-	0xa4, 0x53, 0xe5, 0xf8, 0x33, 0xa6, 0xcf, 0x32, 0x34, 0xc7, 0xa0, 0x53, 0x60, 0x27, 0xd3, 0x68,
-	0xfb, 0x36, 0x67, 0x99, 0x83, 0x45, 0x83, 0xcd, 0x29, 0xbc, 0x23, 0x79, 0xd3, 0x29, 0xba, 0x30,
-	0x9f, 0x53, 0xe0, 0xd0, 0x4d, 0x00, 0x2f, 0x08, 0x42, 0x16, 0x57, 0xbc, 0x51, 0xc7, 0xe7, 0x44,
-	0x71, 0xcb, 0xdb, 0xdb, 0xdb, 0xcc, 0x40, 0xe2, 0x00, 0x68, 0x52, 0xdd, 0x1b, 0x70, 0xb2, 0x30,
-	0xac, 0x6f, 0x80, 0x23, 0x36, 0xe0, 0x94, 0xbe, 0x01, 0x8e, 0xee, 0xe4, 0x5f, 0x55, 0xa1, 0x65,
-	0x78, 0x10, 0x7d, 0x0c, 0x8e, 0x97, 0xd0, 0x70, 0xdf, 0xf3, 0x69, 0xda, 0xb1, 0xb8, 0x4d, 0x17,
-	0x16, 0x78, 0xbb, 0xb7, 0x29, 0x81, 0x38, 0x17, 0xe1, 0x8e, 0x9c, 0x4d, 0xc4, 0x54, 0xed, 0xcc,
-	0x91, 0x22, 0xd4, 0x71, 0xe9, 0xdd, 0xd9, 0x84, 0x60, 0x8e, 0x41, 0xf7, 0xe6, 0x38, 0xe0, 0x5b,
-	0x0b, 0x27, 0x7b, 0x8e, 0x17, 0x7e, 0x61, 0x41, 0x43, 0x19, 0x83, 0xde, 0x97, 0x16, 0x58, 0xdc,
-	0x82, 0x4e, 0xd9, 0x02, 0x92, 0x68, 0x36, 0xa8, 0xb8, 0x58, 0xc9, 0xe3, 0x22, 0xea, 0x40, 0xdd,
-	0x8f, 0x23, 0x4a, 0xbe, 0x12, 0x7c, 0x70, 0xb0, 0x6a, 0xa2, 0xf3, 0x00, 0x41, 0xec, 0x1f, 0x90,
-	0x84, 0x9d, 0x7d, 0xb9, 0xff, 0x5a, 0xcf, 0xab, 0x6e, 0xc7, 0xd7, 0x16, 0x2c, 0xeb, 0x84, 0x43,
-	0xd7, 0xa0, 0xce, 0xda, 0x2c, 0x90, 0x88, 0xbd, 0x38, 0x37, 0x9f, 0x99, 0x3d, 0x81, 0xc2, 0x0a,
-	0xdd, 0xbd, 0x0f, 0x35, 0xf1, 0x89, 0xde, 0x33, 0xdc, 0xb1, 0x6e, 0xb8, 0x43, 0x40, 0x34, 0x6f,
-	0x9c, 0x02, 0xdb, 0x8f, 0xa7, 0x11, 0xe5, 0xa6, 0xd9, 0x58, 0x34, 0xdc, 0x6f, 0x2c, 0x68, 0x9b,
-	0x1c, 0x46, 0x9f, 0x80, 0x23, 0x7a, 0x72, 0xd3, 0x2e, 0x2e, 0x22, 0x7c, 0x4f, 0x21, 0x71, 0x2e,
-	0xd3, 0x7d, 0xc8, 0x2e, 0x2e, 0xd1, 0x78, 0xae, 0x89, 0x02, 0x74, 0xa4, 0x89, 0x7f, 0xb1, 0xa0,
-	0x6d, 0x1e, 0x6d, 0x66, 0xa2, 0x38, 0xdc, 0x73, 0x4d, 0x34, 0xc1, 0xb2, 0xc9, 0x4c, 0xcc, 0x64,
-	0xd0, 0x00, 0xea, 0xfe, 0x68, 0xca, 0x3c, 0x24, 0xd9, 0x6c, 0x72, 0xe9, 0x96, 0x18, 0xe3, 0xa6,
-	0x29, 0x60, 0xf7, 0x31, 0x34, 0x94, 0x2a, 0xf4, 0x81, 0xb1, 0xac, 0xff, 0x33, 0x84, 0x15, 0xe8,
-	0xc8, 0x85, 0xfd, 0xdd, 0x02, 0xc8, 0xaf, 0x5f, 0xb4, 0x59, 0x3e, 0x9e, 0x6f, 0xce, 0xbb, 0xa7,
-	0xb3, 0xb3, 0x29, 0x2f, 0x4d, 0xed, 0x84, 0x5e, 0x80, 0xa6, 0x37, 0xa5, 0xf1, 0x6e, 0x12, 0x0e,
-	0x87, 0x72, 0x69, 0x0d, 0xac, 0x77, 0xa1, 0x6b, 0x00, 0xf2, 0x76, 0x8c, 0x03, 0xc2, 0x8f, 0x40,
-	0x71, 0x57, 0x76, 0xb2, 0x61, 0xac, 0x41, 0xbb, 0xdf, 0x85, 0xb6, 0x39, 0xef, 0xb1, 0xd8, 0xff,
-	0x63, 0x70, 0xb2, 0x1b, 0x0a, 0x9d, 0x81, 0x9a, 0x50, 0x2c, 0x65, 0x65, 0xab, 0x60, 0x5b, 0xe5,
-	0x85, 0x6d, 0x73, 0x7f, 0x02, 0x4d, 0xed, 0x2a, 0xfb, 0xcf, 0xeb, 0xff, 0x99, 0x05, 0x4d, 0x2d,
-	0xe1, 0x59, 0x38, 0xc1, 0xeb, 0x73, 0xbf, 0xfb, 0x0f, 0x0b, 0x56, 0x8a, 0x89, 0xce, 0x42, 0x3b,
-	0xee, 0x81, 0x93, 0x90, 0x34, 0x9e, 0x26, 0x3e, 0x49, 0x3b, 0x15, 0xce, 0xa4, 0x77, 0x16, 0xe7,
-	0x4b, 0x3d, 0xac, 0xb0, 0x92, 0x4f, 0x99, 0xec, 0x2b, 0xb1, 0xc5, 0xd4, 0x7a, 0x2c, 0xb6, 0x6c,
-	0x43, 0xcb, 0xc8, 0xc7, 0x5e, 0xde, 0xe1, 0xee, 0xbf, 0xea, 0x60, 0xf3, 0xfc, 0x03, 0x7d, 0x04,
-	0x4e, 0x96, 0xc9, 0xcb, 0x5c, 0xa3, 0xdb, 0x13, 0xa9, 0x7c, 0x4f, 0xa5, 0xf2, 0xbd, 0x5d, 0x85,
-	0xc0, 0x39, 0x18, 0x5d, 0x05, 0x87, 0x65, 0x61, 0x5c, 0x8d, 0xcc, 0x3a, 0xd6, 0xcc, 0xbb, 0x9c,
-	0x0f, 0x6d, 0x9d, 0xc0, 0x39, 0x0e, 0x6d, 0xc1, 0x8a, 0x2a, 0x40, 0x1e, 0xc4, 0x43, 0x21, 0x5b,
-	0x2d, 0xa5, 0xae, 0x05, 0xc4, 0xd6, 0x09, 0x5c, 0x92, 0x42, 0x4f, 0x60, 0xcd, 0x9b, 0x4c, 0x46,
-	0xa1, 0xcf, 0xcb, 0x94, 0x4c, 0x99, 0xc8, 0x83, 0xb5, 0x4b, 0x63, 0xb3, 0x0c, 0xda, 0x3a, 0x81,
-	0xe7, 0xc9, 0xb2, 0x15, 0x51, 0x2f, 0x3d, 0x10, 0x8a, 0xec, 0x52, 0x2e, 0xa9, 0x86, 0xd8, 0x8a,
-	0x32, 0x1c, 0xba, 0x0f, 0xab, 0xa2, 0x40, 0x98, 0xee, 0xe5, 0xc2, 0x35, 0x2e, 0x7c, 0xb6, 0x18,
-	0xa7, 0x34, 0xc8, 0xd6, 0x09, 0x5c, 0x96, 0x43, 0x8f, 0x00, 0xc9, 0xaa, 0x41, 0xd7, 0x26, 0xf2,
-	0xe0, 0x8d, 0x52, 0x99, 0x61, 0xaa, 0x9b, 0x23, 0x89, 0xae, 0x83, 0x33, 0x89, 0x13, 0x2a, 0xd4,
-	0x34, 0x8e, 0x4a, 0x46, 0xd9, 0xc2, 0x32, 0x38, 0xfa, 0x02, 0xd6, 0xf5, 0x8a, 0x41, 0x37, 0x48,
-	0xa4, 0xcc, 0x17, 0xe7, 0x1f, 0x1e, 0xd3, 0xaa, 0x45, 0x3a, 0xd0, 0x27, 0x79, 0xf1, 0x21, 0x94,
-	0xc2, 0xa2, 0xe2, 0x43, 0xa9, 0x32, 0xf1, 0xcc, 0xbe, 0x60, 0x7e, 0x65, 0xd1, 0x69, 0x16, 0xed,
-	0x5b, 0x50, 0x82, 0x30, 0xfb, 0x16, 0xe8, 0x60, 0x4c, 0xa5, 0x24, 0x19, 0x87, 0x11, 0xe7, 0x88,
-	0xd0, 0xbb, 0x5c, 0xf4, 0xe0, 0x6e, 0x01, 0xc1, 0x98, 0x5a, 0x94, 0x62, 0x9b, 0xc0, 0xb2, 0x68,
-	0xa1, 0xa2, 0x55, 0x56, 0x91, 0xd2, 0x82, 0xcf, 0x72, 0x38, 0xfa, 0x9e, 0xaa, 0x55, 0x84, 0x74,
-	0xbb, 0xc8, 0x04, 0x19, 0xe0, 0x4d, 0x79, 0x5d, 0xe4, 0xe6, 0x32, 0x00, 0x61, 0x1f, 0x4f, 0xd9,
-	0x95, 0xeb, 0x7e, 0x06, 0x2b, 0x45, 0x9b, 0x17, 0x86, 0x91, 0x77, 0xa0, 0x4a, 0x92, 0x44, 0x1e,
-	0x6d, 0x6d, 0x5f, 0x36, 0x7d, 0x9e, 0xed, 0xed, 0x8d, 0xc8, 0x9d, 0x24, 0xc1, 0x0c, 0xc3, 0xd2,
-	0xb8, 0x96, 0xd1, 0x8d, 0xae, 0x40, 0x9d, 0x24, 0x09, 0x0f, 0x90, 0xd6, 0xf3, 0x03, 0xa4, 0xc2,
-	0xb1, 0x24, 0x74, 0x4c, 0xd2, 0xd4, 0x1b, 0xaa, 0xd8, 0xa7, 0x9a, 0xe8, 0x43, 0x68, 0xa6, 0xd3,
-	0xe1, 0x90, 0xa4, 0xfc, 0x45, 0x42, 0x96, 0xce, 0x5a, 0xb5, 0xbe, 0x93, 0x0d, 0x62, 0x1d, 0xe8,
-	0x3e, 0x01, 0x27, 0x8b, 0x43, 0x2c, 0xb0, 0x12, 0x16, 0x73, 0xe5, 0x2a, 0x45, 0xc3, 0xa8, 0x37,
-	0x2b, 0x47, 0xd7, 0x9b, 0xee, 0xef, 0xd9, 0x8d, 0x53, 0x8c, 0x45, 0xeb, 0x50, 0x67, 0xfe, 0x7f,
-	0x1a, 0x06, 0xca, 0x85, 0xac, 0xb9, 0x1d, 0xa0, 0x73, 0x00, 0xa9, 0xd8, 0x1b, 0x36, 0x26, 0x56,
-	0xe5, 0xc8, 0x9e, 0xed, 0x80, 0x79, 0x3e, 0x4e, 0xc2, 0x61, 0x18, 0xc9, 0xac, 0x5b, 0xb6, 0xd0,
-	0x7b, 0x60, 0x8f, 0xc8, 0x21, 0x19, 0xf1, 0x68, 0xd6, 0xce, 0x6a, 0x53, 0xe1, 0xba, 0x07, 0xf1,
-	0xf0, 0x01, 0x1b, 0xc4, 0x02, 0xa3, 0xbb, 0xcd, 0x36, 0xdc, 0xe6, 0xfe, 0xce, 0x82, 0xb5, 0x39,
-	0xe1, 0x0f, 0xbd, 0x05, 0x2d, 0x5f, 0x91, 0xfd, 0x51, 0xfe, 0x44, 0x62, 0x76, 0x32, 0xbd, 0x93,
-	0x38, 0x78, 0x94, 0x97, 0x0a, 0xaa, 0xa9, 0xcf, 0x58, 0x35, 0x37, 0x6a, 0x00, 0xa7, 0x92, 0xd0,
-	0x7f, 0x76, 0x37, 0x4e, 0xc6, 0x1e, 0xa5, 0x24, 0x78, 0x28, 0x61, 0xa2, 0x6e, 0x98, 0x3b, 0xe6,
-	0xfe, 0xc9, 0x02, 0x27, 0x8b, 0xad, 0xa8, 0x0d, 0x95, 0xcc, 0x8b, 0x95, 0x30, 0x60, 0xd5, 0x0a,
-	0x73, 0x96, 0xaa, 0x56, 0xd8, 0x37, 0xbb, 0xdf, 0x02, 0x92, 0xfa, 0x49, 0x38, 0x61, 0xcb, 0x92,
-	0x36, 0xe8, 0x5d, 0x68, 0x03, 0x9c, 0x90, 0x92, 0x84, 0x2f, 0x9b, 0x4f, 0x6e, 0xe3, 0xbc, 0x43,
-	0x23, 0xbc, 0x6d, 0x10, 0xfe, 0x06, 0xb4, 0x3c, 0x9d, 0xc4, 0x32, 0x8c, 0x2f, 0xa4, 0xbe, 0x89,
-	0x76, 0xff, 0x68, 0xc1, 0x6a, 0x29, 0xce, 0x97, 0x16, 0xa4, 0x71, 0xa5, 0x62, 0x70, 0xa5, 0x0b,
-	0x0d, 0x95, 0xb2, 0xca, 0x25, 0x65, 0x6d, 0xe6, 0x85, 0x94, 0x92, 0x89, 0xf4, 0x23, 0xff, 0x7e,
-	0x5d, 0xab, 0xf8, 0xb5, 0xc5, 0x42, 0x84, 0x19, 0x93, 0x5e, 0x7c, 0x11, 0xb9, 0x51, 0xd5, 0xe7,
-	0x1b, 0xb5, 0x74, 0x2c, 0xa3, 0xbe, 0xb6, 0x00, 0x95, 0x43, 0xdd, 0xff, 0x84, 0x59, 0xe5, 0xbb,
-	0xf8, 0xbf, 0x6e, 0xd6, 0x2f, 0x2b, 0xb0, 0xbe, 0xe0, 0x46, 0x3e, 0x16, 0x1d, 0x55, 0xc6, 0xab,
-	0xe8, 0xa8, 0xda, 0x9a, 0xdd, 0x4b, 0x86, 0xdd, 0x0b, 0x43, 0x51, 0x21, 0x65, 0xae, 0xbd, 0x70,
-	0xca, 0x5c, 0x76, 0x45, 0xfd, 0x58, 0xae, 0xf8, 0x67, 0x05, 0x56, 0x8a, 0x69, 0xce, 0x8b, 0xfb,
-	0x60, 0x03, 0x9c, 0x51, 0xec, 0x7b, 0x23, 0xa6, 0x81, 0x3b, 0xc1, 0xc6, 0x79, 0x87, 0x1e, 0x20,
-	0x97, 0xcc, 0x00, 0x59, 0x0a, 0xb0, 0xf6, 0xbc, 0x00, 0xbb, 0x01, 0x4e, 0xe4, 0x8d, 0x49, 0x3a,
-	0xf1, 0x7c, 0xe1, 0x12, 0x07, 0xe7, 0x1d, 0xcc, 0xff, 0x2c, 0x17, 0xe3, 0xe2, 0x75, 0xe1, 0x7f,
-	0xd5, 0x46, 0x2e, 0x2c, 0xab, 0xbd, 0x60, 0xe5, 0x34, 0xcf, 0xec, 0x1c, 0x6c, 0xf4, 0xe9, 0x18,
-	0xae, 0xc3, 0x31, 0x31, 0x2a, 0x90, 0x7b, 0x41, 0x90, 0x90, 0x34, 0xe5, 0xd9, 0x97, 0x83, 0x55,
-	0x13, 0x7d, 0x07, 0x80, 0x7a, 0xc9, 0x90, 0x50, 0xbe, 0xf4, 0x66, 0xf1, 0x89, 0x74, 0x3b, 0xa2,
-	0x8f, 0x93, 0x1d, 0x9a, 0x84, 0xd1, 0x10, 0x6b, 0x40, 0x16, 0x02, 0x5b, 0x46, 0xda, 0x76, 0x2c,
-	0x5f, 0xb3, 0xfc, 0xee, 0x16, 0x7f, 0x10, 0x90, 0xbe, 0xce, 0x3a, 0xd8, 0xe5, 0x1d, 0x8e, 0xf3,
-	0x9b, 0x44, 0x34, 0x5e, 0x57, 0x08, 0xfc, 0xa6, 0x0a, 0xeb, 0x0b, 0x32, 0xc6, 0x57, 0x3f, 0xdb,
-	0xaf, 0x9d, 0x35, 0xd9, 0x25, 0x52, 0x2f, 0x5c, 0x22, 0x1d, 0xa8, 0x27, 0xd3, 0x88, 0x15, 0x70,
-	0x92, 0x30, 0xaa, 0x89, 0xce, 0x03, 0x7c, 0x19, 0x27, 0x07, 0x61, 0x34, 0xbc, 0x1d, 0x26, 0x92,
-	0x29, 0x5a, 0x0f, 0x7a, 0x02, 0xc0, 0xd3, 0x64, 0xf1, 0xcb, 0x05, 0xf0, 0xf4, 0xeb, 0xca, 0x91,
-	0xd9, 0xb5, 0xe8, 0xd7, 0x7e, 0xc7, 0xd0, 0x94, 0x74, 0x6f, 0xc0, 0xc9, 0xc2, 0xf0, 0x51, 0xb5,
-	0x70, 0x4b, 0xaf, 0x85, 0x6f, 0xc0, 0xea, 0x67, 0x29, 0x49, 0xb6, 0x23, 0x4a, 0x22, 0xaa, 0x7e,
-	0xf1, 0xb9, 0x04, 0xb5, 0x90, 0x77, 0xc8, 0x42, 0x76, 0xc5, 0x20, 0x2c, 0x03, 0xca, 0x71, 0xf7,
-	0x63, 0x68, 0xcb, 0x52, 0x58, 0xc9, 0xbe, 0x6f, 0xfe, 0xfa, 0xa4, 0xbf, 0x87, 0x0b, 0xa0, 0xf1,
-	0x23, 0xd4, 0x15, 0x58, 0xd6, 0xbb, 0x51, 0x17, 0xea, 0x84, 0xd3, 0x47, 0x50, 0xa3, 0xb1, 0x75,
-	0x02, 0xab, 0x8e, 0x9b, 0x36, 0x54, 0x0f, 0xbd, 0x91, 0xfb, 0x7d, 0xa8, 0x09, 0x23, 0xd8, 0xaa,
-	0xf2, 0xa7, 0xfd, 0x86, 0x7a, 0xc1, 0x67, 0x57, 0xfc, 0x2c, 0xf2, 0x65, 0xb5, 0xce, 0xbf, 0x19,
-	0x87, 0xe4, 0xab, 0x7e, 0x95, 0xf7, 0xca, 0x96, 0x1b, 0x02, 0xe4, 0x29, 0x2f, 0xba, 0x05, 0xed,
-	0x3c, 0xe9, 0xd5, 0x32, 0xee, 0xb3, 0x66, 0x7c, 0x35, 0x20, 0xb8, 0x20, 0xc2, 0xa6, 0x12, 0x87,
-	0x40, 0xd1, 0x58, 0xb4, 0xdc, 0x27, 0xd0, 0xd4, 0x0e, 0x3b, 0x4f, 0xc7, 0xd4, 0x0b, 0x9f, 0x2d,
-	0x9f, 0xf1, 0xce, 0x70, 0xb7, 0x7f, 0xee, 0x8d, 0xe4, 0x3b, 0x9e, 0x6c, 0x89, 0x13, 0x90, 0xb0,
-	0xfe, 0xec, 0x04, 0xb0, 0xd6, 0xe0, 0x0f, 0x35, 0x58, 0x55, 0x29, 0xf4, 0xe7, 0x83, 0x1d, 0x92,
-	0x1c, 0x86, 0x3e, 0x41, 0x77, 0xa1, 0x71, 0x8f, 0xa8, 0xa7, 0xb0, 0xd2, 0x0b, 0xc4, 0x9d, 0xf1,
-	0x84, 0xce, 0xba, 0xc5, 0xdf, 0x04, 0xdd, 0xd5, 0x9f, 0xff, 0xf9, 0x6f, 0xbf, 0xa9, 0x34, 0x91,
-	0xd3, 0x3f, 0x1c, 0xf4, 0xf9, 0xd6, 0xa0, 0x7b, 0x50, 0xe3, 0xec, 0x4b, 0x5f, 0x44, 0x0b, 0x47,
-	0xba, 0x88, 0x6b, 0x59, 0x46, 0xc0, 0xb4, 0xf0, 0x62, 0x29, 0xbd, 0x6c, 0xa1, 0x1f, 0xc0, 0x49,
-	0x33, 0x79, 0x3e, 0x86, 0xc6, 0xb3, 0x5c, 0xe3, 0x69, 0xb4, 0xc6, 0x34, 0x9a, 0x4f, 0x0d, 0x4c,
-	0xf5, 0x0e, 0x2c, 0x6b, 0x35, 0xc4, 0x31, 0xf4, 0x76, 0xb8, 0x5e, 0x84, 0x56, 0xfa, 0xda, 0x2f,
-	0xb9, 0x52, 0xe9, 0x8f, 0xa0, 0x7e, 0xe7, 0x2b, 0xe2, 0x4f, 0x29, 0x41, 0xda, 0xc3, 0x43, 0xe9,
-	0x94, 0x74, 0x17, 0x4c, 0xa6, 0x6c, 0x76, 0x9b, 0xdc, 0x0b, 0x42, 0xd3, 0x75, 0x79, 0x60, 0x50,
-	0x00, 0xce, 0xe6, 0x94, 0xc6, 0x3c, 0xbd, 0x45, 0x9d, 0xd2, 0xe1, 0x38, 0x4a, 0xf7, 0xdb, 0x5c,
-	0xf7, 0x1b, 0xdd, 0x33, 0x4c, 0x37, 0xe7, 0x7b, 0xdf, 0x9b, 0xd2, 0xf8, 0xa9, 0x9a, 0x46, 0x1c,
-	0x2b, 0xb4, 0x07, 0x0d, 0x36, 0x0b, 0xbb, 0x3d, 0x5e, 0x62, 0x92, 0xb7, 0xf8, 0x24, 0xe7, 0xbb,
-	0xa7, 0xb9, 0x73, 0x66, 0x91, 0x3f, 0x77, 0x8e, 0x7d, 0x00, 0x36, 0x87, 0x48, 0xdb, 0x5e, 0x62,
-	0x96, 0xff, 0xe7, 0xb3, 0x5c, 0xe8, 0xae, 0xb3, 0x59, 0xc4, 0x79, 0x9c, 0x3b, 0xcf, 0x63, 0xa8,
-	0x6d, 0x79, 0x51, 0x30, 0x22, 0xa8, 0xb8, 0x8b, 0x0b, 0x55, 0x6f, 0x70, 0xd5, 0x67, 0xdc, 0xd5,
-	0x9c, 0x87, 0xfd, 0x67, 0x5c, 0xc7, 0x75, 0xeb, 0xdd, 0x9b, 0x57, 0x7f, 0x78, 0x65, 0x18, 0xd2,
-	0x67, 0xd3, 0xbd, 0x9e, 0x1f, 0x8f, 0xfb, 0xf7, 0xb8, 0x86, 0x2c, 0xe0, 0xee, 0xc6, 0xf1, 0x28,
-	0xcd, 0x18, 0x21, 0x7e, 0x84, 0xef, 0x1f, 0x0e, 0x3e, 0xad, 0xee, 0xd5, 0xf8, 0xf7, 0xd5, 0x7f,
-	0x07, 0x00, 0x00, 0xff, 0xff, 0x90, 0x22, 0x13, 0xf6, 0xfc, 0x1f, 0x00, 0x00,
+	// 2441 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0x4b, 0x6f, 0x1b, 0xc9,
+	0x11, 0xd6, 0x90, 0x1a, 0x92, 0x53, 0x14, 0x65, 0xa9, 0x25, 0x5b, 0x34, 0x2d, 0x7b, 0xed, 0xf1,
+	0x6e, 0xe2, 0x7d, 0x91, 0xb6, 0x9c, 0xac, 0x17, 0x46, 0xbc, 0x1b, 0xf9, 0x29, 0xc5, 0xaf, 0x75,
+	0x53, 0x5e, 0x20, 0x8f, 0x8d, 0x31, 0x9a, 0x69, 0xd1, 0x03, 0x91, 0x33, 0xcc, 0x4c, 0x53, 0x5e,
+	0xde, 0x82, 0x1c, 0x82, 0x20, 0xc8, 0x29, 0xd9, 0x53, 0x4e, 0x0b, 0xe4, 0x94, 0x4b, 0x4e, 0xf9,
+	0x07, 0x01, 0xf2, 0x07, 0x02, 0xe4, 0x92, 0x5b, 0x90, 0x43, 0x90, 0x5f, 0x11, 0xf4, 0x6b, 0xa6,
+	0x7b, 0x48, 0x5a, 0x92, 0x1d, 0x23, 0x7b, 0xb1, 0xd9, 0xdd, 0x5f, 0x7d, 0x5d, 0x5d, 0x5d, 0x5d,
+	0x5d, 0xd5, 0x23, 0x58, 0x3e, 0xd8, 0xe8, 0xa4, 0xfb, 0xde, 0xde, 0x5e, 0xdc, 0x0f, 0xda, 0xc3,
+	0x24, 0xa6, 0x31, 0xaa, 0xf1, 0xff, 0xda, 0x07, 0x1b, 0xad, 0xf5, 0x5e, 0x1c, 0xf7, 0xfa, 0xa4,
+	0xe3, 0x0d, 0xc3, 0x8e, 0x17, 0x45, 0x31, 0xf5, 0x68, 0x18, 0x47, 0xa9, 0xc0, 0xb5, 0xde, 0x92,
+	0xa3, 0xbc, 0xb5, 0x3b, 0xda, 0xeb, 0xd0, 0x70, 0x40, 0x52, 0xea, 0x0d, 0x86, 0x12, 0x70, 0xa6,
+	0x08, 0x20, 0x83, 0x21, 0x1d, 0xcb, 0xc1, 0x65, 0x12, 0x8d, 0x06, 0x69, 0x87, 0xff, 0x2b, 0xba,
+	0xdc, 0x8f, 0xa0, 0xd1, 0xa5, 0x1e, 0x25, 0x98, 0xa4, 0xc3, 0x38, 0x4a, 0x09, 0x7a, 0x07, 0xec,
+	0x94, 0x75, 0x34, 0xad, 0xf3, 0xd6, 0xa5, 0xfa, 0xc6, 0x89, 0xb6, 0xd2, 0xac, 0x2d, 0x70, 0x62,
+	0xd4, 0x5d, 0x87, 0x5a, 0x26, 0xb2, 0x04, 0xe5, 0x41, 0xda, 0xe3, 0x02, 0x0e, 0x66, 0x3f, 0xdd,
+	0xb3, 0x50, 0xc5, 0xe4, 0x67, 0x23, 0x92, 0x52, 0x84, 0x60, 0x3e, 0xf2, 0x06, 0x44, 0x8e, 0xf2,
+	0xdf, 0xee, 0xef, 0x6d, 0xb0, 0x39, 0x1b, 0xfa, 0x0e, 0xc0, 0xee, 0x28, 0xec, 0x07, 0x5d, 0x6d,
+	0xca, 0xd5, 0x7c, 0xca, 0x9b, 0xd9, 0x18, 0xd6, 0x70, 0xe8, 0x1a, 0xd4, 0x03, 0x32, 0xec, 0xc7,
+	0x63, 0x21, 0x56, 0xe2, 0x62, 0x27, 0x73, 0xb1, 0xdb, 0xf9, 0x20, 0xd6, 0x91, 0xe8, 0x3e, 0x2c,
+	0xee, 0xc5, 0xc9, 0x0b, 0x2f, 0x09, 0x48, 0xf0, 0x59, 0x9c, 0xd0, 0xb4, 0x59, 0x3e, 0x5f, 0xbe,
+	0x54, 0xdf, 0xb8, 0x58, 0x58, 0x65, 0xfb, 0xae, 0x81, 0xba, 0x13, 0xd1, 0x64, 0x8c, 0x0b, 0xa2,
+	0xe8, 0x2e, 0x2c, 0x31, 0x5b, 0x8c, 0xd2, 0x5b, 0xcf, 0x89, 0xbf, 0x2f, 0x54, 0x99, 0xe7, 0xaa,
+	0xb4, 0x4c, 0x3a, 0x1d, 0x81, 0x27, 0x64, 0xd0, 0x0d, 0x68, 0xec, 0x85, 0x7d, 0xd2, 0x1d, 0x47,
+	0xbe, 0x20, 0xb1, 0x39, 0xc9, 0x5a, 0x4e, 0x72, 0x57, 0x1f, 0xc6, 0x26, 0x1a, 0x75, 0x61, 0x25,
+	0x20, 0xbb, 0xa3, 0x5e, 0x2f, 0x8c, 0x7a, 0xb7, 0xe2, 0x88, 0x7a, 0x61, 0x44, 0x92, 0xb4, 0x59,
+	0xe1, 0x0b, 0xbb, 0xa0, 0x1b, 0xa5, 0x08, 0xba, 0x73, 0x40, 0x22, 0x8a, 0xa7, 0x49, 0xa3, 0x36,
+	0xd4, 0x06, 0x84, 0x7a, 0x81, 0x47, 0xbd, 0x66, 0x95, 0xab, 0x83, 0x72, 0xa6, 0x87, 0x72, 0x04,
+	0x67, 0x18, 0x74, 0x05, 0x1c, 0x4a, 0x52, 0x2a, 0xf4, 0xaf, 0x71, 0x81, 0x95, 0x5c, 0x60, 0x47,
+	0x0d, 0xe1, 0x1c, 0xc5, 0x36, 0x31, 0x21, 0x51, 0x40, 0x12, 0x21, 0xe4, 0x14, 0x37, 0x11, 0xe7,
+	0x83, 0x58, 0x47, 0xb6, 0xbe, 0x80, 0x95, 0x29, 0xdb, 0xc3, 0xbc, 0x70, 0x9f, 0x8c, 0xb9, 0x0f,
+	0xd9, 0x98, 0xfd, 0x44, 0x97, 0xc1, 0x3e, 0xf0, 0xfa, 0x23, 0xe5, 0x20, 0xda, 0xae, 0x30, 0x31,
+	0xc9, 0x21, 0x8c, 0x20, 0x80, 0xd7, 0x4b, 0x1f, 0x5b, 0xee, 0x3f, 0x4b, 0x50, 0x53, 0x2b, 0x44,
+	0x1f, 0x82, 0xcd, 0xfd, 0x4e, 0xba, 0xe6, 0x5a, 0xc1, 0x35, 0x33, 0x4b, 0x08, 0x14, 0xba, 0x0c,
+	0x15, 0xe1, 0x6e, 0x72, 0xca, 0x66, 0xd1, 0x27, 0x33, 0x01, 0x89, 0x43, 0xef, 0xc1, 0x3c, 0x33,
+	0x49, 0xb3, 0xcc, 0xf1, 0xa7, 0x4c, 0x9b, 0x65, 0x68, 0x8e, 0x41, 0xab, 0x60, 0x27, 0xa3, 0x68,
+	0xfb, 0x36, 0xf7, 0x32, 0x07, 0x8b, 0x06, 0x9b, 0x53, 0x58, 0x47, 0xfa, 0x4d, 0xb3, 0x68, 0xc2,
+	0x7c, 0x4e, 0x81, 0x43, 0x37, 0x01, 0xbc, 0x20, 0x08, 0x59, 0x5c, 0xf1, 0xfa, 0x4d, 0x9f, 0x3b,
+	0x8a, 0x3b, 0xb9, 0xbd, 0xed, 0xcd, 0x0c, 0x24, 0x0e, 0x80, 0x26, 0xd5, 0xba, 0x01, 0x27, 0x0a,
+	0xc3, 0xfa, 0x06, 0x38, 0x62, 0x03, 0x56, 0xf5, 0x0d, 0x70, 0x74, 0x23, 0xff, 0xa6, 0x0c, 0x0d,
+	0xc3, 0x82, 0xe8, 0x13, 0x70, 0xbc, 0x84, 0x86, 0x7b, 0x9e, 0x4f, 0xd3, 0xa6, 0xc5, 0x75, 0x3a,
+	0x3f, 0xc3, 0xda, 0xed, 0x4d, 0x09, 0xc4, 0xb9, 0x08, 0x37, 0xe4, 0x78, 0x28, 0xa6, 0x5a, 0xcc,
+	0x0c, 0x29, 0x42, 0x1d, 0x97, 0xde, 0x19, 0x0f, 0x09, 0xe6, 0x18, 0x74, 0x6f, 0x8a, 0x01, 0xbe,
+	0x3d, 0x73, 0xb2, 0x97, 0x58, 0xe1, 0x97, 0x16, 0xd4, 0x94, 0x32, 0xe8, 0x03, 0xa9, 0x81, 0xc5,
+	0x35, 0x68, 0x4e, 0x6a, 0x40, 0x12, 0x4d, 0x07, 0x15, 0x17, 0x4b, 0x79, 0x5c, 0x44, 0x4d, 0xa8,
+	0xfa, 0x71, 0x44, 0xc9, 0x97, 0xc2, 0x1f, 0x1c, 0xac, 0x9a, 0xe8, 0x1c, 0x40, 0x10, 0xfb, 0xfb,
+	0x24, 0x61, 0x67, 0x5f, 0xee, 0xbf, 0xd6, 0xf3, 0xba, 0xdb, 0xf1, 0x95, 0x05, 0x0b, 0xba, 0xc3,
+	0xa1, 0x6b, 0x50, 0x65, 0x6d, 0x16, 0x48, 0xc4, 0x5e, 0x9c, 0x9d, 0xee, 0x99, 0x6d, 0x81, 0xc2,
+	0x0a, 0xdd, 0xba, 0x0f, 0x15, 0xf1, 0x13, 0xbd, 0x6f, 0x98, 0x63, 0xcd, 0x30, 0x87, 0x80, 0x68,
+	0xd6, 0x58, 0x05, 0xdb, 0x8f, 0x47, 0x11, 0xe5, 0xaa, 0xd9, 0x58, 0x34, 0xdc, 0xaf, 0x2d, 0x58,
+	0x34, 0x7d, 0x18, 0x7d, 0x0a, 0x8e, 0xe8, 0xc9, 0x55, 0xbb, 0x30, 0xcb, 0xe1, 0xdb, 0x0a, 0x89,
+	0x73, 0x99, 0xd6, 0x43, 0x76, 0x71, 0x89, 0xc6, 0x4b, 0x55, 0x14, 0xa0, 0x43, 0x55, 0xfc, 0xbb,
+	0x05, 0x8b, 0xe6, 0xd1, 0x66, 0x2a, 0x8a, 0xc3, 0x3d, 0x55, 0x45, 0x13, 0x2c, 0x9b, 0x4c, 0xc5,
+	0x4c, 0x06, 0x6d, 0x40, 0xd5, 0xef, 0x8f, 0x98, 0x85, 0xa4, 0x37, 0x9b, 0xbe, 0x74, 0x4b, 0x8c,
+	0x71, 0xd5, 0x14, 0xb0, 0xf5, 0x18, 0x6a, 0x8a, 0x0a, 0x7d, 0x68, 0x2c, 0xeb, 0xb4, 0x21, 0xac,
+	0x40, 0x87, 0x2e, 0xec, 0xdf, 0x16, 0x40, 0x7e, 0xfd, 0xa2, 0xcd, 0xc9, 0xe3, 0x79, 0x71, 0xda,
+	0x3d, 0x9d, 0x9d, 0x4d, 0x79, 0x69, 0x6a, 0x27, 0xf4, 0x3c, 0xd4, 0xbd, 0x11, 0x8d, 0x77, 0x92,
+	0xb0, 0xd7, 0x93, 0x4b, 0xab, 0x61, 0xbd, 0x0b, 0x5d, 0x03, 0x90, 0xb7, 0x63, 0x1c, 0x10, 0x7e,
+	0x04, 0x8a, 0xbb, 0xd2, 0xcd, 0x86, 0xb1, 0x06, 0x6d, 0x7d, 0x0f, 0x16, 0xcd, 0x79, 0x8f, 0xe5,
+	0xfd, 0x3f, 0x01, 0x27, 0xbb, 0xa1, 0xd0, 0x29, 0xa8, 0x08, 0x62, 0x29, 0x2b, 0x5b, 0x05, 0xdd,
+	0x4a, 0x47, 0xd6, 0xcd, 0xfd, 0x29, 0xd4, 0xb5, 0xab, 0xec, 0x7f, 0xcf, 0xff, 0x73, 0x0b, 0xea,
+	0x5a, 0xc2, 0x33, 0x73, 0x82, 0x37, 0x67, 0x7e, 0xf7, 0x3f, 0x16, 0x2c, 0x15, 0x13, 0x9d, 0x99,
+	0x7a, 0xdc, 0x03, 0x27, 0x21, 0x69, 0x3c, 0x4a, 0x7c, 0x92, 0x36, 0x4b, 0xdc, 0x93, 0xde, 0x9d,
+	0x9d, 0x2f, 0xb5, 0xb1, 0xc2, 0x4a, 0x7f, 0xca, 0x64, 0x5f, 0xcb, 0x5b, 0x4c, 0xd6, 0x63, 0x79,
+	0xcb, 0x36, 0x34, 0x8c, 0x7c, 0xec, 0xd5, 0x0d, 0xee, 0xfe, 0xa3, 0x06, 0x36, 0xcf, 0x3f, 0xd0,
+	0xc7, 0xe0, 0x64, 0x99, 0xbc, 0xcc, 0x35, 0x5a, 0x6d, 0x91, 0xca, 0xb7, 0x55, 0x2a, 0xdf, 0xde,
+	0x51, 0x08, 0x9c, 0x83, 0xd1, 0x55, 0x70, 0x58, 0x16, 0xc6, 0x69, 0x64, 0xd6, 0xb1, 0x62, 0xde,
+	0xe5, 0x7c, 0x68, 0x6b, 0x0e, 0xe7, 0x38, 0xb4, 0x05, 0x4b, 0xaa, 0x00, 0x79, 0x10, 0xf7, 0x84,
+	0x6c, 0x79, 0x22, 0x75, 0x2d, 0x20, 0xb6, 0xe6, 0xf0, 0x84, 0x14, 0x7a, 0x02, 0x2b, 0xde, 0x70,
+	0xd8, 0x0f, 0x7d, 0x5e, 0xa6, 0x64, 0x64, 0x22, 0x0f, 0xd6, 0x2e, 0x8d, 0xcd, 0x49, 0xd0, 0xd6,
+	0x1c, 0x9e, 0x26, 0xcb, 0x56, 0x44, 0xbd, 0x74, 0x5f, 0x10, 0xd9, 0x13, 0xb9, 0xa4, 0x1a, 0x62,
+	0x2b, 0xca, 0x70, 0xe8, 0x3e, 0x2c, 0x8b, 0x02, 0x61, 0xb4, 0x9b, 0x0b, 0x57, 0xb8, 0xf0, 0x99,
+	0x62, 0x9c, 0xd2, 0x20, 0x5b, 0x73, 0x78, 0x52, 0x0e, 0x3d, 0x02, 0x24, 0xab, 0x06, 0x9d, 0x4d,
+	0xe4, 0xc1, 0xeb, 0x13, 0x65, 0x86, 0x49, 0x37, 0x45, 0x12, 0x5d, 0x07, 0x67, 0x18, 0x27, 0x54,
+	0xd0, 0xd4, 0x0e, 0x4b, 0x46, 0xd9, 0xc2, 0x32, 0x38, 0xfa, 0x02, 0xd6, 0xf4, 0x8a, 0x41, 0x57,
+	0x48, 0xa4, 0xcc, 0x17, 0xa6, 0x1f, 0x1e, 0x53, 0xab, 0x59, 0x1c, 0xe8, 0xd3, 0xbc, 0xf8, 0x10,
+	0xa4, 0x30, 0xab, 0xf8, 0x50, 0x54, 0x26, 0x9e, 0xe9, 0x17, 0x4c, 0xaf, 0x2c, 0x9a, 0xf5, 0xa2,
+	0x7e, 0x33, 0x4a, 0x10, 0xa6, 0xdf, 0x0c, 0x0e, 0xe6, 0xa9, 0x94, 0x24, 0x83, 0x30, 0xe2, 0x3e,
+	0x22, 0x78, 0x17, 0x8a, 0x16, 0xdc, 0x29, 0x20, 0x98, 0xa7, 0x16, 0xa5, 0xd8, 0x26, 0xb0, 0x2c,
+	0x5a, 0x50, 0x34, 0x26, 0x29, 0x52, 0x5a, 0xb0, 0x59, 0x0e, 0x47, 0xdf, 0x57, 0xb5, 0x8a, 0x90,
+	0x5e, 0x2c, 0x7a, 0x82, 0x0c, 0xf0, 0xa6, 0xbc, 0x2e, 0x82, 0x7c, 0x38, 0x3d, 0xcc, 0xf7, 0x19,
+	0x13, 0x2f, 0x08, 0x23, 0x92, 0xa6, 0x82, 0xef, 0x04, 0xe7, 0xbb, 0x38, 0xd5, 0x25, 0x4c, 0xe8,
+	0xd6, 0x1c, 0x9e, 0xcd, 0x73, 0x73, 0x01, 0x80, 0xb0, 0x1f, 0xcf, 0xd8, 0xbd, 0xee, 0x3e, 0x85,
+	0xa5, 0xa2, 0x61, 0x66, 0xc6, 0xaa, 0x77, 0xa1, 0x4c, 0x92, 0x44, 0xc6, 0x0f, 0x6d, 0xf3, 0x37,
+	0x7d, 0x9e, 0x52, 0xee, 0xf6, 0xc9, 0x9d, 0x24, 0xc1, 0x0c, 0xc3, 0x72, 0xc5, 0x86, 0xd1, 0x8d,
+	0xae, 0x40, 0x95, 0x24, 0x09, 0x8f, 0xc2, 0xd6, 0xcb, 0xa3, 0xb0, 0xc2, 0xb1, 0x4c, 0x77, 0x40,
+	0xd2, 0xd4, 0xeb, 0xa9, 0x00, 0xab, 0x9a, 0xe8, 0x23, 0xa8, 0xa7, 0xa3, 0x5e, 0x8f, 0xa4, 0xfc,
+	0xd9, 0x43, 0xd6, 0xe7, 0xda, 0x93, 0x40, 0x37, 0x1b, 0xc4, 0x3a, 0xd0, 0x7d, 0x02, 0x4e, 0x16,
+	0xec, 0x58, 0xf4, 0x26, 0x2c, 0xb0, 0xcb, 0x55, 0x8a, 0x86, 0x51, 0xd4, 0x96, 0x0e, 0x2f, 0x6a,
+	0xdd, 0x3f, 0xb2, 0x6b, 0xad, 0x18, 0xf0, 0xd6, 0xa0, 0xca, 0x36, 0xf9, 0x59, 0x18, 0x28, 0x13,
+	0xb2, 0xe6, 0x76, 0x80, 0xce, 0x02, 0xa4, 0xc2, 0x01, 0xd8, 0x98, 0x58, 0x95, 0x23, 0x7b, 0xb6,
+	0x03, 0x66, 0xf9, 0x38, 0x09, 0x7b, 0x61, 0x24, 0x53, 0x7b, 0xd9, 0x42, 0xef, 0x83, 0xdd, 0x27,
+	0x07, 0xa4, 0xcf, 0x43, 0xe6, 0x62, 0x56, 0x00, 0x0b, 0xd3, 0x3d, 0x88, 0x7b, 0x0f, 0xd8, 0x20,
+	0x16, 0x18, 0xdd, 0x6c, 0xb6, 0x61, 0x36, 0xf7, 0x0f, 0x16, 0xac, 0x4c, 0x89, 0xb1, 0xe8, 0x6d,
+	0x68, 0xf8, 0xea, 0x44, 0x3d, 0xca, 0xdf, 0x61, 0xcc, 0x4e, 0xc6, 0x3b, 0x8c, 0x83, 0x47, 0x79,
+	0x3d, 0xa2, 0x9a, 0xfa, 0x8c, 0x65, 0x73, 0xa3, 0x36, 0x60, 0x35, 0x09, 0xfd, 0xe7, 0x77, 0xe3,
+	0x64, 0xe0, 0x51, 0x4a, 0x82, 0x87, 0x12, 0x26, 0x8a, 0x93, 0xa9, 0x63, 0xee, 0x5f, 0x2d, 0x70,
+	0xb2, 0x00, 0x8e, 0x16, 0xa1, 0x94, 0x59, 0xb1, 0x14, 0x06, 0xac, 0x24, 0x62, 0xc6, 0x52, 0x25,
+	0x11, 0xfb, 0xcd, 0x2e, 0xd1, 0x80, 0xa4, 0x7e, 0x12, 0x0e, 0xd9, 0xb2, 0xa4, 0x0e, 0x7a, 0x17,
+	0x5a, 0x07, 0x27, 0xa4, 0x24, 0xe1, 0xcb, 0xe6, 0x93, 0xdb, 0x38, 0xef, 0xd0, 0x1c, 0xde, 0x36,
+	0x1c, 0xfe, 0x06, 0x34, 0x3c, 0xdd, 0x89, 0xe5, 0x5d, 0x31, 0xd3, 0xf5, 0x4d, 0xb4, 0xfb, 0x17,
+	0x0b, 0x96, 0x27, 0x2e, 0x93, 0x89, 0x05, 0x69, 0xbe, 0x52, 0x32, 0x7c, 0xa5, 0x05, 0x35, 0x95,
+	0x17, 0xcb, 0x25, 0x65, 0x6d, 0x66, 0x85, 0x94, 0x92, 0xa1, 0xb4, 0x23, 0xff, 0xfd, 0xa6, 0x56,
+	0xf1, 0x5b, 0x8b, 0x85, 0x08, 0x33, 0xf0, 0x1d, 0x7d, 0x11, 0xb9, 0x52, 0xe5, 0x97, 0x2b, 0x35,
+	0x7f, 0x2c, 0xa5, 0xbe, 0xb2, 0x00, 0x4d, 0xc6, 0xd3, 0x6f, 0x84, 0x5a, 0x93, 0x17, 0xfe, 0xff,
+	0x5d, 0xad, 0x5f, 0x95, 0x60, 0x6d, 0xc6, 0xb5, 0x7f, 0x2c, 0x77, 0x54, 0x69, 0xb5, 0x72, 0x47,
+	0xd5, 0xd6, 0xf4, 0x9e, 0x37, 0xf4, 0x9e, 0x19, 0x8a, 0x0a, 0x79, 0x79, 0xe5, 0xc8, 0x79, 0xf9,
+	0xa4, 0x29, 0xaa, 0xc7, 0x32, 0xc5, 0x9f, 0xca, 0xb0, 0x54, 0xcc, 0xa5, 0x8e, 0x6e, 0x83, 0x75,
+	0x70, 0xfa, 0xb1, 0xef, 0xf5, 0x19, 0x03, 0x37, 0x82, 0x8d, 0xf3, 0x0e, 0x3d, 0x40, 0xce, 0x9b,
+	0x01, 0x72, 0x22, 0xc0, 0xda, 0xd3, 0x02, 0xec, 0x3a, 0x38, 0x91, 0x37, 0x20, 0xe9, 0xd0, 0xf3,
+	0x85, 0x49, 0x1c, 0x9c, 0x77, 0x30, 0xfb, 0xb3, 0x4b, 0x9d, 0x8b, 0x57, 0x85, 0xfd, 0x55, 0x1b,
+	0xb9, 0xb0, 0xa0, 0xf6, 0x82, 0xd5, 0xec, 0x3c, 0x7d, 0x74, 0xb0, 0xd1, 0xa7, 0x63, 0x38, 0x87,
+	0x63, 0x62, 0x54, 0x20, 0xf7, 0x82, 0x20, 0x21, 0x69, 0xca, 0x53, 0x3c, 0x07, 0xab, 0x26, 0xfa,
+	0x2e, 0x00, 0xf5, 0x92, 0x1e, 0xa1, 0x7c, 0xe9, 0xf5, 0xe2, 0x3b, 0xec, 0x76, 0x44, 0x1f, 0x27,
+	0x5d, 0x9a, 0x84, 0x51, 0x0f, 0x6b, 0x40, 0x36, 0xe9, 0x0b, 0xb2, 0xdb, 0x8d, 0xfd, 0x7d, 0x42,
+	0x9f, 0x26, 0x7d, 0x9e, 0x95, 0x39, 0xd8, 0xe8, 0x33, 0x63, 0x73, 0xa3, 0x10, 0x9b, 0xdd, 0x5f,
+	0x5b, 0x70, 0x7a, 0x66, 0xa6, 0x33, 0xfb, 0xa2, 0x5d, 0x05, 0x3b, 0x21, 0x5e, 0x30, 0x96, 0x15,
+	0x95, 0x68, 0xa0, 0x73, 0x00, 0xfc, 0xc7, 0x2d, 0xfe, 0x94, 0x21, 0x36, 0x50, 0xeb, 0x61, 0xe3,
+	0x34, 0xa6, 0x5e, 0x5f, 0x8c, 0x8b, 0x7b, 0x42, 0xeb, 0x61, 0x11, 0xbd, 0x61, 0xa4, 0xba, 0xc7,
+	0x72, 0x1d, 0x96, 0x13, 0xeb, 0x33, 0xe7, 0x1d, 0x4c, 0xdd, 0x70, 0x90, 0x5f, 0x8c, 0xa2, 0xf1,
+	0xa6, 0x22, 0xfa, 0xd7, 0x65, 0x58, 0x9b, 0x91, 0x65, 0xbf, 0x7e, 0xa8, 0x7a, 0xe3, 0x87, 0x20,
+	0xbb, 0x13, 0xab, 0x85, 0x3b, 0xb1, 0x09, 0xd5, 0x64, 0x14, 0xb1, 0xa2, 0x57, 0xfa, 0xbf, 0x6a,
+	0xb2, 0x6d, 0x7d, 0x11, 0x27, 0xfb, 0x61, 0xd4, 0xbb, 0x1d, 0x26, 0xd2, 0xf1, 0xb5, 0x1e, 0xf4,
+	0x04, 0x80, 0x97, 0x16, 0xe2, 0x6b, 0x0f, 0xf0, 0x6c, 0xf2, 0xca, 0xa1, 0x15, 0x89, 0xe8, 0xd7,
+	0xbe, 0xfd, 0x68, 0x24, 0xad, 0x1b, 0x70, 0xa2, 0x30, 0x7c, 0xd8, 0xfb, 0x41, 0x43, 0x7f, 0x3f,
+	0xb8, 0x01, 0xcb, 0x4f, 0x53, 0x92, 0x6c, 0x47, 0x94, 0x44, 0x54, 0x7d, 0x25, 0xbb, 0x04, 0x95,
+	0x90, 0x77, 0xc8, 0xe2, 0x7f, 0xc9, 0x38, 0x7f, 0x0c, 0x28, 0xc7, 0xdd, 0x4f, 0x60, 0x51, 0x3e,
+	0x1f, 0x28, 0xd9, 0x0f, 0xcc, 0x2f, 0x76, 0xfa, 0x37, 0x04, 0x01, 0x34, 0x3e, 0xdc, 0x5d, 0x81,
+	0x05, 0xbd, 0x1b, 0xb5, 0xa0, 0x4a, 0xb8, 0xfb, 0x08, 0xd7, 0xa8, 0x6d, 0xcd, 0x61, 0xd5, 0x71,
+	0xd3, 0x86, 0xf2, 0x81, 0xd7, 0x77, 0x7f, 0x00, 0x15, 0xa1, 0x04, 0x5b, 0x55, 0xfe, 0x39, 0xa4,
+	0xa6, 0xbe, 0x7a, 0xb0, 0x8c, 0x65, 0x1c, 0xf9, 0xf2, 0x3c, 0xf2, 0xdf, 0xcc, 0x87, 0xe4, 0x97,
+	0x90, 0x32, 0xef, 0x95, 0x2d, 0x37, 0x04, 0xc8, 0x33, 0x78, 0x74, 0x0b, 0x16, 0xf3, 0x1c, 0x5e,
+	0x2b, 0x20, 0xce, 0x98, 0xd7, 0x85, 0x01, 0xc1, 0x05, 0x11, 0x36, 0x95, 0x38, 0x04, 0xca, 0x8d,
+	0x45, 0xcb, 0x7d, 0x02, 0x75, 0x2d, 0x76, 0xf1, 0xec, 0x52, 0xbd, 0x8a, 0xda, 0xf2, 0xe9, 0xf3,
+	0x14, 0x37, 0xfb, 0xe7, 0x5e, 0x5f, 0xbe, 0x7d, 0xca, 0x96, 0x38, 0x01, 0x09, 0xeb, 0xcf, 0x4e,
+	0x00, 0x6b, 0x6d, 0xfc, 0xb9, 0x02, 0xcb, 0xaa, 0x22, 0xf8, 0x7c, 0xa3, 0x4b, 0x92, 0x83, 0xd0,
+	0x27, 0xe8, 0x2e, 0xd4, 0xee, 0x11, 0xf5, 0x7c, 0x38, 0xf1, 0x6a, 0x73, 0x67, 0x30, 0xa4, 0xe3,
+	0x56, 0xf1, 0x3b, 0xaa, 0xbb, 0xfc, 0x8b, 0xbf, 0xfd, 0xeb, 0x77, 0xa5, 0x3a, 0x72, 0x3a, 0x07,
+	0x1b, 0x1d, 0xbe, 0x35, 0xe8, 0x1e, 0x54, 0xb8, 0xf7, 0xa5, 0x47, 0x61, 0xe1, 0x48, 0x17, 0x71,
+	0x96, 0x05, 0x04, 0x8c, 0x85, 0xd7, 0x7e, 0xe9, 0x65, 0x0b, 0xfd, 0x10, 0x4e, 0x98, 0xb5, 0xc0,
+	0x31, 0x18, 0xcf, 0x70, 0xc6, 0x93, 0x68, 0x85, 0x31, 0x9a, 0xcf, 0x33, 0x8c, 0xba, 0x0b, 0x0b,
+	0x5a, 0x49, 0x74, 0x0c, 0xde, 0x26, 0xe7, 0x45, 0x68, 0xa9, 0xa3, 0x7d, 0xfd, 0x96, 0xa4, 0x3f,
+	0x86, 0xea, 0x9d, 0x2f, 0x89, 0x3f, 0xa2, 0x04, 0x69, 0x8f, 0x35, 0x13, 0xa7, 0xa4, 0x35, 0x63,
+	0x32, 0xa5, 0xb3, 0x5b, 0xe7, 0x56, 0x10, 0x4c, 0xd7, 0xe5, 0x81, 0x41, 0x01, 0x38, 0x9b, 0x23,
+	0x1a, 0xf3, 0x6c, 0x1d, 0x35, 0x27, 0x0e, 0xc7, 0x61, 0xdc, 0xef, 0x70, 0xee, 0xb7, 0x5a, 0xa7,
+	0x18, 0x37, 0xf7, 0xf7, 0x8e, 0x37, 0xa2, 0xf1, 0x33, 0x35, 0x8d, 0x38, 0x56, 0x68, 0x17, 0x6a,
+	0x6c, 0x16, 0x76, 0x7b, 0xbc, 0xc2, 0x24, 0x6f, 0xf3, 0x49, 0xce, 0xb5, 0x4e, 0x72, 0xe3, 0x8c,
+	0x23, 0x7f, 0xea, 0x1c, 0x7b, 0x00, 0x6c, 0x0e, 0x91, 0x85, 0xbe, 0xc2, 0x2c, 0xdf, 0xe2, 0xb3,
+	0x9c, 0x6f, 0xad, 0xb1, 0x59, 0xc4, 0x79, 0x9c, 0x3a, 0xcf, 0x63, 0xa8, 0x6c, 0x79, 0x51, 0xd0,
+	0x27, 0xa8, 0xb8, 0x8b, 0x33, 0xa9, 0xd7, 0x39, 0xf5, 0x29, 0x77, 0x39, 0xf7, 0xc3, 0xce, 0x73,
+	0xce, 0x71, 0xdd, 0x7a, 0xef, 0xe6, 0xd5, 0x1f, 0x5d, 0xe9, 0x85, 0xf4, 0xf9, 0x68, 0xb7, 0xed,
+	0xc7, 0x83, 0xce, 0x3d, 0xce, 0x90, 0x05, 0xdc, 0x9d, 0x38, 0xee, 0xa7, 0x99, 0x47, 0x88, 0x3f,
+	0x5c, 0xe8, 0x1c, 0x6c, 0x7c, 0x56, 0xde, 0xad, 0xf0, 0xdf, 0x57, 0xff, 0x1b, 0x00, 0x00, 0xff,
+	0xff, 0xab, 0x18, 0x31, 0xba, 0x30, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.